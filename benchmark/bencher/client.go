@@ -17,15 +17,10 @@
 package bencher
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"io/ioutil"
 
 	"github.com/threefoldtech/0-stor/client"
-	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
-	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
 	"github.com/threefoldtech/0-stor/client/metastor"
 	metaDB "github.com/threefoldtech/0-stor/client/metastor/db"
 	"github.com/threefoldtech/0-stor/client/metastor/db/test"
@@ -41,7 +36,7 @@ import (
 // if Metastor shards are empty, it will use an in memory metadata server
 func newClientFromConfig(cfg *daemon.Config, jobCount int) (*client.Client, *metastor.Client, error) {
 	// create datastor cluster
-	datastorCluster, err := createDataClusterFromConfig(cfg)
+	datastorCluster, err := client.NewDataCluster(cfg.Config)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,16 +62,6 @@ func newClientFromConfig(cfg *daemon.Config, jobCount int) (*client.Client, *met
 	return client.NewClient(metastorClient, dataPipeline), metastorClient, nil
 }
 
-func createDataClusterFromConfig(cfg *daemon.Config) (datastor.Cluster, error) {
-	// optionally create the global datastor TLS config
-	tlsConfig, err := createTLSConfigFromDatastorTLSConfig(&cfg.DataStor.TLS)
-	if err != nil {
-		return nil, err
-	}
-
-	return zerodb.NewCluster(cfg.DataStor.Shards, cfg.Password, cfg.Namespace, tlsConfig, cfg.DataStor.Spreading)
-}
-
 func createMetastorClientFromConfig(namespace string, cfg *daemon.MetaStorConfig) (*metastor.Client, error) {
 	if len(cfg.DB.Type) == 0 {
 		// if no config, return a test metadata server (in-memory)
@@ -127,41 +112,3 @@ func createMetastorClientFromConfigAndDatabase(namespace string, cfg *daemon.Met
 	// including encryption support for our metadata in binary form
 	return metastor.NewClientFromConfig([]byte(namespace), config)
 }
-
-func createTLSConfigFromDatastorTLSConfig(config *client.DataStorTLSConfig) (*tls.Config, error) {
-	if config == nil || !config.Enabled {
-		return nil, nil
-	}
-	tlsConfig := &tls.Config{
-		MinVersion: config.MinVersion.VersionTLSOrDefault(tls.VersionTLS11),
-		MaxVersion: config.MaxVersion.VersionTLSOrDefault(tls.VersionTLS12),
-	}
-
-	if config.ServerName != "" {
-		tlsConfig.ServerName = config.ServerName
-	} else {
-		log.Warning("TLS is configured to skip verification of certs, " +
-			"making the client susceptible to man-in-the-middle attacks!!!")
-		tlsConfig.InsecureSkipVerify = true
-	}
-
-	if config.RootCA == "" {
-		var err error
-		tlsConfig.RootCAs, err = x509.SystemCertPool()
-		if err != nil {
-			return nil, fmt.Errorf("failed to create datastor TLS config: %v", err)
-		}
-	} else {
-		tlsConfig.RootCAs = x509.NewCertPool()
-		caFile, err := ioutil.ReadFile(config.RootCA)
-		if err != nil {
-			return nil, err
-		}
-		if !tlsConfig.RootCAs.AppendCertsFromPEM(caFile) {
-			return nil, fmt.Errorf("error reading CA file '%s', while creating datastor TLS config: %v",
-				config.RootCA, err)
-		}
-	}
-
-	return tlsConfig, nil
-}
@@ -0,0 +1,212 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+var (
+	// ErrAppendConflict is returned when the metadata of a key
+	// was modified by another write while appending to that key.
+	// None of the appended data is stored in that case, and the append can be retried.
+	ErrAppendConflict = errors.New("Client: key was modified while appending to it")
+)
+
+// Append appends the data read from the given reader to the data stored as the given key,
+// returning the updated metadata.
+// See AppendContext for more information.
+func (c *Client) Append(key []byte, r io.Reader) (*metatypes.Metadata, error) {
+	return c.AppendContext(context.Background(), key, r)
+}
+
+// AppendContext appends the data read from the given reader to the data stored as the given key,
+// returning the updated metadata,
+// aborting as soon as the given context is cancelled or its deadline expires.
+// The key is created in case it doesn't exist yet.
+//
+// Only the appended data is written, the data already stored isn't rewritten,
+// except for its last chunk in case it is smaller than the chunk size,
+// as that chunk is completed using the appended data,
+// such that all chunks (but the last one) remain the size of the chunk size.
+// Data which is split into chunks of variable size always has its last chunk rewritten,
+// as its boundary was defined by the end of the data, rather than by its content.
+// Data which isn't chunked at all, is rewritten completely.
+// The chunk size of the client has to equal the chunk size of the stored data.
+//
+// The metadata is updated atomically, ErrAppendConflict is returned in case
+// the key was modified by another write in the meantime.
+// Appending doesn't create an older version of the key, regardless of the overwrite mode,
+// while the replaced last chunk is deleted immediately, or delayed in case of the delayed overwrite mode.
+func (c *Client) AppendContext(ctx context.Context, key []byte, r io.Reader) (*metatypes.Metadata, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+	if r == nil {
+		return nil, errors.New("no reader given to read from")
+	}
+	if c.metastorClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+
+	base, err := c.metastorClient.GetMetadata(key)
+	if err != nil && err != metastor.ErrNotFound {
+		return nil, err
+	}
+
+	// collect the data of the chunks which have to be rewritten
+	chunkSize := c.dataPipeline.ChunkSize()
+	var tail []metatypes.Chunk
+	tailData := bytes.NewBuffer(nil)
+	if base != nil && len(base.Chunks) > 0 {
+		err = c.checkChunkSize(*base)
+		if err != nil {
+			return nil, err
+		}
+		if chunkSize == metatypes.VariableChunkSize {
+			tail = base.Chunks[len(base.Chunks)-1:]
+		} else if chunkSize <= 0 {
+			tail = base.Chunks
+		} else if base.Size%int64(chunkSize) != 0 {
+			tail = base.Chunks[len(base.Chunks)-1:]
+		}
+		if len(tail) > 0 {
+			err = c.dataPipeline.ReadContext(ctx, tail, tailData)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	tailSize := int64(tailData.Len())
+
+	// process and write the tail, followed by the new data
+	rc := &readCounter{r: io.MultiReader(tailData, r)}
+	chunks, err := c.writeChunks(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+	if base != nil && rc.Size() == tailSize {
+		// nothing was appended
+		c.deleteUnusedChunks(chunks)
+		return base, nil
+	}
+
+	now := EpochNow()
+	var md metatypes.Metadata
+	if base != nil {
+		md = *base
+		md.Chunks = append([]metatypes.Chunk(nil), base.Chunks[:len(base.Chunks)-len(tail)]...)
+		for _, chunk := range tail {
+			md.StorageSize -= chunk.Size
+		}
+	} else {
+		md = metatypes.Metadata{
+			Key:           key,
+			CreationEpoch: now,
+			ChunkSize:     int32(chunkSize),
+		}
+	}
+	if md.ChunkSize == metatypes.VariableChunkSize {
+		offsetChunks(chunks, md.Size-tailSize)
+	}
+	md.Size += rc.Size() - tailSize
+	md.LastWriteEpoch = now
+	md.Chunks = append(md.Chunks, chunks...)
+	for _, chunk := range chunks {
+		md.StorageSize += chunk.Size
+	}
+
+	err = c.commitUpdate(base, md, ErrAppendConflict)
+	if err != nil {
+		c.deleteUnusedChunks(chunks)
+		return nil, err
+	}
+
+	c.deleteReplacedChunks(key, tail)
+	return &md, nil
+}
+
+// checkChunkSize returns an error in case the data of the given metadata
+// can't be updated, as it has a different chunk size than the data pipeline of the client
+func (c *Client) checkChunkSize(md metatypes.Metadata) error {
+	chunkSize := c.dataPipeline.ChunkSize()
+	if int(md.ChunkSize) != chunkSize && (md.ChunkSize != 0 || chunkSize != 0) {
+		return fmt.Errorf("%q has chunk size %d, while the client has chunk size %d",
+			md.Key, md.ChunkSize, chunkSize)
+	}
+	return nil
+}
+
+// offsetChunks moves the offsets of the given chunks of variable size by the given offset,
+// such that they can be referenced after the data which precedes them
+func offsetChunks(chunks []metatypes.Chunk, offset int64) {
+	for index := range chunks {
+		chunks[index].Offset += offset
+	}
+}
+
+// commitUpdate stores the given metadata atomically,
+// as the update of the given base metadata, which is nil in case the key didn't exist yet.
+// The given conflict error is returned in case the key was modified
+// (or created, or deleted) since the base metadata was fetched.
+func (c *Client) commitUpdate(base *metatypes.Metadata, md metatypes.Metadata, errConflict error) error {
+	_, err := c.metastorClient.UpdateMetadata(md.Key, func(current metatypes.Metadata) (*metatypes.Metadata, error) {
+		if base == nil || current.LastWriteEpoch != base.LastWriteEpoch ||
+			len(current.Chunks) != len(base.Chunks) {
+			return nil, errConflict
+		}
+		updated := md
+		updated.Namespace = current.Namespace
+		return &updated, nil
+	})
+	if err == metastor.ErrNotFound {
+		if base != nil {
+			// deleted in the meantime
+			return errConflict
+		}
+		return c.metastorClient.SetMetadata(md)
+	}
+	return err
+}
+
+// deleteReplacedChunks frees the given chunks of the given key,
+// which were replaced by an update of the metadata of that key.
+// They are deleted immediately, or delayed in case of the delayed overwrite mode.
+//
+// Errors are only logged, as the updated metadata has been committed already,
+// chunks that couldn't be freed are left to be collected by the garbage collector.
+func (c *Client) deleteReplacedChunks(key []byte, chunks []metatypes.Chunk) {
+	if len(chunks) == 0 {
+		return
+	}
+	replaced := metatypes.Metadata{Key: key, Chunks: chunks}
+	if c.overwrite.Mode == OverwriteModeDelayed {
+		c.scheduleDelete(replaced)
+	} else {
+		c.deleteSupersededChunks(replaced)
+	}
+}
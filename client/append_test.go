@@ -0,0 +1,177 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAppendChunked(t *testing.T) {
+	testClientAppend(t, 64)
+}
+
+func TestClientAppendNotChunked(t *testing.T) {
+	testClientAppend(t, 0)
+}
+
+func TestClientAppendVariableChunked(t *testing.T) {
+	testClientAppend(t, metatypes.VariableChunkSize)
+}
+
+func testClientAppend(t *testing.T, chunkSize int) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, cluster, err := getTestClient(newDefaultConfig(shards, chunkSize))
+	require.NoError(t, err)
+	defer c.Close()
+
+	key := []byte("log")
+	var data []byte
+	// the first append creates the key,
+	// and appends are both aligned and unaligned to the chunk size
+	for _, size := range []int{100, 28, 64, 1, 200} {
+		part := make([]byte, size)
+		_, err = rand.Read(part)
+		require.NoError(t, err)
+		data = append(data, part...)
+
+		md, err := c.Append(key, bytes.NewReader(part))
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), md.Size)
+
+		stored, err := c.metastorClient.GetMetadata(key)
+		require.NoError(t, err)
+		require.Equal(t, md.Chunks, stored.Chunks)
+		require.Equal(t, md.StorageSize, stored.StorageSize)
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, c.Read(*stored, buf))
+		require.Equal(t, data, buf.Bytes())
+		if chunkSize > 0 {
+			// all chunks but the last one are full
+			require.Len(t, stored.Chunks, (len(data)+chunkSize-1)/chunkSize)
+		}
+	}
+
+	stored, err := c.metastorClient.GetMetadata(key)
+	require.NoError(t, err)
+	for _, tc := range []struct{ offset, length int64 }{
+		{0, 10}, {60, 10}, {100, 100}, {127, 66}, {int64(len(data)) - 1, 1},
+	} {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, c.ReadRange(*stored, buf, tc.offset, tc.length))
+		require.Equal(t, data[tc.offset:tc.offset+tc.length], buf.Bytes())
+	}
+
+	// replaced chunks were deleted, as well as the chunks of empty appends
+	before := objectCount(t, cluster)
+	md, err := c.Append(key, bytes.NewReader(nil))
+	require.NoError(t, err)
+	require.Equal(t, stored.Chunks, md.Chunks)
+	require.Equal(t, before, objectCount(t, cluster))
+	require.NoError(t, c.Delete(*stored))
+	require.Zero(t, objectCount(t, cluster))
+}
+
+func TestClientAppendConflict(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(t, err)
+	defer c.Close()
+
+	key := []byte("log")
+	_, err = c.Write(key, bytes.NewReader(make([]byte, 100)))
+	require.NoError(t, err)
+
+	// overwrite the key while appending to it
+	hp := &hookPipeline{Pipeline: c.dataPipeline}
+	c.dataPipeline = hp
+	var overwritten *metatypes.Metadata
+	hp.hook = func() {
+		hp.hook = nil
+		overwritten, err = c.Write(key, bytes.NewReader([]byte("foo")))
+		require.NoError(t, err)
+	}
+	_, err = c.Append(key, bytes.NewReader([]byte("bar")))
+	require.Equal(t, ErrAppendConflict, err)
+
+	// the appended data is deleted, and the overwritten data is intact
+	stored, err := c.metastorClient.GetMetadata(key)
+	require.NoError(t, err)
+	require.Equal(t, overwritten.Chunks, stored.Chunks)
+	var objects int
+	for _, chunk := range stored.Chunks {
+		objects += len(chunk.Objects)
+	}
+	require.Equal(t, objects, objectCount(t, cluster))
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, c.Read(*stored, buf))
+	require.Equal(t, []byte("foo"), buf.Bytes())
+
+	// a client with another chunk size can't append
+	c.dataPipeline = hp.Pipeline
+	other, _, err := getTestClient(newDefaultConfig(shards, 32))
+	require.NoError(t, err)
+	defer other.Close()
+	_, err = NewClient(c.metastorClient, other.dataPipeline).Append(key, bytes.NewReader([]byte("bar")))
+	require.Error(t, err)
+
+	_, err = c.Append(nil, bytes.NewReader(nil))
+	require.Equal(t, ErrNilKey, err)
+	_, err = c.AppendContext(nil, key, bytes.NewReader(nil))
+	require.Equal(t, ErrNilContext, err)
+	_, err = NewClient(nil, c.dataPipeline).Append(key, bytes.NewReader(nil))
+	require.Equal(t, ErrNoMetastorClient, err)
+}
+
+// hookPipeline is a pipeline which calls its hook (if any)
+// after writing data, prior to returning
+type hookPipeline struct {
+	pipeline.Pipeline
+	hook func()
+}
+
+func (hp *hookPipeline) WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error) {
+	chunks, err := hp.Pipeline.WriteContext(ctx, r)
+	if hp.hook != nil {
+		hp.hook()
+	}
+	return chunks, err
+}
@@ -17,12 +17,15 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
@@ -40,6 +43,9 @@ var (
 	ErrNilKey = errors.New("Client: nil/empty key given")
 	// ErrNilContext is an error returned in case a context given to a client method is nil.
 	ErrNilContext = errors.New("Client: nil context given")
+	// ErrNoMetastorClient is an error returned in case a client method requires
+	// a metastor client, while the client was created without one.
+	ErrNoMetastorClient = errors.New("Client: no metastor client configured")
 
 	// ErrRepairSupport is returned when data is not stored using replication or distribution
 	ErrRepairSupport = errors.New("data is not stored using replication or distribution, repair impossible")
@@ -52,6 +58,11 @@ var (
 type Client struct {
 	dataPipeline   pipeline.Pipeline
 	metastorClient *metastor.Client
+
+	overwrite      OverwriteConfig
+	dedup          bool
+	pendingDeletes map[*pendingDelete]struct{}
+	pendingMux     sync.Mutex
 }
 
 // NewClientFromConfig creates new 0-stor client using the given config.
@@ -60,7 +71,7 @@ type Client struct {
 // as defined by the pipeline package.
 func NewClientFromConfig(cfg Config, metastorClient *metastor.Client, jobCount int) (*Client, error) {
 	// create datastor cluster
-	datastorCluster, err := createDataClusterFromConfig(cfg)
+	datastorCluster, err := NewDataCluster(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -71,19 +82,56 @@ func NewClientFromConfig(cfg Config, metastorClient *metastor.Client, jobCount i
 		return nil, err
 	}
 
-	return NewClient(metastorClient, dataPipeline), nil
+	client := NewClient(metastorClient, dataPipeline)
+	err = client.SetOverwriteConfig(cfg.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+	// identical chunks share their objects when using user keys,
+	// which are only deleted once no longer referenced when deduplicating chunks
+	err = client.SetDeduplication(cfg.Deduplication || cfg.DataStor.KeyMode == datastor.KeyModeUser)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
 }
 
-func createDataClusterFromConfig(cfg Config) (datastor.Cluster, error) {
-	// optionally create the global datastor TLS config
-	tlsConfig, err := createTLSConfigFromDatastorTLSConfig(&cfg.DataStor.TLS)
+// NewDataCluster creates the datastor cluster defined by the given config,
+// as used by the data pipeline of a client created using NewClientFromConfig.
+func NewDataCluster(cfg Config) (datastor.Cluster, error) {
+	clusterCfg, err := DataClusterConfig(cfg)
 	if err != nil {
 		return nil, err
 	}
-	return zerodb.NewCluster(cfg.DataStor.Shards, cfg.Password, cfg.Namespace, tlsConfig, cfg.DataStor.Spreading)
+	return zerodb.NewClusterFromConfig(clusterCfg)
 }
 
-func createTLSConfigFromDatastorTLSConfig(config *DataStorTLSConfig) (*tls.Config, error) {
+// DataClusterConfig returns the config of the datastor cluster defined by the given config,
+// such that all users of the cluster create it the same way (see NewDataCluster).
+func DataClusterConfig(cfg Config) (zerodb.ClusterConfig, error) {
+	// optionally create the global datastor TLS config
+	tlsConfig, err := CreateTLSConfigFromDatastorTLSConfig(&cfg.DataStor.TLS)
+	if err != nil {
+		return zerodb.ClusterConfig{}, err
+	}
+	return zerodb.ClusterConfig{
+		Shards:        cfg.DataStor.Shards,
+		Password:      cfg.Password,
+		Namespace:     cfg.Namespace,
+		TLSConfig:     tlsConfig,
+		Spreading:     cfg.DataStor.Spreading,
+		KeyMode:       cfg.DataStor.KeyMode,
+		Health:        cfg.DataStor.Health,
+		Connection:    cfg.DataStor.Connection,
+		Provision:     cfg.DataStor.Provision,
+		StatsInterval: cfg.DataStor.StatsInterval,
+	}, nil
+}
+
+// CreateTLSConfigFromDatastorTLSConfig creates the TLS config,
+// used for the connections to all datastor shards, from the given (user) config.
+// No TLS config (nil) is returned, in case the given config is nil or not enabled.
+func CreateTLSConfigFromDatastorTLSConfig(config *DataStorTLSConfig) (*tls.Config, error) {
 	if config == nil || !config.Enabled {
 		return nil, nil
 	}
@@ -118,6 +166,18 @@ func createTLSConfigFromDatastorTLSConfig(config *DataStorTLSConfig) (*tls.Confi
 		}
 	}
 
+	// optionally load the client certificate, used for mutual TLS
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, errors.New("datastor TLS config requires both a client certificate and key, or none of them")
+		}
+		cert, err := tls.LoadX509KeyPair(config.ClientCert, config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate, while creating datastor TLS config: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
 	return tlsConfig, nil
 }
 
@@ -140,7 +200,15 @@ func NewClient(metaClient *metastor.Client, dataPipeline pipeline.Pipeline) *Cli
 // Write writes the data to a 0-stor cluster,
 // storing the metadata using the internal metastor client.
 func (c *Client) Write(key []byte, r io.Reader) (*metatypes.Metadata, error) {
-	return c.write(key, r, nil)
+	return c.write(context.Background(), key, r, nil)
+}
+
+// WriteContext writes the data to a 0-stor cluster,
+// storing the metadata using the internal metastor client.
+// The write is aborted as soon as the given context is cancelled or its deadline expires,
+// in which case the context's error is returned.
+func (c *Client) WriteContext(ctx context.Context, key []byte, r io.Reader) (*metatypes.Metadata, error) {
+	return c.write(ctx, key, r, nil)
 }
 
 // WriteWithUserMeta writes the data to a 0-stor cluster,
@@ -148,10 +216,19 @@ func (c *Client) Write(key []byte, r io.Reader) (*metatypes.Metadata, error) {
 // The given user defined metadata will be stored in the `UserDefined` field
 // of the metadata.
 func (c *Client) WriteWithUserMeta(key []byte, r io.Reader, userDefined map[string]string) (*metatypes.Metadata, error) {
-	return c.write(key, r, userDefined)
+	return c.write(context.Background(), key, r, userDefined)
+}
+
+// WriteWithUserMetaContext is the same as WriteWithUserMeta,
+// except that it can be cancelled, or given a deadline, using the given context.
+func (c *Client) WriteWithUserMetaContext(ctx context.Context, key []byte, r io.Reader, userDefined map[string]string) (*metatypes.Metadata, error) {
+	return c.write(ctx, key, r, userDefined)
 }
 
-func (c *Client) write(key []byte, r io.Reader, userDefinedMeta map[string]string) (*metatypes.Metadata, error) {
+func (c *Client) write(ctx context.Context, key []byte, r io.Reader, userDefinedMeta map[string]string) (*metatypes.Metadata, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
 	if len(key) == 0 {
 		return nil, ErrNilKey // ensure a key is given
 	}
@@ -164,7 +241,7 @@ func (c *Client) write(key []byte, r io.Reader, userDefinedMeta map[string]strin
 	rc := &readCounter{r: r}
 
 	// process and write the data
-	chunks, err := c.dataPipeline.Write(rc)
+	chunks, err := c.writeChunks(ctx, rc)
 	if err != nil {
 		return nil, err
 	}
@@ -186,11 +263,19 @@ func (c *Client) write(key []byte, r io.Reader, userDefinedMeta map[string]strin
 		md.StorageSize += chunk.Size
 	}
 
-	// store metadata
-	if c.metastorClient != nil {
-		err = c.metastorClient.SetMetadata(md)
+	if c.metastorClient == nil {
+		return &md, nil
+	}
+
+	// store metadata, and free the chunks of the data it supersedes (if any)
+	previous, err := c.commitMetadata(md)
+	if err != nil {
+		return &md, err
+	}
+	if previous != nil {
+		c.handleSupersededMetadata(*previous)
 	}
-	return &md, err
+	return &md, nil
 }
 
 // Read reads the data, from the 0-stor cluster,
@@ -210,15 +295,38 @@ func (c *Client) write(key []byte, r io.Reader, userDefinedMeta map[string]strin
 // Read reads the data, from the 0-stor cluster,
 // using the reference information fetched from the given metadata.
 func (c *Client) Read(meta metatypes.Metadata, w io.Writer) error {
-	return c.dataPipeline.Read(meta.Chunks, w)
+	return c.ReadContext(context.Background(), meta, w)
+}
+
+// ReadContext reads the data, from the 0-stor cluster,
+// using the reference information fetched from the given metadata.
+// The read is aborted as soon as the given context is cancelled or its deadline expires,
+// in which case the context's error is returned.
+func (c *Client) ReadContext(ctx context.Context, meta metatypes.Metadata, w io.Writer) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	return c.dataPipeline.ReadContext(ctx, meta.Chunks, w)
 }
 
 // ReadRange reads data with the given offset & length.
 func (c *Client) ReadRange(meta metatypes.Metadata, w io.Writer, offset, length int64) error {
+	return c.ReadRangeContext(context.Background(), meta, w, offset, length)
+}
+
+// ReadRangeContext reads data with the given offset & length,
+// aborting as soon as the given context is cancelled or its deadline expires.
+// Only the chunks containing the requested data are read,
+// located using either the chunk size of the metadata,
+// or the offsets of the chunks in case they are of variable size.
+func (c *Client) ReadRangeContext(ctx context.Context, meta metatypes.Metadata, w io.Writer, offset, length int64) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
 	// in case we don't split the data,
 	// no need to worry about which chunk to proceed
 	if meta.ChunkSize == 0 {
-		return c.dataPipeline.Read(meta.Chunks, &rangeWriter{
+		return c.dataPipeline.ReadContext(ctx, meta.Chunks, &rangeWriter{
 			w:      w,
 			offset: offset,
 			length: length,
@@ -232,6 +340,18 @@ func (c *Client) ReadRange(meta metatypes.Metadata, w io.Writer, offset, length
 		return ErrInvalidReadRange
 	}
 
+	if meta.ChunkSize == metatypes.VariableChunkSize {
+		startChunkIdx, chunkOffset := chunkAt(meta.Chunks, metatypes.VariableChunkSize, offset)
+		endChunkIdx := sort.Search(len(meta.Chunks), func(i int) bool {
+			return meta.Chunks[i].Offset >= endOffset
+		})
+		return c.dataPipeline.ReadContext(ctx, meta.Chunks[startChunkIdx:endChunkIdx], &rangeWriter{
+			offset: offset - chunkOffset,
+			length: length,
+			w:      w,
+		})
+	}
+
 	var (
 		startChunkIdx = int(offset / int64(meta.ChunkSize))
 		endChunkIdx   = int(endOffset / int64(meta.ChunkSize))
@@ -245,7 +365,7 @@ func (c *Client) ReadRange(meta metatypes.Metadata, w io.Writer, offset, length
 		length: length,
 		w:      w,
 	}
-	return c.dataPipeline.Read(meta.Chunks[startChunkIdx:endChunkIdx], rw)
+	return c.dataPipeline.ReadContext(ctx, meta.Chunks[startChunkIdx:endChunkIdx], rw)
 }
 
 // range writer is writer that only write data
@@ -299,8 +419,30 @@ func (rw *rangeWriter) Write(p []byte) (int, error) {
 // using the reference information fetched from the given metadata
 // (which is linked to the given key).
 func (c *Client) Delete(meta metatypes.Metadata) error {
+	return c.DeleteContext(context.Background(), meta)
+}
+
+// DeleteContext deletes the data, from the 0-stor cluster,
+// using the reference information fetched from the given metadata,
+// aborting as soon as the given context is cancelled or its deadline expires.
+// The metadata is only deleted in case all data could be deleted.
+//
+// When deduplication is enabled, the metadata is deleted first instead,
+// as the references to its chunks can only be released once,
+// and only the data of chunks which are no longer referenced by any key is deleted.
+func (c *Client) DeleteContext(ctx context.Context, meta metatypes.Metadata) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	if c.dedup {
+		err := c.metastorClient.DeleteMetadata(meta.Key)
+		if err != nil {
+			return err
+		}
+		return c.freeChunks(ctx, meta.Chunks)
+	}
 	// delete data
-	err := c.dataPipeline.Delete(meta.Chunks)
+	err := c.dataPipeline.DeleteContext(ctx, meta.Chunks)
 	if err != nil {
 		return err
 	}
@@ -317,7 +459,16 @@ func (c *Client) Delete(meta metatypes.Metadata) error {
 // CheckStatusInvalid indicates the data is invalid and non-repairable,
 // Any other value indicates the data is readable, but if it's not optimal, it could use a repair.
 func (c *Client) Check(meta metatypes.Metadata, fast bool) (storage.CheckStatus, error) {
-	return c.dataPipeline.Check(meta.Chunks, fast)
+	return c.CheckContext(context.Background(), meta, fast)
+}
+
+// CheckContext gets the status of data stored in a 0-stor cluster,
+// aborting as soon as the given context is cancelled or its deadline expires.
+func (c *Client) CheckContext(ctx context.Context, meta metatypes.Metadata, fast bool) (storage.CheckStatus, error) {
+	if ctx == nil {
+		return storage.CheckStatusInvalid, ErrNilContext
+	}
+	return c.dataPipeline.CheckContext(ctx, meta.Chunks, fast)
 }
 
 // Repair repairs broken data, whether it's needed or not.
@@ -331,6 +482,16 @@ func (c *Client) Check(meta metatypes.Metadata, fast bool) (storage.CheckStatus,
 // if the data has not been distributed or replicated, we can't repair it,
 // or if not enough shards are available we cannot repair it either.
 func (c *Client) Repair(md metatypes.Metadata) (*metatypes.Metadata, error) {
+	return c.RepairContext(context.Background(), md)
+}
+
+// RepairContext repairs broken data, whether it's needed or not,
+// aborting as soon as the given context is cancelled or its deadline expires.
+// See Repair for more information.
+func (c *Client) RepairContext(ctx context.Context, md metatypes.Metadata) (*metatypes.Metadata, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
 
 	// because of conflicts, the callback might be called multiple times,
 	// hence why we want to only do the actual repairing once
@@ -344,7 +505,7 @@ func (c *Client) Repair(md metatypes.Metadata) (*metatypes.Metadata, error) {
 		if repairEpoch == 0 {
 			var err error
 			// repair the chunks (if possible)
-			repairedChunks, err = c.dataPipeline.Repair(meta.Chunks)
+			repairedChunks, err = c.repairChunks(ctx, meta.Chunks)
 			if err != nil {
 				if err == storage.ErrNotSupported {
 					return nil, ErrRepairSupport
@@ -384,6 +545,10 @@ func (c *Client) Repair(md metatypes.Metadata) (*metatypes.Metadata, error) {
 func (c *Client) Close() error {
 	var ce closeErrors
 
+	// execute all pending deletes first,
+	// as those require the data pipeline
+	c.flushPendingDeletes()
+
 	if c.metastorClient != nil {
 		if err := c.metastorClient.Close(); err != nil {
 			ce = append(ce, err)
@@ -17,6 +17,7 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"io"
 
@@ -103,7 +104,7 @@ func (c *Client) WriteLinked(key, prevKey []byte, r io.Reader) (meta, prevMeta *
 			// create the current metadata, should it not be created yet
 			if meta == nil {
 				// process and write the data
-				chunks, err := c.dataPipeline.Write(r)
+				chunks, err := c.writeChunks(context.Background(), r)
 				if err != nil {
 					return nil, err
 				}
@@ -261,7 +262,7 @@ func (state *traverseIteratorState) ReadData(w io.Writer) error {
 	if state.md == nil {
 		return ErrInvalidTraverseIterator
 	}
-	return state.dataPipeline.Read(state.md.Chunks, w)
+	return state.dataPipeline.ReadContext(context.Background(), state.md.Chunks, w)
 }
 
 // forwardTraverseIterator contains the logic and state
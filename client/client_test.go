@@ -18,8 +18,11 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"sync"
 	"testing"
 	"time"
@@ -27,6 +30,7 @@ import (
 	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
+	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
 	"github.com/threefoldtech/0-stor/client/metastor"
 	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
 	"github.com/threefoldtech/0-stor/client/processing"
@@ -35,6 +39,75 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestCreateTLSConfigFromDatastorTLSConfig(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "0-stor-test")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	certs, err := zdbtest.NewTLSCertificates(dir)
+	require.NoError(err)
+
+	// TLS disabled
+	tlsConfig, err := CreateTLSConfigFromDatastorTLSConfig(nil)
+	require.NoError(err)
+	require.Nil(tlsConfig)
+	tlsConfig, err = CreateTLSConfigFromDatastorTLSConfig(&DataStorTLSConfig{
+		ServerName: zdbtest.TLSServerName,
+	})
+	require.NoError(err)
+	require.Nil(tlsConfig)
+
+	// TLS without client certificate
+	tlsConfig, err = CreateTLSConfigFromDatastorTLSConfig(&DataStorTLSConfig{
+		Enabled:    true,
+		ServerName: zdbtest.TLSServerName,
+		RootCA:     certs.CAFile,
+	})
+	require.NoError(err)
+	require.NotNil(tlsConfig)
+	require.Equal(zdbtest.TLSServerName, tlsConfig.ServerName)
+	require.Empty(tlsConfig.Certificates)
+
+	// mutual TLS
+	tlsConfig, err = CreateTLSConfigFromDatastorTLSConfig(&DataStorTLSConfig{
+		Enabled:    true,
+		ServerName: zdbtest.TLSServerName,
+		RootCA:     certs.CAFile,
+		ClientCert: certs.ClientCertFile,
+		ClientKey:  certs.ClientKeyFile,
+	})
+	require.NoError(err)
+	require.NotNil(tlsConfig)
+	require.Len(tlsConfig.Certificates, 1)
+
+	// a client certificate requires a key, and vice versa
+	_, err = CreateTLSConfigFromDatastorTLSConfig(&DataStorTLSConfig{
+		Enabled:    true,
+		ServerName: zdbtest.TLSServerName,
+		RootCA:     certs.CAFile,
+		ClientCert: certs.ClientCertFile,
+	})
+	require.Error(err)
+	_, err = CreateTLSConfigFromDatastorTLSConfig(&DataStorTLSConfig{
+		Enabled:    true,
+		ServerName: zdbtest.TLSServerName,
+		RootCA:     certs.CAFile,
+		ClientKey:  certs.ClientKeyFile,
+	})
+	require.Error(err)
+
+	// invalid client key pair
+	_, err = CreateTLSConfigFromDatastorTLSConfig(&DataStorTLSConfig{
+		Enabled:    true,
+		ServerName: zdbtest.TLSServerName,
+		RootCA:     certs.CAFile,
+		ClientCert: certs.ClientCertFile,
+		ClientKey:  certs.ServerKeyFile,
+	})
+	require.Error(err)
+}
+
 func TestNewClientFromConfigErrors(t *testing.T) {
 	require := require.New(t)
 
@@ -325,6 +398,10 @@ func TestReadRangeNotChunked(t *testing.T) {
 	testReadRange(t, 0)
 }
 
+func TestReadRangeVariableChunked(t *testing.T) {
+	testReadRange(t, metatypes.VariableChunkSize)
+}
+
 func testReadRange(t *testing.T, chunkSize int) {
 	servers, serverClean := testZdbServer(t, 4)
 	defer serverClean()
@@ -581,13 +658,20 @@ func TestIssue225(t *testing.T) {
 	assert.Equal(t, data, result)
 }
 
+// newDefaultConfig creates a client config using the given block size,
+// or using content-defined chunking in case it equals metatypes.VariableChunkSize.
 func newDefaultConfig(dataShards []datastor.ShardConfig, blockSize int) Config {
+	var chunking pipeline.ChunkingConfig
+	if blockSize == metatypes.VariableChunkSize {
+		chunking.AverageSize = 64
+	}
 	return Config{
 		Namespace: "namespace1",
 		DataStor: DataStorConfig{
 			Shards: dataShards,
 			Pipeline: pipeline.Config{
 				BlockSize: blockSize,
+				Chunking:  chunking,
 				Compression: pipeline.CompressionConfig{
 					Mode: processing.CompressionModeDefault,
 				},
@@ -793,3 +877,343 @@ func TestClient_ExplicitErrors(t *testing.T) {
 
 	require.NoError(cli.Close())
 }
+
+func TestClientOverwrite(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 3)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	oldData := bytes.Repeat([]byte("old"), 100)
+	newData := bytes.Repeat([]byte("new"), 200)
+
+	// writes the same key twice, returning both metadata
+	overwrite := func(t *testing.T, cli *Client) (*metatypes.Metadata, *metatypes.Metadata) {
+		oldMeta, err := cli.Write([]byte("foo"), bytes.NewReader(oldData))
+		require.NoError(t, err)
+		newMeta, err := cli.Write([]byte("foo"), bytes.NewReader(newData))
+		require.NoError(t, err)
+
+		// the new data is always readable, using the stored metadata
+		meta, err := cli.metastorClient.GetMetadata([]byte("foo"))
+		require.NoError(t, err)
+		require.Equal(t, newMeta.Chunks, meta.Chunks)
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, cli.Read(*meta, buf))
+		require.Equal(t, newData, buf.Bytes())
+		return oldMeta, newMeta
+	}
+	chunkObjectCount := func(meta *metatypes.Metadata) (count int) {
+		for _, chunk := range meta.Chunks {
+			count += len(chunk.Objects)
+		}
+		return
+	}
+
+	t.Run("delete", func(t *testing.T) {
+		cli, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+		require.NoError(t, err)
+		defer cli.Close()
+		before := objectCount(t, cluster)
+
+		oldMeta, newMeta := overwrite(t, cli)
+		// the old chunks are deleted immediately
+		require.Equal(t, before+chunkObjectCount(newMeta), objectCount(t, cluster))
+		require.Error(t, cli.Read(*oldMeta, bytes.NewBuffer(nil)))
+		require.NoError(t, cli.Delete(*newMeta))
+	})
+
+	t.Run("delayed", func(t *testing.T) {
+		cli, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+		require.NoError(t, err)
+		require.NoError(t, cli.SetOverwriteConfig(OverwriteConfig{
+			Mode:  OverwriteModeDelayed,
+			Delay: time.Hour,
+		}))
+		before := objectCount(t, cluster)
+
+		oldMeta, newMeta := overwrite(t, cli)
+		// the old chunks are kept until the delay expires,
+		// or until the client is closed
+		require.Equal(t, before+chunkObjectCount(oldMeta)+chunkObjectCount(newMeta), objectCount(t, cluster))
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, cli.Read(*oldMeta, buf))
+		require.Equal(t, oldData, buf.Bytes())
+		require.NoError(t, cli.Delete(*newMeta))
+		require.NoError(t, cli.Close())
+		cluster, err = NewDataCluster(newDefaultConfig(shards, 64))
+		require.NoError(t, err)
+		require.Equal(t, before, objectCount(t, cluster))
+		require.NoError(t, cluster.Close())
+
+		// the old chunks are deleted once the delay expires
+		cli, cluster, err = getTestClient(newDefaultConfig(shards, 64))
+		require.NoError(t, err)
+		defer cli.Close()
+		require.NoError(t, cli.SetOverwriteConfig(OverwriteConfig{
+			Mode:  OverwriteModeDelayed,
+			Delay: time.Millisecond,
+		}))
+		_, newMeta = overwrite(t, cli)
+		expected := before + chunkObjectCount(newMeta)
+		for i := 0; i < 100 && objectCount(t, cluster) != expected; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		require.Equal(t, expected, objectCount(t, cluster))
+		require.NoError(t, cli.Delete(*newMeta))
+	})
+
+	t.Run("keep", func(t *testing.T) {
+		cli, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+		require.NoError(t, err)
+		defer cli.Close()
+		require.NoError(t, cli.SetOverwriteConfig(OverwriteConfig{Mode: OverwriteModeKeep}))
+		before := objectCount(t, cluster)
+
+		oldMeta, newMeta := overwrite(t, cli)
+		// the old chunks are kept, as part of an older version
+		require.Equal(t, before+chunkObjectCount(oldMeta)+chunkObjectCount(newMeta), objectCount(t, cluster))
+		version, err := cli.metastorClient.GetVersion([]byte("foo"), oldMeta.LastWriteEpoch)
+		require.NoError(t, err)
+		require.Equal(t, oldMeta.Chunks, version.Chunks)
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, cli.Read(*version, buf))
+		require.Equal(t, oldData, buf.Bytes())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cli, _, err := getTestClient(newDefaultConfig(shards, 64))
+		require.NoError(t, err)
+		defer cli.Close()
+		require.Error(t, cli.SetOverwriteConfig(OverwriteConfig{Mode: _MaxOverwriteMode + 1}))
+		require.Error(t, cli.SetOverwriteConfig(OverwriteConfig{Delay: -time.Second}))
+	})
+}
+
+func TestClientVersions(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 3)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	cli, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(t, err)
+	defer cli.Close()
+	require.NoError(t, cli.SetOverwriteConfig(OverwriteConfig{Mode: OverwriteModeKeep}))
+
+	key := []byte("foo")
+	read := func(md *metatypes.Metadata) []byte {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, cli.Read(*md, buf))
+		return buf.Bytes()
+	}
+
+	// write 3 versions of the same key
+	var (
+		data   [][]byte
+		epochs []int64
+	)
+	for i := 0; i < 3; i++ {
+		value := bytes.Repeat([]byte{byte('a' + i)}, 100*(i+1))
+		md, err := cli.Write(key, bytes.NewReader(value))
+		require.NoError(t, err)
+		data = append(data, value)
+		epochs = append(epochs, md.LastWriteEpoch)
+	}
+
+	// only the overwritten metadata is listed as a version
+	versions, err := cli.ListVersions(key)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	for i, version := range versions {
+		require.Equal(t, epochs[i], version.LastWriteEpoch)
+		require.Equal(t, data[i], read(&version))
+	}
+	md, err := cli.GetVersion(key, epochs[1])
+	require.NoError(t, err)
+	require.Equal(t, data[1], read(md))
+
+	// read as of a given epoch
+	_, err = cli.GetVersionAsOf(key, epochs[0]-1)
+	require.Equal(t, metastor.ErrNotFound, err)
+	for i, epoch := range epochs {
+		md, err = cli.GetVersionAsOf(key, epoch)
+		require.NoError(t, err)
+		require.Equal(t, data[i], read(md))
+	}
+	md, err = cli.GetVersionAsOf(key, EpochNow())
+	require.NoError(t, err)
+	require.Equal(t, data[2], read(md))
+
+	// restore the oldest version as the current one
+	before := objectCount(t, cluster)
+	restored, err := cli.RestoreVersion(key, epochs[0])
+	require.NoError(t, err)
+	require.True(t, restored.LastWriteEpoch > epochs[2])
+	md, err = cli.metastorClient.GetMetadata(key)
+	require.NoError(t, err)
+	require.Equal(t, data[0], read(md))
+	require.Equal(t, before, objectCount(t, cluster))
+	_, err = cli.GetVersion(key, epochs[0])
+	require.Equal(t, metastor.ErrNotFound, err)
+	versions, err = cli.ListVersions(key)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, data[1], read(&versions[0]))
+	require.Equal(t, data[2], read(&versions[1]))
+
+	// the previous state can still be read as of the time it was current
+	md, err = cli.GetVersionAsOf(key, restored.LastWriteEpoch-1)
+	require.NoError(t, err)
+	require.Equal(t, data[2], read(md))
+
+	// delete a single version, including its data
+	require.NoError(t, cli.DeleteVersion(key, epochs[1]))
+	require.Equal(t, before-len(versions[0].Chunks)*len(versions[0].Chunks[0].Objects), objectCount(t, cluster))
+	_, err = cli.GetVersion(key, epochs[1])
+	require.Equal(t, metastor.ErrNotFound, err)
+	require.Equal(t, metastor.ErrNotFound, cli.DeleteVersion(key, epochs[1]))
+
+	// versions of a deleted key can still be restored
+	md, err = cli.metastorClient.GetMetadata(key)
+	require.NoError(t, err)
+	require.NoError(t, cli.Delete(*md))
+	_, err = cli.metastorClient.GetMetadata(key)
+	require.Equal(t, metastor.ErrNotFound, err)
+	_, err = cli.RestoreVersion(key, epochs[2])
+	require.NoError(t, err)
+	md, err = cli.metastorClient.GetMetadata(key)
+	require.NoError(t, err)
+	require.Equal(t, data[2], read(md))
+
+	// versions require a metastor client
+	cli = NewClient(nil, cli.dataPipeline)
+	_, err = cli.ListVersions(key)
+	require.Equal(t, ErrNoMetastorClient, err)
+	_, err = cli.RestoreVersion(key, epochs[0])
+	require.Equal(t, ErrNoMetastorClient, err)
+}
+
+func TestClientContext(t *testing.T) {
+	require := require.New(t)
+
+	servers, serverClean := testZdbServer(t, 3)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+	cli, _, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(err)
+	defer cli.Close()
+
+	data := make([]byte, 512)
+	_, err = rand.Read(data)
+	require.NoError(err)
+
+	// a nil context is never allowed
+	_, err = cli.WriteContext(nil, []byte("foo"), bytes.NewReader(data))
+	require.Equal(ErrNilContext, err)
+
+	// a cancelled context aborts the write, and no metadata is stored
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = cli.WriteContext(ctx, []byte("foo"), bytes.NewReader(data))
+	require.Equal(context.Canceled, err)
+	_, err = cli.metastorClient.GetMetadata([]byte("foo"))
+	require.Equal(metastor.ErrNotFound, err)
+
+	// a context which is still valid behaves as the regular methods
+	ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	meta, err := cli.WriteContext(ctx, []byte("foo"), bytes.NewReader(data))
+	require.NoError(err)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(cli.ReadContext(ctx, *meta, buf))
+	require.Equal(data, buf.Bytes())
+	status, err := cli.CheckContext(ctx, *meta, false)
+	require.NoError(err)
+	require.Equal(storage.CheckStatusOptimal, status)
+
+	// an expired deadline aborts all other operations
+	expiredCtx, expiredCancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer expiredCancel()
+	err = cli.ReadContext(expiredCtx, *meta, bytes.NewBuffer(nil))
+	require.Equal(context.DeadlineExceeded, err)
+	err = cli.ReadRangeContext(expiredCtx, *meta, bytes.NewBuffer(nil), 10, 100)
+	require.Equal(context.DeadlineExceeded, err)
+	_, err = cli.CheckContext(expiredCtx, *meta, true)
+	require.Equal(context.DeadlineExceeded, err)
+	_, err = cli.RepairContext(expiredCtx, *meta)
+	require.Equal(context.DeadlineExceeded, err)
+	err = cli.DeleteContext(expiredCtx, *meta)
+	require.Equal(context.DeadlineExceeded, err)
+
+	// as the delete was aborted, the data should still be available
+	buf.Reset()
+	require.NoError(cli.ReadContext(ctx, *meta, buf))
+	require.Equal(data, buf.Bytes())
+	require.NoError(cli.DeleteContext(ctx, *meta))
+}
+
+func TestClientUserKeys(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 3)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+	// without encryption and with consistent-hash spreading,
+	// identical data is stored as identical objects on the same shards
+	cfg := newDefaultConfig(shards, 64)
+	cfg.DataStor.Pipeline.Encryption = pipeline.EncryptionConfig{}
+	cfg.DataStor.Spreading = datastor.SpreadingTypeConsistentHash
+	cfg.DataStor.KeyMode = datastor.KeyModeUser
+	cfg.DataStor.Provision.Enabled = true
+
+	// user keys enable deduplication, as identical chunks share their objects
+	metastorClient, err := getTestMetastorClient(cfg.Namespace)
+	require.NoError(t, err)
+	cli, err := NewClientFromConfig(cfg, metastorClient, -1)
+	require.NoError(t, err)
+	defer cli.Close()
+	require.True(t, cli.dedup)
+
+	data := bytes.Repeat([]byte("data"), 100)
+	read := func(key string) ([]byte, error) {
+		meta, err := cli.metastorClient.GetMetadata([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+		buf := bytes.NewBuffer(nil)
+		err = cli.Read(*meta, buf)
+		return buf.Bytes(), err
+	}
+
+	// overwriting a key with the same data frees only the objects of the old data
+	_, err = cli.Write([]byte("a"), bytes.NewReader(data))
+	require.NoError(t, err)
+	_, err = cli.Write([]byte("a"), bytes.NewReader(data))
+	require.NoError(t, err)
+	stored, err := read("a")
+	require.NoError(t, err)
+	require.Equal(t, data, stored)
+
+	// deleting one of two keys with the same data keeps the other one readable
+	metaB, err := cli.Write([]byte("b"), bytes.NewReader(data))
+	require.NoError(t, err)
+	_, err = cli.Write([]byte("c"), bytes.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, cli.Delete(*metaB))
+	stored, err = read("c")
+	require.NoError(t, err)
+	require.Equal(t, data, stored)
+}
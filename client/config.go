@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
@@ -64,6 +65,90 @@ type Config struct {
 	// MetaStor defines the configuration for the metadata shards (servers).
 	// For now only an ETCD cluster is supported using this config.
 	//MetaStor MetaStorConfig `yaml:"metastor" json:"metastor"`
+
+	// Overwrite defines what happens with the data of a key,
+	// when that key gets overwritten by a new write.
+	Overwrite OverwriteConfig `yaml:"overwrite" json:"overwrite"`
+
+	// Deduplication can be enabled in order to store identical chunks only once,
+	// reusing the chunks already stored, by indexing all chunks in the metastor.
+	// See Client.SetDeduplication for more information.
+	// It is always enabled when using user keys (see DataStorConfig.KeyMode).
+	Deduplication bool `yaml:"deduplication" json:"deduplication"`
+}
+
+// OverwriteConfig defines what happens with the chunks of the data
+// previously stored for a key, when that key gets overwritten.
+type OverwriteConfig struct {
+	// Mode defines how the superseded chunks are handled,
+	// by default they are deleted immediately.
+	Mode OverwriteMode `yaml:"mode" json:"mode"`
+	// Delay defines how long to wait before deleting the superseded chunks,
+	// only used for the delayed overwrite mode.
+	// DefaultOverwriteDelay is used if no delay is given.
+	Delay time.Duration `yaml:"delay" json:"delay"`
+}
+
+// DefaultOverwriteDelay is the delay used for the delayed overwrite mode,
+// in case no delay is configured.
+const DefaultOverwriteDelay = 5 * time.Minute
+
+// OverwriteMode defines how the superseded chunks
+// of an overwritten key are handled.
+type OverwriteMode uint8
+
+const (
+	// OverwriteModeDelete deletes the superseded chunks immediately,
+	// once the new metadata has been committed.
+	// This is the default overwrite mode.
+	OverwriteModeDelete OverwriteMode = iota
+	// OverwriteModeDelayed deletes the superseded chunks after a delay,
+	// such that readers which still use the old metadata can finish reading.
+	// Deletes which are still pending, get executed when the client is closed.
+	OverwriteModeDelayed
+	// OverwriteModeKeep keeps the superseded chunks,
+	// storing the superseded metadata as an older version of the key.
+	OverwriteModeKeep
+
+	_MaxOverwriteMode = OverwriteModeKeep
+)
+
+var _OverwriteModeStrings = []string{
+	"delete",
+	"delayed",
+	"keep",
+}
+
+// String implements Stringer.String
+func (mode OverwriteMode) String() string {
+	if mode > _MaxOverwriteMode {
+		return ""
+	}
+	return _OverwriteModeStrings[mode]
+}
+
+// MarshalText implements encoding.TextMarshaler.MarshalText
+func (mode OverwriteMode) MarshalText() (text []byte, err error) {
+	if mode > _MaxOverwriteMode {
+		return nil, fmt.Errorf("invalid in-memory overwrite mode %d", uint8(mode))
+	}
+	return []byte(_OverwriteModeStrings[mode]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.UnmarshalText
+func (mode *OverwriteMode) UnmarshalText(text []byte) error {
+	str := strings.ToLower(string(text))
+	if len(str) == 0 {
+		*mode = OverwriteModeDelete
+		return nil
+	}
+	for index, modeStr := range _OverwriteModeStrings {
+		if modeStr == str {
+			*mode = OverwriteMode(index)
+			return nil
+		}
+	}
+	return fmt.Errorf("overwrite mode %s not recognized or supported", str)
 }
 
 // DataStorConfig is used to configure a zstordb cluster.
@@ -75,6 +160,32 @@ type DataStorConfig struct {
 	// a cluster during writes.
 	Spreading datastor.SpreadingType `yaml:"spreading" json:"spreading"`
 
+	// KeyMode defines how the keys of the objects stored on the listed shards are defined,
+	// sequential (generated by the 0-db servers) by default.
+	// The user key mode derives the keys from the stored chunks in the client instead (see zerodb.UserKey),
+	// and requires the namespaces of all shards to be in user-key mode.
+	// As identical chunks share their objects, it enables Deduplication as well.
+	KeyMode datastor.KeyMode `yaml:"key_mode" json:"key_mode"`
+
+	// Health defines how the health of the listed shards is tracked,
+	// such that unhealthy shards can be skipped until they respond again.
+	Health datastor.HealthConfig `yaml:"health" json:"health"`
+
+	// Connection defines the connection pool, timeouts and retry policy
+	// used for all shards, each listed shard can override it using its own connection config.
+	Connection datastor.ConnectionConfig `yaml:"connection" json:"connection"`
+
+	// Provision defines, when enabled, that the namespaces of the listed shards
+	// are created (or updated) prior to connecting to them,
+	// using the admin commands of their 0-db servers.
+	Provision datastor.ProvisionConfig `yaml:"provision" json:"provision"`
+
+	// StatsInterval defines the interval at which the namespace information
+	// of all listed shards is refreshed, as used by the spreading algorithms.
+	// A default interval is used in case it is zero,
+	// use a negative interval to disable refreshing.
+	StatsInterval time.Duration `yaml:"stats_interval" json:"stats_interval"`
+
 	// Pipeline defines the object read/write pipeline configuration
 	// for this 0-stor client. It defines how to structure,
 	// process, identify and store all data to be written,
@@ -97,6 +208,12 @@ type DataStorTLSConfig struct {
 	// when not given, the system CA will be used
 	RootCA string `yaml:"root_ca" json:"root_ca"`
 
+	// optional client certificate and private key (PEM encoded files),
+	// used to authenticate the client to the server (mutual TLS),
+	// either both or none of them have to be given
+	ClientCert string `yaml:"client_cert" json:"client_cert"`
+	ClientKey  string `yaml:"client_key" json:"client_key"`
+
 	// optional min/max TLS versions, limiting the
 	// accepted TLS version used by the server
 	MinVersion TLSVersion `yaml:"min_version" json:"min_version"`
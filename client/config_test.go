@@ -19,12 +19,14 @@ package client
 import (
 	"crypto/tls"
 	"testing"
+	"time"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
 	"github.com/threefoldtech/0-stor/client/processing"
 
 	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
 )
 
 func TestDecodeZstorExampleConfig(t *testing.T) {
@@ -97,6 +99,106 @@ func TestTLSVersionMarshalUnmarshal(t *testing.T) {
 	}
 }
 
+func TestOverwriteModeMarshalUnmarshal(t *testing.T) {
+	testCases := []OverwriteMode{
+		OverwriteModeDelete, OverwriteModeDelayed, OverwriteModeKeep,
+	}
+	for _, testCase := range testCases {
+		text, err := testCase.MarshalText()
+		require.NoError(t, err)
+		var mode OverwriteMode
+		err = mode.UnmarshalText(text)
+		require.NoError(t, err)
+		require.Equal(t, testCase, mode)
+	}
+
+	var mode OverwriteMode
+	require.NoError(t, mode.UnmarshalText([]byte("KEEP")))
+	require.Equal(t, OverwriteModeKeep, mode)
+	require.NoError(t, mode.UnmarshalText(nil))
+	require.Equal(t, OverwriteModeDelete, mode)
+	require.Error(t, mode.UnmarshalText([]byte("foo")))
+	_, err := OverwriteMode(_MaxOverwriteMode + 1).MarshalText()
+	require.Error(t, err)
+}
+
+func TestOverwriteConfig(t *testing.T) {
+	var cfg Config
+	err := yaml.Unmarshal([]byte("overwrite:\n  mode: delayed\n  delay: 2m\n"), &cfg)
+	require.NoError(t, err)
+	require.Equal(t, OverwriteConfig{
+		Mode:  OverwriteModeDelayed,
+		Delay: 2 * time.Minute,
+	}, cfg.Overwrite)
+}
+
+func TestShardConfig(t *testing.T) {
+	var cfg Config
+	err := yaml.Unmarshal([]byte(`
+datastor:
+  provision:
+    enabled: true
+    max_size: 1073741824
+  shards:
+    - address: 127.0.0.1:12345
+      namespace: foo
+      password: bar
+      zone: a
+      rack: b
+      host: c
+      weight: 2
+      max_size: 2147483648
+      connection:
+        max_active: 5
+    - address: 127.0.0.1:12346
+`), &cfg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1073741824), cfg.DataStor.Provision.MaxSize)
+	require.Equal(t, []datastor.ShardConfig{
+		{
+			Address:    "127.0.0.1:12345",
+			Namespace:  "foo",
+			Password:   "bar",
+			Zone:       "a",
+			Rack:       "b",
+			Host:       "c",
+			Weight:     2,
+			MaxSize:    2147483648,
+			Connection: datastor.ConnectionConfig{MaxActive: 5},
+		},
+		{Address: "127.0.0.1:12346"},
+	}, cfg.DataStor.Shards)
+}
+
+func TestDataClusterConfig(t *testing.T) {
+	var cfg Config
+	cfg.Namespace = "ns"
+	cfg.Password = "secret"
+	cfg.DataStor = DataStorConfig{
+		Shards:        []datastor.ShardConfig{{Address: "127.0.0.1:12345"}},
+		Spreading:     datastor.SpreadingTypeConsistentHash,
+		KeyMode:       datastor.KeyModeUser,
+		Health:        datastor.HealthConfig{FailureThreshold: 3},
+		Connection:    datastor.ConnectionConfig{MaxActive: 5},
+		Provision:     datastor.ProvisionConfig{Enabled: true},
+		StatsInterval: time.Minute,
+	}
+
+	// all datastor options are applied to the cluster
+	clusterCfg, err := DataClusterConfig(cfg)
+	require.NoError(t, err)
+	require.Equal(t, cfg.DataStor.Shards, clusterCfg.Shards)
+	require.Equal(t, "ns", clusterCfg.Namespace)
+	require.Equal(t, "secret", clusterCfg.Password)
+	require.Nil(t, clusterCfg.TLSConfig)
+	require.Equal(t, cfg.DataStor.Spreading, clusterCfg.Spreading)
+	require.Equal(t, cfg.DataStor.KeyMode, clusterCfg.KeyMode)
+	require.Equal(t, cfg.DataStor.Health, clusterCfg.Health)
+	require.Equal(t, cfg.DataStor.Connection, clusterCfg.Connection)
+	require.Equal(t, cfg.DataStor.Provision, clusterCfg.Provision)
+	require.Equal(t, cfg.DataStor.StatsInterval, clusterCfg.StatsInterval)
+}
+
 func TestTLSVersionConfig(t *testing.T) {
 	tt := []struct {
 		input    string
@@ -32,32 +32,54 @@ import (
 // If the server requires authentication,
 // this will have to be configured when creating the client as well, otherwise the methods of this interface will fail.
 //
+// Each method which communicates with the server has a variant taking a context,
+// which can be used to cancel an operation in flight or to give it a deadline.
+// When the context is cancelled, or its deadline expires,
+// the method returns the context's error as soon as possible.
+// The variants without a context use context.Background().
+//
 // Errors that can be returned for all methods:
 //
 type Client interface {
 	// Creates an object, using the given data.
-	// The key of the object is generated by the server, and returned.
+	// The key of the object is generated by the server,
+	// or defined by the client in case it uses user keys, and returned.
 	CreateObject(data []byte) (key []byte, err error)
+	// CreateObjectContext creates an object just like CreateObject,
+	// aborting as soon as the given context is done.
+	CreateObjectContext(ctx context.Context, data []byte) (key []byte, err error)
 
 	// Get an existing object, linked to a given key.
 	//
 	// ErrKeyNotFound is returned in case the requested key couldn't be found.
 	// ErrObjectCorrupted is returned in case the stored object is corrupted.
 	GetObject(key []byte) (*Object, error)
+	// GetObjectContext gets an object just like GetObject,
+	// aborting as soon as the given context is done.
+	GetObjectContext(ctx context.Context, key []byte) (*Object, error)
 
 	// DeleteObject deletes an object, using a given key.
 	// Deleting an non-existing object is considered valid.
 	DeleteObject(key []byte) error
+	// DeleteObjectContext deletes an object just like DeleteObject,
+	// aborting as soon as the given context is done.
+	DeleteObjectContext(ctx context.Context, key []byte) error
 
 	// GetObjectStatus returns the status of an object,
 	// indicating whether it's OK, missing or corrupt.
 	GetObjectStatus(key []byte) (ObjectStatus, error)
+	// GetObjectStatusContext returns the status of an object just like GetObjectStatus,
+	// aborting as soon as the given context is done.
+	GetObjectStatusContext(ctx context.Context, key []byte) (ObjectStatus, error)
 
 	// ExistObject returns whether or not an object exists.
 	//
 	// ErrObjectCorrupted is returned in case the object key exists,
 	// but the object is corrupted.
 	ExistObject(key []byte) (bool, error)
+	// ExistObjectContext returns whether or not an object exists just like ExistObject,
+	// aborting as soon as the given context is done.
+	ExistObjectContext(ctx context.Context, key []byte) (bool, error)
 
 	// ListObjectKeyIterator returns an iterator,
 	// from which the keys of all stored objects within the namespace
@@ -76,6 +98,15 @@ type Client interface {
 	// ErrKeyNotFound is returned in case no
 	// stored namespace exist for the used label.
 	GetNamespace() (*Namespace, error)
+	// GetNamespaceContext returns the information of a namespace just like GetNamespace,
+	// aborting as soon as the given context is done.
+	GetNamespaceContext(ctx context.Context) (*Namespace, error)
+
+	// CachedNamespace returns the information of the namespace,
+	// as cached by the client from its last GetNamespace call,
+	// and updated locally with each object created since.
+	// It might be outdated, but doesn't require a call to the server.
+	CachedNamespace() *Namespace
 
 	// Utilization return the amount of bytes stored in
 	// the namespace the client is connected to
@@ -84,3 +115,19 @@ type Client interface {
 	// Close any open resources.
 	Close() error
 }
+
+// KeyedClient is the interface of a Client which can define the key of an object itself,
+// deriving it from the chunk the object is part of (see KeyModeUser).
+// It is optional, a Client which doesn't implement it creates all objects using CreateObject.
+type KeyedClient interface {
+	Client
+
+	// KeyMode returns the mode used to define the keys of created objects.
+	KeyMode() KeyMode
+
+	// CreateObjectPartContext creates an object just like CreateObjectContext,
+	// which stores the part with the given index of the chunk with the given hash.
+	// In KeyModeUser the key of the object is derived from the chunk hash and part index,
+	// such that all objects of the same chunk part have the same key.
+	CreateObjectPartContext(ctx context.Context, chunkHash []byte, part int, data []byte) (key []byte, err error)
+}
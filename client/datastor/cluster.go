@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"math"
 	"math/big"
 	mathRand "math/rand"
 	"sort"
@@ -31,6 +32,9 @@ var (
 	// ErrNoShardsAvailable is returned in case no shards are available in a cluster,
 	// e.g. after filtering them with an exception list of non-desired shards.
 	ErrNoShardsAvailable = errors.New("no shards available")
+	// ErrShardUnhealthy is returned in case a listed shard is requested,
+	// which is considered unhealthy by its cluster, and thus isn't handed out.
+	ErrShardUnhealthy = errors.New("shard is unhealthy")
 )
 
 // Cluster can be used to group a bunch of zstordb servers
@@ -66,10 +70,38 @@ type Cluster interface {
 	// ListedShardCount returns the amount of listed shards available in this cluster.
 	ListedShardCount() int
 
+	// GetShardHealth returns the health of all listed shards of this cluster,
+	// in the order they were listed.
+	GetShardHealth() []ShardHealth
+
 	// Close any open resources.
 	Close() error
 }
 
+// SeededCluster defines a cluster which can walk its shards
+// in an order defined by a seed, such as the data to be stored.
+// It is implemented by clusters supporting deterministic placement,
+// e.g. using a consistent-hash ring.
+type SeededCluster interface {
+	Cluster
+
+	// GetSeededShardIterator returns an iterator just like GetShardIterator,
+	// walking the shards in an order defined by the given seed,
+	// if supported by the spreading algorithm used by the cluster.
+	GetSeededShardIterator(seed []byte, exceptShards []string) ShardIterator
+}
+
+// ListedCluster defines a cluster which can hand out its listed shards,
+// regardless of their health. It is implemented by clusters tracking the health of their shards,
+// such that a shard which is no longer handed out can still be managed, e.g. drained.
+type ListedCluster interface {
+	Cluster
+
+	// GetListedShard returns the listed shard with the given identifier,
+	// even if it is considered unhealthy, and an error in case it isn't listed.
+	GetListedShard(id string) (Shard, error)
+}
+
 // ShardIterator defines the interface of an iterator which can be used
 // to get different shards, without ever getting the same shard back.
 //
@@ -91,9 +123,38 @@ type ShardIterator interface {
 
 // ShardConfig defines shard configuration
 type ShardConfig struct {
-	Address   string `json:"address"`
-	Namespace string `json:"namespace"`
-	Password  string `json:"password"`
+	Address   string `yaml:"address" json:"address"`
+	Namespace string `yaml:"namespace" json:"namespace"`
+	Password  string `yaml:"password" json:"password"`
+
+	// optional labels of the failure domains the shard belongs to,
+	// used to spread the parts of a chunk over different domains
+	Zone string `yaml:"zone" json:"zone"`
+	Rack string `yaml:"rack" json:"rack"`
+	Host string `yaml:"host" json:"host"`
+
+	// optional relative weight of the shard, used by the consistent hash
+	// and weighted random spreading algorithms,
+	// a shard with weight 2 receives twice as much data as a shard with weight 1;
+	// only the ratio between the weights of the shards matters,
+	// hence any scale can be used, such as the capacity of the shard in GB;
+	// a shard without weight gets the smallest weight defined by the other shards
+	// when using consistent hashing, while weighted random spreading only uses
+	// the configured weights in case all shards define one
+	Weight float64 `yaml:"weight" json:"weight"`
+
+	// optional size limit of the namespace of the shard in bytes,
+	// used when provisioning the namespace, overriding the global size limit
+	MaxSize int64 `yaml:"max_size" json:"max_size"`
+
+	// optional connection config of the shard,
+	// overriding the properties of the global connection config it defines
+	Connection ConnectionConfig `yaml:"connection" json:"connection"`
+}
+
+// Topology returns the failure domains defined for the shard.
+func (cfg ShardConfig) Topology() Topology {
+	return Topology{Zone: cfg.Zone, Rack: cfg.Rack, Host: cfg.Host}
 }
 
 // Shard defines the interface of a cluster shard.
@@ -107,6 +168,9 @@ type Shard interface {
 	Address() string
 	Namespace() string
 	Password() string
+
+	// Topology returns the failure domains this shard belongs to.
+	Topology() Topology
 }
 
 // ShardIteratorChannel takes a context and an iterator,
@@ -179,6 +243,11 @@ func (it *LazyShardIterator) Next() bool {
 		if err == nil {
 			return true
 		}
+		if err == ErrShardUnhealthy {
+			// the cluster already reported the shard as unhealthy
+			log.Debugf("LazyShardIterator: skipping unhealthy shard %q", shard)
+			continue
+		}
 		log.Errorf("LazyShardIterator: error while getting shard %q: %v", shard, err)
 	}
 	return false
@@ -284,13 +353,135 @@ func (it *LeastUsedShardIterator) Shard() Shard {
 	return it.current
 }
 
+// NewMostFreeShardIterator creates a new most free shard Iterator.
+// See `MostFreeShardIterator` for more information.
+// This function takes ownership of the slice passed as argument
+// So care must be taken if the caller still uses this slice afterwards
+func NewMostFreeShardIterator(slice []Shard) *MostFreeShardIterator {
+	free := make(map[Shard]int64, len(slice))
+	for _, shard := range slice {
+		if ns := shard.CachedNamespace(); ns != nil {
+			free[shard] = ns.Free
+		}
+	}
+	sort.SliceStable(slice, func(i, j int) bool {
+		fi, fj := free[slice[i]], free[slice[j]]
+		if fi != fj {
+			return fi > fj
+		}
+		return slice[i].Utilization() < slice[j].Utilization()
+	})
+	it := &MostFreeShardIterator{
+		slice: slice,
+	}
+	return it
+}
+
+// MostFreeShardIterator implements the ShardIterator interface,
+// in order to get a unique datastor client for each iteration,
+// sorted by the free space of their namespace, as last cached by those clients.
+// Shards with equal free space (e.g. because it isn't known),
+// are sorted by the amount of bytes they store instead.
+// The iterator is finished when all clients of the cluster have been exhausted.
+type MostFreeShardIterator struct {
+	slice   []Shard
+	current Shard
+}
+
+// Next implements ShardIterator.Next
+func (it *MostFreeShardIterator) Next() bool {
+	if len(it.slice) < 1 {
+		return false
+	}
+	it.current = it.slice[0]
+	it.slice = it.slice[1:]
+	return true
+}
+
+// Shard implements ShardIterator.Shard
+func (it *MostFreeShardIterator) Shard() Shard {
+	if it.current == nil {
+		panic("invalid shard iterator, ensure to make a successful Next() call first")
+	}
+	return it.current
+}
+
+// NewWeightedRandomShardIterator creates a new weighted random shard Iterator.
+// See `WeightedRandomShardIterator` for more information.
+// weights defines the weight of the shard at the same index,
+// shards with a weight `<= 0` are only returned after all other shards.
+// This function takes ownership of the slice passed as argument
+// So care must be taken if the caller still uses this slice afterwards
+func NewWeightedRandomShardIterator(slice []Shard, weights []float64) *WeightedRandomShardIterator {
+	type weightedShard struct {
+		shard Shard
+		key   float64
+	}
+	weighted := make([]weightedShard, len(slice))
+	for index, shard := range slice {
+		key := math.Inf(-1)
+		if index < len(weights) && weights[index] > 0 {
+			// weighted random sampling (Efraimidis-Spirakis),
+			// using the logarithm of the key u^(1/w) for precision
+			key = math.Log(1-mathRand.Float64()) / weights[index]
+		}
+		weighted[index] = weightedShard{shard: shard, key: key}
+	}
+	// shuffle first, such that shards without weight are returned in random order
+	mathRand.Shuffle(len(weighted), func(i, j int) {
+		weighted[i], weighted[j] = weighted[j], weighted[i]
+	})
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].key > weighted[j].key
+	})
+	for index := range weighted {
+		slice[index] = weighted[index].shard
+	}
+	return &WeightedRandomShardIterator{
+		slice: slice,
+	}
+}
+
+// WeightedRandomShardIterator implements the ShardIterator interface,
+// in order to get a unique pseudo-random datastor client for each iteration,
+// where the chance of a shard to be returned before any other remaining shard
+// is proportional to its weight.
+// The iterator is finished when all clients of the cluster have been exhausted.
+type WeightedRandomShardIterator struct {
+	slice   []Shard
+	current Shard
+}
+
+// Next implements ShardIterator.Next
+func (it *WeightedRandomShardIterator) Next() bool {
+	if len(it.slice) < 1 {
+		return false
+	}
+	it.current = it.slice[0]
+	it.slice = it.slice[1:]
+	return true
+}
+
+// Shard implements ShardIterator.Shard
+func (it *WeightedRandomShardIterator) Shard() Shard {
+	if it.current == nil {
+		panic("invalid shard iterator, ensure to make a successful Next() call first")
+	}
+	return it.current
+}
+
 var (
 	_ ShardIterator = (*LazyShardIterator)(nil)
 	_ ShardIterator = (*RandomShardIterator)(nil)
 	_ ShardIterator = (*LeastUsedShardIterator)(nil)
+	_ ShardIterator = (*MostFreeShardIterator)(nil)
+	_ ShardIterator = (*WeightedRandomShardIterator)(nil)
 )
 
 func init() {
 	RegisterSpreadingType(SpreadingTypeRandom, "random")
 	RegisterSpreadingType(SpreadingTypeLeastUsed, "least_used")
+	RegisterSpreadingType(SpreadingTypeMostFree, "most_free")
+	RegisterSpreadingType(SpreadingTypeConsistentHash, "consistent_hash")
+	RegisterSpreadingType(SpreadingTypeWeightedRandom, "weighted_random")
 }
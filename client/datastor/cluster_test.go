@@ -132,6 +132,38 @@ func TestRandomShardIterator(t *testing.T) {
 	require.Equal(lastID, it.Shard().Identifier())
 }
 
+func TestWeightedRandomShardIterator(t *testing.T) {
+	require := require.New(t)
+
+	it := NewWeightedRandomShardIterator(nil, nil)
+	require.Panics(func() {
+		it.Shard()
+	}, "need to call next first")
+	require.False(it.Next())
+
+	shards := func() []Shard {
+		return []Shard{&stubShard{id: "a"}, &stubShard{id: "b"}, &stubShard{id: "c"}, &stubShard{id: "d"}}
+	}
+
+	const iterations = 10000
+	firsts := make(map[string]int)
+	for i := 0; i < iterations; i++ {
+		it = NewWeightedRandomShardIterator(shards(), []float64{1, 3, 0, -1})
+		var ids string
+		for it.Next() {
+			ids += it.Shard().Identifier()
+		}
+		// each shard is returned once, those without weight last
+		require.Len(ids, 4)
+		require.Contains([]string{"ab", "ba"}, ids[:2])
+		require.Contains([]string{"cd", "dc"}, ids[2:])
+		firsts[ids[:1]]++
+	}
+	// shards are returned first relative to their weight
+	require.InDelta(iterations/4, firsts["a"], iterations/20)
+	require.InDelta(iterations*3/4, firsts["b"], iterations/20)
+}
+
 func TestLazyShardIteratorPanics(t *testing.T) {
 	require := require.New(t)
 
@@ -187,7 +219,8 @@ type (
 
 	stubShard struct {
 		Client
-		id string
+		id       string
+		topology Topology
 	}
 )
 
@@ -200,6 +233,9 @@ func (sc *stubShard) Namespace() string {
 func (sc *stubShard) Password() string {
 	return ""
 }
+func (sc *stubShard) Topology() Topology {
+	return sc.topology
+}
 
 func (sc *stubCluster) GetShard(id string) (Shard, error) {
 	return &stubShard{id: id}, nil
@@ -220,6 +256,14 @@ func (sc *stubCluster) ListedShardCount() int {
 	return len(sc.shards)
 }
 
+func (sc *stubCluster) GetShardHealth() []ShardHealth {
+	health := make([]ShardHealth, len(sc.shards))
+	for i, shard := range sc.shards {
+		health[i] = ShardHealth{Shard: shard}
+	}
+	return health
+}
+
 func (sc *stubCluster) Close() error { return nil }
 
 func (sc *stubCluster) filteredSlice(exceptShards []string) []Shard {
@@ -0,0 +1,168 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultMaxActive is the maximum amount of connections used,
+	// in case no maximum is defined in the ConnectionConfig.
+	DefaultMaxActive = 5
+	// DefaultConnectTimeout is the connect timeout used,
+	// in case no connect timeout is defined in the ConnectionConfig.
+	DefaultConnectTimeout = 3 * time.Second
+	// DefaultReadTimeout is the read timeout used,
+	// in case no read timeout is defined in the ConnectionConfig.
+	DefaultReadTimeout = 60 * time.Second
+	// DefaultWriteTimeout is the write timeout used,
+	// in case no write timeout is defined in the ConnectionConfig.
+	DefaultWriteTimeout = 60 * time.Second
+
+	// DefaultMaxRetries is the amount of retries used,
+	// in case no amount of retries is defined in the RetryConfig.
+	DefaultMaxRetries = 2
+	// DefaultInitialBackoff is the initial backoff used,
+	// in case no initial backoff is defined in the RetryConfig.
+	DefaultInitialBackoff = 50 * time.Millisecond
+	// DefaultMaxBackoff is the maximum backoff used,
+	// in case no maximum backoff is defined in the RetryConfig.
+	DefaultMaxBackoff = 2 * time.Second
+)
+
+// ConnectionConfig defines the connection pool, timeouts
+// and retry policy used by the client of a shard.
+//
+// All properties are optional, the zero value of a property
+// meaning that its default (or globally configured) value is used.
+type ConnectionConfig struct {
+	// MaxActive defines the maximum amount of connections to the shard,
+	// DefaultMaxActive is used in case it is zero,
+	// use a negative maximum for an unlimited amount of connections.
+	MaxActive int `yaml:"max_active" json:"max_active"`
+
+	// MaxIdle defines the maximum amount of idle connections kept open to the shard,
+	// MaxActive is used in case it is zero.
+	MaxIdle int `yaml:"max_idle" json:"max_idle"`
+
+	// ConnectTimeout, ReadTimeout and WriteTimeout define the timeouts
+	// used to connect to, read from and write to the shard,
+	// DefaultConnectTimeout, DefaultReadTimeout and DefaultWriteTimeout
+	// are used in case they are zero, use a negative timeout to disable it.
+	ConnectTimeout time.Duration `yaml:"connect_timeout" json:"connect_timeout"`
+	ReadTimeout    time.Duration `yaml:"read_timeout" json:"read_timeout"`
+	WriteTimeout   time.Duration `yaml:"write_timeout" json:"write_timeout"`
+
+	// Retry defines how calls which failed with a retryable error are retried.
+	Retry RetryConfig `yaml:"retry" json:"retry"`
+}
+
+// RetryConfig defines how calls which failed with a retryable error are retried,
+// using an exponential backoff with jitter.
+// See `IsRetryableError` for more information about which errors are retryable.
+type RetryConfig struct {
+	// MaxRetries defines the maximum amount of times a call is retried,
+	// DefaultMaxRetries is used in case it is zero,
+	// use a negative amount to disable retries.
+	MaxRetries int `yaml:"max_retries" json:"max_retries"`
+
+	// InitialBackoff defines the backoff prior to the first retry,
+	// which is doubled for each next retry, up to the MaxBackoff.
+	// A random jitter of up to half of the backoff is subtracted from it.
+	// DefaultInitialBackoff and DefaultMaxBackoff are used in case they are zero.
+	InitialBackoff time.Duration `yaml:"initial_backoff" json:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" json:"max_backoff"`
+}
+
+// Merge returns a copy of this config,
+// with all properties defined by the given config overriding the ones of this config.
+// It can be used to apply the config of a single shard on top of the global config.
+func (cfg ConnectionConfig) Merge(other ConnectionConfig) ConnectionConfig {
+	if other.MaxActive != 0 {
+		cfg.MaxActive = other.MaxActive
+	}
+	if other.MaxIdle != 0 {
+		cfg.MaxIdle = other.MaxIdle
+	}
+	if other.ConnectTimeout != 0 {
+		cfg.ConnectTimeout = other.ConnectTimeout
+	}
+	if other.ReadTimeout != 0 {
+		cfg.ReadTimeout = other.ReadTimeout
+	}
+	if other.WriteTimeout != 0 {
+		cfg.WriteTimeout = other.WriteTimeout
+	}
+	if other.Retry.MaxRetries != 0 {
+		cfg.Retry.MaxRetries = other.Retry.MaxRetries
+	}
+	if other.Retry.InitialBackoff != 0 {
+		cfg.Retry.InitialBackoff = other.Retry.InitialBackoff
+	}
+	if other.Retry.MaxBackoff != 0 {
+		cfg.Retry.MaxBackoff = other.Retry.MaxBackoff
+	}
+	return cfg
+}
+
+// IsRetryableError returns true in case the given error, as returned by a Client call,
+// is a transient error, such as a network reset or timeout,
+// in which case it makes sense to retry the call.
+//
+// Errors which indicate the call was handled by the server,
+// such as ErrKeyNotFound, ErrObjectCorrupted and ErrNamespaceFull,
+// as well as errors of the context of the call, are never retryable.
+func IsRetryableError(err error) bool {
+	switch err {
+	case nil, ErrKeyNotFound, ErrObjectCorrupted, ErrNamespaceFull,
+		context.Canceled, context.DeadlineExceeded:
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// ProvisionConfig defines how the namespaces of the listed shards are provisioned,
+// using the admin commands of their servers, prior to connecting to them.
+// Namespaces are created in case they don't exist yet,
+// and their password, size limit and mode are (re)applied in case they do.
+type ProvisionConfig struct {
+	// Enabled has to be true in order to provision the namespaces.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// AdminPassword is the admin password of the servers, if any.
+	AdminPassword string `yaml:"admin_password" json:"admin_password"`
+
+	// MaxSize defines the size limit of the namespaces in bytes,
+	// the namespaces are unlimited in case it is zero.
+	// Each shard can override it using the max size of its ShardConfig.
+	MaxSize int64 `yaml:"max_size" json:"max_size"`
+
+	// Public defines whether or not the namespaces can be read without password.
+	Public bool `yaml:"public" json:"public"`
+}
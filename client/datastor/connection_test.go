@@ -0,0 +1,76 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionConfigMerge(t *testing.T) {
+	require := require.New(t)
+
+	global := ConnectionConfig{
+		MaxActive:   10,
+		ReadTimeout: time.Second,
+		Retry:       RetryConfig{MaxRetries: 3},
+	}
+	cfg := global.Merge(ConnectionConfig{
+		MaxActive: 20,
+		Retry:     RetryConfig{InitialBackoff: time.Millisecond},
+	})
+	require.Equal(ConnectionConfig{
+		MaxActive:   20,
+		ReadTimeout: time.Second,
+		Retry:       RetryConfig{MaxRetries: 3, InitialBackoff: time.Millisecond},
+	}, cfg)
+	require.Equal(global, global.Merge(ConnectionConfig{}))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	require := require.New(t)
+
+	for _, err := range []error{
+		nil,
+		ErrKeyNotFound,
+		ErrObjectCorrupted,
+		ErrNamespaceFull,
+		context.Canceled,
+		context.DeadlineExceeded,
+		errors.New("some server error"),
+	} {
+		require.False(IsRetryableError(err), "%v", err)
+	}
+
+	for _, err := range []error{
+		io.EOF,
+		io.ErrUnexpectedEOF,
+		syscall.ECONNRESET,
+		fmt.Errorf("failed to dial: %w", syscall.ECONNREFUSED),
+		&net.OpError{Op: "read", Err: errors.New("i/o timeout")},
+	} {
+		require.True(IsRetryableError(err), "%v", err)
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// DefaultVirtualNodes is the amount of virtual nodes,
+// placed on a HashRing per unit of weight of a shard,
+// in case no amount is given.
+const DefaultVirtualNodes = 128
+
+// MaxVirtualNodes is the maximum amount of virtual nodes,
+// placed on a HashRing for a single shard.
+const MaxVirtualNodes = 16 * DefaultVirtualNodes
+
+// NewHashRing creates a new consistent-hash ring,
+// placing virtualNodes virtual nodes on the ring for each unit of weight of a shard.
+// See `HashRing` for more information.
+//
+// weights is optional, and defines the weight of the shard at the same index.
+// Only the ratio between the weights matters, as they are normalized
+// relative to the smallest positive weight, which is also used for weights `<= 0`.
+// In case the heaviest shard would get more than MaxVirtualNodes virtual nodes,
+// the amount of virtual nodes of all shards is scaled down proportionally,
+// such that any weight scale (e.g. the capacity in GB) can be used.
+// virtualNodes can be `<= 0` in order to use DefaultVirtualNodes.
+func NewHashRing(shards []Shard, weights []float64, virtualNodes int) *HashRing {
+	if virtualNodes <= 0 {
+		virtualNodes = DefaultVirtualNodes
+	}
+	var minWeight, maxWeight float64
+	for index := range shards {
+		if index < len(weights) && weights[index] > 0 {
+			if minWeight == 0 || weights[index] < minWeight {
+				minWeight = weights[index]
+			}
+			if weights[index] > maxWeight {
+				maxWeight = weights[index]
+			}
+		}
+	}
+	if minWeight == 0 {
+		minWeight, maxWeight = 1, 1
+	}
+	nodesPerWeight := float64(virtualNodes) / minWeight
+	if maxWeight*nodesPerWeight > MaxVirtualNodes {
+		nodesPerWeight = MaxVirtualNodes / maxWeight
+	}
+	ring := &HashRing{shardCount: len(shards)}
+	for index, shard := range shards {
+		weight := minWeight
+		if index < len(weights) && weights[index] > 0 {
+			weight = weights[index]
+		}
+		count := int(math.Round(weight * nodesPerWeight))
+		if count < 1 {
+			count = 1
+		}
+		id := shard.Identifier()
+		for i := 0; i < count; i++ {
+			ring.points = append(ring.points, ringPoint{
+				hash:  ringHash([]byte(id + "#" + strconv.Itoa(i))),
+				shard: shard,
+			})
+		}
+	}
+	sort.Slice(ring.points, func(i, j int) bool {
+		return ring.points[i].hash < ring.points[j].hash
+	})
+	return ring
+}
+
+// HashRing is a consistent-hash ring, on which each shard owns
+// a number of virtual nodes, proportional to its weight.
+// Data is placed on the shards found by walking the ring clockwise,
+// starting from the position of a seed, such as the hash of that data.
+//
+// The placement is thus deterministic, and adding or removing a shard
+// only moves the data of the ring segments owned by that shard.
+type HashRing struct {
+	points     []ringPoint
+	shardCount int
+}
+
+type ringPoint struct {
+	hash  uint64
+	shard Shard
+}
+
+// ShardIterator returns an iterator walking the ring clockwise,
+// starting from the position of the given seed, returning each shard only once.
+// A random position is used in case no seed is given.
+//
+// filter is optional, and can be used to skip the shards for which it returns false.
+func (ring *HashRing) ShardIterator(seed []byte, filter func(Shard) bool) *ConsistentHashShardIterator {
+	it := &ConsistentHashShardIterator{
+		ring:   ring,
+		filter: filter,
+		seen:   make(map[Shard]struct{}, ring.shardCount),
+	}
+	if len(ring.points) == 0 {
+		return it
+	}
+	if seed == nil {
+		it.index = int(RandShardIndex(int64(len(ring.points))))
+	} else {
+		hash := ringHash(seed)
+		it.index = sort.Search(len(ring.points), func(i int) bool {
+			return ring.points[i].hash >= hash
+		}) % len(ring.points)
+	}
+	return it
+}
+
+// ConsistentHashShardIterator implements the ShardIterator interface,
+// walking the virtual nodes of a HashRing clockwise, returning each shard once.
+// The iterator is finished when the ring has been walked completely.
+type ConsistentHashShardIterator struct {
+	ring    *HashRing
+	filter  func(Shard) bool
+	seen    map[Shard]struct{}
+	index   int
+	steps   int
+	current Shard
+}
+
+// Next implements ShardIterator.Next
+func (it *ConsistentHashShardIterator) Next() bool {
+	points := it.ring.points
+	for it.steps < len(points) && len(it.seen) < it.ring.shardCount {
+		shard := points[it.index].shard
+		it.index = (it.index + 1) % len(points)
+		it.steps++
+		if _, ok := it.seen[shard]; ok {
+			continue
+		}
+		it.seen[shard] = struct{}{}
+		if it.filter != nil && !it.filter(shard) {
+			continue
+		}
+		it.current = shard
+		return true
+	}
+	return false
+}
+
+// Shard implements ShardIterator.Shard
+func (it *ConsistentHashShardIterator) Shard() Shard {
+	if it.current == nil {
+		panic("invalid shard iterator, ensure to make a successful Next() call first")
+	}
+	return it.current
+}
+
+// ringHash returns the position of the given data on a HashRing.
+func ringHash(data []byte) uint64 {
+	sum := blake2b.Sum256(data)
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+var (
+	_ ShardIterator = (*ConsistentHashShardIterator)(nil)
+)
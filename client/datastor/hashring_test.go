@@ -0,0 +1,160 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashRingIteratorPanics(t *testing.T) {
+	it := NewHashRing(nil, nil, 0).ShardIterator([]byte("seed"), nil)
+	require.False(t, it.Next())
+	require.Panics(t, func() {
+		it.Shard()
+	}, "no shard available")
+}
+
+func TestHashRingIterator(t *testing.T) {
+	require := require.New(t)
+
+	shards := hashRingStubShards(8)
+	ring := NewHashRing(shards, nil, 0)
+
+	for _, seed := range [][]byte{nil, []byte("foo"), []byte("bar")} {
+		// each shard is returned once
+		seen := make(map[string]struct{})
+		it := ring.ShardIterator(seed, nil)
+		for it.Next() {
+			id := it.Shard().Identifier()
+			require.NotContains(seen, id)
+			seen[id] = struct{}{}
+		}
+		require.Len(seen, len(shards))
+	}
+
+	// the same seed walks the same shards, even when listed in another order
+	reversed := make([]Shard, len(shards))
+	for i, shard := range shards {
+		reversed[len(shards)-1-i] = shard
+	}
+	other := NewHashRing(reversed, nil, 0)
+	for i := 0; i < 64; i++ {
+		seed := []byte(fmt.Sprint("seed", i))
+		require.Equal(hashRingIDs(ring.ShardIterator(seed, nil)),
+			hashRingIDs(other.ShardIterator(seed, nil)))
+	}
+
+	// filtered shards are skipped
+	it := ring.ShardIterator([]byte("foo"), func(shard Shard) bool {
+		return shard.Identifier() != "shard3"
+	})
+	ids := hashRingIDs(it)
+	require.Len(ids, len(shards)-1)
+	require.NotContains(ids, "shard3")
+}
+
+func TestHashRingDistribution(t *testing.T) {
+	require := require.New(t)
+
+	const keyCount = 10000
+	owners := func(ring *HashRing) []string {
+		owners := make([]string, keyCount)
+		for i := range owners {
+			it := ring.ShardIterator([]byte(fmt.Sprint("key", i)), nil)
+			require.True(it.Next())
+			owners[i] = it.Shard().Identifier()
+		}
+		return owners
+	}
+
+	// adding a shard only moves the keys it now owns
+	shards := hashRingStubShards(5)
+	before := owners(NewHashRing(shards[:4], nil, 0))
+	after := owners(NewHashRing(shards, nil, 0))
+	var moved int
+	for i := range before {
+		if before[i] != after[i] {
+			require.Equal("shard4", after[i])
+			moved++
+		}
+	}
+	require.InDelta(keyCount/5, moved, keyCount/20)
+
+	// a shard receives keys proportional to its weight
+	counts := make(map[string]int)
+	for _, owner := range owners(NewHashRing(shards[:2], []float64{1, 3}, 0)) {
+		counts[owner]++
+	}
+	require.InDelta(keyCount/4, counts["shard0"], keyCount/20)
+	require.InDelta(keyCount*3/4, counts["shard1"], keyCount/20)
+
+	// weights are relative, hence the scale doesn't matter
+	require.Equal(
+		owners(NewHashRing(shards[:2], []float64{1, 3}, 0)),
+		owners(NewHashRing(shards[:2], []float64{16000, 48000}, 0)))
+}
+
+func TestHashRingVirtualNodes(t *testing.T) {
+	require := require.New(t)
+
+	shards := hashRingStubShards(3)
+	pointCounts := func(ring *HashRing) map[string]int {
+		counts := make(map[string]int)
+		for _, point := range ring.points {
+			counts[point.shard.Identifier()]++
+		}
+		return counts
+	}
+
+	// weights are normalized relative to the smallest positive weight
+	require.Equal(map[string]int{
+		"shard0": DefaultVirtualNodes,
+		"shard1": 2 * DefaultVirtualNodes,
+		"shard2": DefaultVirtualNodes,
+	}, pointCounts(NewHashRing(shards, []float64{16000, 32000, 0}, 0)))
+
+	// the heaviest shard gets at most MaxVirtualNodes virtual nodes
+	require.Equal(map[string]int{
+		"shard0": MaxVirtualNodes / 64,
+		"shard1": MaxVirtualNodes / 2,
+		"shard2": MaxVirtualNodes,
+	}, pointCounts(NewHashRing(shards, []float64{1, 32, 64}, 0)))
+	require.Equal(map[string]int{
+		"shard0": 1,
+		"shard1": 1,
+		"shard2": MaxVirtualNodes,
+	}, pointCounts(NewHashRing(shards, []float64{1, 1, 1e9}, 0)))
+}
+
+func hashRingStubShards(count int) []Shard {
+	shards := make([]Shard, count)
+	for i := range shards {
+		shards[i] = &stubShard{id: fmt.Sprint("shard", i)}
+	}
+	return shards
+}
+
+func hashRingIDs(it ShardIterator) []string {
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Shard().Identifier())
+	}
+	return ids
+}
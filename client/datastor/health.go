@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultFailureThreshold is the failure threshold used,
+	// in case no failure threshold is defined in the HealthConfig.
+	DefaultFailureThreshold = 3
+	// DefaultProbeInterval is the probe interval used,
+	// in case no probe interval is defined in the HealthConfig.
+	DefaultProbeInterval = 10 * time.Second
+	// DefaultLatencyThreshold is the latency threshold used,
+	// in case no latency threshold is defined in the HealthConfig.
+	DefaultLatencyThreshold = 2 * time.Second
+)
+
+// HealthConfig defines how the health of the shards of a cluster is tracked.
+type HealthConfig struct {
+	// FailureThreshold defines the amount of consecutive failed calls,
+	// after which a shard is considered unhealthy, and is no longer handed out,
+	// until a probe readmits it. DefaultFailureThreshold is used in case it is zero,
+	// use a negative threshold to never consider a shard unhealthy.
+	FailureThreshold int `yaml:"failure_threshold" json:"failure_threshold"`
+
+	// ProbeInterval defines the interval at which all shards are probed,
+	// readmitting unhealthy shards which respond again,
+	// and collecting the health counters of their namespace.
+	// DefaultProbeInterval is used in case it is zero,
+	// use a negative interval to disable probing.
+	ProbeInterval time.Duration `yaml:"probe_interval" json:"probe_interval"`
+
+	// LatencyThreshold defines the average latency of calls,
+	// above which a shard is considered degraded.
+	// DefaultLatencyThreshold is used in case it is zero,
+	// use a negative threshold to ignore the latency.
+	LatencyThreshold time.Duration `yaml:"latency_threshold" json:"latency_threshold"`
+}
+
+// ShardState defines the health state of a shard.
+type ShardState uint8
+
+const (
+	// ShardStateHealthy is the state of a shard,
+	// which responds to calls without any issues.
+	ShardStateHealthy ShardState = iota
+	// ShardStateDegraded is the state of a shard,
+	// which is still handed out, but which failed calls recently,
+	// responds slowly, or of which the namespace reported new I/O errors or faults.
+	ShardStateDegraded
+	// ShardStateUnhealthy is the state of a shard,
+	// which failed too many consecutive calls, and is no longer handed out,
+	// until a probe readmits it.
+	ShardStateUnhealthy
+)
+
+// String implements Stringer.String
+func (state ShardState) String() string {
+	str, ok := _ShardStateValueToStringMapping[state]
+	if !ok {
+		return fmt.Sprint(uint8(state))
+	}
+	return str
+}
+
+// MarshalText implements encoding.TextMarshaler.MarshalText
+func (state ShardState) MarshalText() ([]byte, error) {
+	str, ok := _ShardStateValueToStringMapping[state]
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a valid ShardState value", state)
+	}
+	return []byte(str), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.UnmarshalText
+func (state *ShardState) UnmarshalText(text []byte) error {
+	var ok bool
+	*state, ok = _ShardStateStringToValueMapping[strings.ToLower(string(text))]
+	if !ok {
+		return fmt.Errorf("'%s' is not a valid ShardState string", text)
+	}
+	return nil
+}
+
+var (
+	_ShardStateValueToStringMapping = map[ShardState]string{
+		ShardStateHealthy:   "healthy",
+		ShardStateDegraded:  "degraded",
+		ShardStateUnhealthy: "unhealthy",
+	}
+	_ShardStateStringToValueMapping = map[string]ShardState{
+		"healthy":   ShardStateHealthy,
+		"degraded":  ShardStateDegraded,
+		"unhealthy": ShardStateUnhealthy,
+	}
+)
+
+// ShardHealth defines the health of a shard, as tracked by its cluster.
+type ShardHealth struct {
+	// Shard is the identifier of the shard.
+	Shard string
+	// State is the current health state of the shard.
+	State ShardState
+	// Since is the moment the shard got into its current state.
+	Since time.Time
+	// Failures is the amount of consecutive failed calls.
+	Failures int
+	// LastError is the error of the last failed call, if any.
+	LastError error
+	// LastErrorTime is the moment of the last failed call, if any.
+	LastErrorTime time.Time
+	// Latency is the (moving) average latency of successful calls.
+	Latency time.Duration
+	// Namespace contains the health counters of the namespace,
+	// as collected by the last successful probe, if any.
+	Namespace *Health
+}
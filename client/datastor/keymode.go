@@ -0,0 +1,79 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeyMode defines how the keys of created objects are defined.
+type KeyMode uint8
+
+const (
+	// KeyModeSequential is the enum constant that identifies
+	// the mode in which the key of an object is generated by the server,
+	// when the object is created.
+	KeyModeSequential KeyMode = iota
+
+	// KeyModeUser is the enum constant that identifies
+	// the mode in which the key of an object is derived by the client from the chunk it is part of,
+	// such that a call which creates an object can be retried without storing it twice,
+	// and identical chunk parts are stored only once per shard, under the same key.
+	// It requires the namespaces of the shards to be in user-key mode.
+	KeyModeUser
+
+	// DefaultKeyMode represent the default value
+	// for the KeyMode.
+	DefaultKeyMode = KeyModeSequential
+)
+
+// String implements Stringer.String
+func (mode KeyMode) String() string {
+	switch mode {
+	case KeyModeSequential:
+		return "sequential"
+	case KeyModeUser:
+		return "user"
+	default:
+		return ""
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.MarshalText
+func (mode KeyMode) MarshalText() ([]byte, error) {
+	str := mode.String()
+	if str == "" {
+		return nil, fmt.Errorf("'%d' is not a valid KeyMode value", uint8(mode))
+	}
+	return []byte(str), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.UnmarshalText
+func (mode *KeyMode) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "":
+		*mode = DefaultKeyMode
+	case "sequential":
+		*mode = KeyModeSequential
+	case "user":
+		*mode = KeyModeUser
+	default:
+		return fmt.Errorf("'%s' is not a valid KeyMode string", text)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultLatencySampleCount is the amount of latency samples kept per shard,
+// in case no (positive) sample count is given to NewLatencyTracker.
+const DefaultLatencySampleCount = 64
+
+// NewLatencyTracker creates a new LatencyTracker,
+// keeping the given amount of most recent samples per shard.
+// DefaultLatencySampleCount is used in case sampleCount is zero or lower.
+func NewLatencyTracker(sampleCount int) *LatencyTracker {
+	if sampleCount <= 0 {
+		sampleCount = DefaultLatencySampleCount
+	}
+	return &LatencyTracker{
+		sampleCount: sampleCount,
+		shards:      make(map[string]*latencySamples),
+	}
+}
+
+// LatencyTracker tracks the latency of the calls made to shards,
+// keeping a window of the most recent samples of each shard,
+// such that the percentiles of their latency can be computed.
+//
+// A LatencyTracker is safe for concurrent use.
+type LatencyTracker struct {
+	mux         sync.Mutex
+	sampleCount int
+	shards      map[string]*latencySamples
+}
+
+// latencySamples is a ring buffer of the latency samples of a single shard.
+type latencySamples struct {
+	samples []time.Duration
+	next    int
+}
+
+// Record records the latency of a single call made to the given shard.
+func (t *LatencyTracker) Record(shard string, latency time.Duration) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	s, ok := t.shards[shard]
+	if !ok {
+		s = &latencySamples{samples: make([]time.Duration, 0, t.sampleCount)}
+		t.shards[shard] = s
+	}
+	if len(s.samples) < t.sampleCount {
+		s.samples = append(s.samples, latency)
+		return
+	}
+	s.samples[s.next] = latency
+	s.next = (s.next + 1) % t.sampleCount
+}
+
+// Percentile returns the given percentile, in the range of (0, 100],
+// of the recorded latency samples of the given shard.
+// False is returned in case no latency has been recorded yet for that shard.
+func (t *LatencyTracker) Percentile(shard string, percentile float64) (time.Duration, bool) {
+	t.mux.Lock()
+	s, ok := t.shards[shard]
+	if !ok || len(s.samples) == 0 {
+		t.mux.Unlock()
+		return 0, false
+	}
+	samples := make([]time.Duration, len(s.samples))
+	copy(samples, s.samples)
+	t.mux.Unlock()
+
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i] < samples[j]
+	})
+	// nearest-rank method
+	rank := int(math.Ceil(percentile / 100 * float64(len(samples))))
+	if rank < 1 {
+		rank = 1
+	} else if rank > len(samples) {
+		rank = len(samples)
+	}
+	return samples[rank-1], true
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyTracker(t *testing.T) {
+	require := require.New(t)
+
+	tracker := NewLatencyTracker(10)
+	_, ok := tracker.Percentile("a", 50)
+	require.False(ok, "no latency recorded yet")
+
+	for i := 1; i <= 10; i++ {
+		tracker.Record("a", time.Duration(i)*time.Millisecond)
+	}
+	latency, ok := tracker.Percentile("a", 50)
+	require.True(ok)
+	require.Equal(5*time.Millisecond, latency)
+	latency, ok = tracker.Percentile("a", 95)
+	require.True(ok)
+	require.Equal(10*time.Millisecond, latency)
+	latency, ok = tracker.Percentile("a", 0)
+	require.True(ok)
+	require.Equal(time.Millisecond, latency)
+
+	// only the most recent samples are kept
+	for i := 0; i < 10; i++ {
+		tracker.Record("a", time.Second)
+	}
+	latency, ok = tracker.Percentile("a", 1)
+	require.True(ok)
+	require.Equal(time.Second, latency)
+
+	// shards are tracked separately
+	_, ok = tracker.Percentile("b", 50)
+	require.False(ok)
+}
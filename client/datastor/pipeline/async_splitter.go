@@ -22,10 +22,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
 	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
 
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -81,6 +83,26 @@ func NewAsyncSplitterPipeline(cs storage.ChunkStorage, chunkSize int, pc Process
 	}
 }
 
+// NewContentDefinedSplitterPipeline creates a parallel pipeline,
+// which splits all the content as it reads into chunks of variable size,
+// using content-defined chunking, and, processes and stores them as multiple objects.
+// The offset of each chunk, within the written content, is defined by that chunk,
+// while the chunk size of the pipeline equals metatypes.VariableChunkSize.
+//
+// NewContentDefinedSplitterPipeline panics in case the given ChunkingConfig is invalid,
+// all other parameters are handled the same way as NewAsyncSplitterPipeline does.
+func NewContentDefinedSplitterPipeline(cs storage.ChunkStorage, cfg ChunkingConfig, pc ProcessorConstructor, hc HasherConstructor, jobCount int) *AsyncSplitterPipeline {
+	chunking, err := cfg.normalize()
+	if err != nil {
+		panic(err)
+	}
+	// the chunk size is only used to validate the parameters
+	asp := NewAsyncSplitterPipeline(cs, chunking.AverageSize, pc, hc, jobCount)
+	asp.chunkSize = metatypes.VariableChunkSize
+	asp.chunking = &chunking
+	return asp
+}
+
 // AsyncSplitterPipeline defines a parallel pipeline,
 // which chunks all the content as it reads, and, processes
 // and stores the read data as multiple objects.
@@ -92,9 +114,23 @@ type AsyncSplitterPipeline struct {
 	storage                            storage.ChunkStorage
 	storageJobCount, processorJobCount int
 	chunkSize                          int
+	chunking                           *ChunkingConfig
+}
+
+// NewChunker implements ChunkingPipeline.NewChunker
+func (asp *AsyncSplitterPipeline) NewChunker(r io.Reader) Chunker {
+	if asp.chunking == nil {
+		return nil
+	}
+	return newChunker(r, *asp.chunking)
 }
 
 // Write implements Pipeline.Write
+func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error) {
+	return asp.WriteContext(context.Background(), r)
+}
+
+// WriteContext implements Pipeline.WriteContext
 //
 // The following graph visualizes the logic of this pipeline's Write method:
 //
@@ -124,24 +160,45 @@ type AsyncSplitterPipeline struct {
 //
 // As soon as an error happens within any stage, at any point,
 // the entire pipeline will be cancelled and that error is returned to the callee of this method.
-func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error) {
+func (asp *AsyncSplitterPipeline) WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error) {
+	return asp.WriteLookup(ctx, r, nil)
+}
+
+// WriteLookup implements LookupPipeline.WriteLookup
+//
+// The chunks are looked up right after hashing them,
+// such that chunks which are stored already are neither processed nor stored.
+func (asp *AsyncSplitterPipeline) WriteLookup(ctx context.Context, r io.Reader, lookup ChunkLookup) ([]metatypes.Chunk, error) {
 	if r == nil {
 		return nil, errors.New("no reader given to read from")
 	}
 
-	group, ctx := errgroup.WithContext(context.Background())
+	parent := ctx
+	group, ctx := errgroup.WithContext(parent)
 
 	// start the data splitter
-	inputCh, splitter := newAsyncDataSplitter(
-		ctx, r, asp.chunkSize, asp.processorJobCount)
+	var (
+		inputCh  <-chan indexedDataChunk
+		splitter func() error
+	)
+	if asp.chunking != nil {
+		inputCh, splitter = newAsyncContentDefinedSplitter(ctx, r, *asp.chunking)
+	} else {
+		inputCh, splitter = newAsyncDataSplitter(
+			ctx, r, asp.chunkSize, asp.processorJobCount)
+	}
 	group.Go(splitter)
 
 	// start all the processors,
 	// which will also create key, using the hasher
 	type indexedData struct {
-		Index int
-		Hash  []byte
-		Data  []byte
+		Index  int
+		Offset int64
+		Hash   []byte
+		Data   []byte
+		// Stored is the chunk returned by the lookup, if any,
+		// in which case the data doesn't have to be stored
+		Stored *metatypes.Chunk
 	}
 	dataCh := make(chan indexedData)
 	processorGroup, _ := errgroup.WithContext(ctx)
@@ -159,6 +216,22 @@ func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error)
 				// generate the data's hash
 				hash := hasher.HashBytes(input.Data)
 
+				// look up the data, which doesn't have to be processed if it's stored already
+				if lookup != nil {
+					stored, err := lookup(hash)
+					if err != nil {
+						return err
+					}
+					if stored != nil {
+						select {
+						case dataCh <- indexedData{input.Index, input.Offset, hash, nil, stored}:
+						case <-ctx.Done():
+							return nil
+						}
+						continue
+					}
+				}
+
 				// process the data
 				data, err := processor.WriteProcess(input.Data)
 				if err != nil {
@@ -175,7 +248,7 @@ func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error)
 				}
 
 				select {
-				case dataCh <- indexedData{input.Index, hash, data}:
+				case dataCh <- indexedData{input.Index, input.Offset, hash, data, nil}:
 				case <-ctx.Done():
 					return nil
 				}
@@ -194,26 +267,53 @@ func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error)
 	type indexedChunk struct {
 		Index int
 		Chunk metatypes.Chunk
+		// Stored is true in case the chunk was returned by the lookup,
+		// in which case it is never rolled back
+		Stored bool
 	}
 	chunkCh := make(chan indexedChunk)
 	storageGroup, _ := errgroup.WithContext(ctx)
+	// objects which could not be rolled back by the storage itself
+	var (
+		remainingObjects []metatypes.Object
+		remainingMux     sync.Mutex
+	)
 	for i := 0; i < asp.storageJobCount; i++ {
 		storageGroup.Go(func() error {
 			for data := range dataCh {
-				cfg, err := asp.storage.WriteChunk(data.Data)
+				if err := ctx.Err(); err != nil {
+					// the write failed already, no need to store more chunks
+					return err
+				}
+				if data.Stored != nil {
+					chunk := *data.Stored
+					// the offset is defined by the written data
+					chunk.Offset = data.Offset
+					chunkCh <- indexedChunk{data.Index, chunk, true}
+					continue
+				}
+				// store the chunk using the parent context,
+				// such that in-flight writes aren't abandoned when another goroutine fails,
+				// as the keys of abandoned objects are unknown, making it impossible to roll them back
+				cfg, err := asp.storage.WriteChunkContext(parent, data.Data)
 				if err != nil {
+					if rbErr, ok := err.(*storage.RollbackError); ok {
+						remainingMux.Lock()
+						remainingObjects = append(remainingObjects, rbErr.Objects...)
+						remainingMux.Unlock()
+						err = rbErr.Err
+					}
 					return err
 				}
 				chunk := metatypes.Chunk{
 					Size:    cfg.Size,
 					Objects: cfg.Objects,
 					Hash:    data.Hash,
+					Offset:  data.Offset,
 				}
-				select {
-				case chunkCh <- indexedChunk{data.Index, chunk}:
-				case <-ctx.Done():
-					return nil
-				}
+				// always send the stored chunk, even when the context is cancelled,
+				// such that it can be rolled back in case the write fails
+				chunkCh <- indexedChunk{data.Index, chunk, false}
 			}
 			return nil
 		})
@@ -228,6 +328,8 @@ func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error)
 	var (
 		chunks    []metatypes.Chunk
 		chunkSize int
+		// chunks stored by this write, which are rolled back in case it fails
+		written []metatypes.Chunk
 	)
 	group.Go(func() error {
 		var (
@@ -249,6 +351,9 @@ func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error)
 			// store our chunk and increase our received count
 			chunks[chunk.Index] = chunk.Chunk
 			receivedChunkCount++
+			if !chunk.Stored {
+				written = append(written, chunk.Chunk)
+			}
 
 			// update our chunkSize if needed,
 			// this to know the final chunk length in the end
@@ -265,10 +370,11 @@ func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error)
 	})
 
 	// wait until all data has been
-	// read, chunked, processed and stored
-	err := group.Wait()
+	// read, chunked, processed and stored,
+	// rolling back all stored chunks if that failed
+	err := contextError(parent, group.Wait())
 	if err != nil {
-		return nil, err
+		return nil, rollbackChunks(asp.storage, written, remainingObjects, err)
 	}
 
 	// return all received chunks, and nothing more
@@ -276,6 +382,11 @@ func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error)
 }
 
 // Read implements Pipeline.Read
+func (asp *AsyncSplitterPipeline) Read(chunks []metatypes.Chunk, w io.Writer) error {
+	return asp.ReadContext(context.Background(), chunks, w)
+}
+
+// ReadContext implements Pipeline.ReadContext
 //
 // The following graph visualizes the logic of this pipeline's Read method:
 //
@@ -314,7 +425,7 @@ func (asp *AsyncSplitterPipeline) Write(r io.Reader) ([]metatypes.Chunk, error)
 // to read the data using the Read method of that pipeline,
 // as to now spawn an entire async pipeline, when only one chunk is to be read.
 // See (*SingleObjectPipeline).Read for more information about the logic for this scenario.
-func (asp *AsyncSplitterPipeline) Read(chunks []metatypes.Chunk, w io.Writer) error {
+func (asp *AsyncSplitterPipeline) ReadContext(ctx context.Context, chunks []metatypes.Chunk, w io.Writer) error {
 	chunkLength := len(chunks)
 	if chunkLength == 0 {
 		return errors.New("no chunks given to read")
@@ -328,7 +439,7 @@ func (asp *AsyncSplitterPipeline) Read(chunks []metatypes.Chunk, w io.Writer) er
 			processor: asp.processor,
 			storage:   asp.storage,
 		}
-		return sop.Read(chunks, w)
+		return sop.ReadContext(ctx, chunks, w)
 	}
 	if w == nil {
 		return errors.New("no writer given to write to")
@@ -345,7 +456,8 @@ func (asp *AsyncSplitterPipeline) Read(chunks []metatypes.Chunk, w io.Writer) er
 
 	// the master group, which will spawn all non-grouped goroutines,
 	// and the close-goroutines for the (processor and storage) sub groups.
-	group, ctx := errgroup.WithContext(context.Background())
+	parent := ctx
+	group, ctx := errgroup.WithContext(parent)
 
 	// send all chunks one by one,
 	// until all chunks have been send, or until the context is cancelled
@@ -378,7 +490,7 @@ func (asp *AsyncSplitterPipeline) Read(chunks []metatypes.Chunk, w io.Writer) er
 	for i := 0; i < storageJobCount; i++ {
 		storageGroup.Go(func() error {
 			for ic := range chunkCh {
-				data, err := asp.storage.ReadChunk(storage.ChunkConfig{
+				data, err := asp.storage.ReadChunkContext(ctx, storage.ChunkConfig{
 					Size:    ic.Chunk.Size,
 					Objects: ic.Chunk.Objects,
 				})
@@ -502,11 +614,16 @@ func (asp *AsyncSplitterPipeline) Read(chunks []metatypes.Chunk, w io.Writer) er
 	})
 
 	// wait for all goroutines to be finished
-	return group.Wait()
+	return contextError(parent, group.Wait())
 }
 
 // Check implements Pipeline.Check
 func (asp *AsyncSplitterPipeline) Check(chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
+	return asp.CheckContext(context.Background(), chunks, fast)
+}
+
+// CheckContext implements Pipeline.CheckContext
+func (asp *AsyncSplitterPipeline) CheckContext(ctx context.Context, chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
 	chunkLength := len(chunks)
 	if chunkLength == 0 {
 		return storage.CheckStatus(0), errors.New("no chunks given to check")
@@ -520,7 +637,7 @@ func (asp *AsyncSplitterPipeline) Check(chunks []metatypes.Chunk, fast bool) (st
 			processor: asp.processor,
 			storage:   asp.storage,
 		}
-		return sop.Check(chunks, fast)
+		return sop.CheckContext(ctx, chunks, fast)
 	}
 
 	// limit our job count,
@@ -532,7 +649,8 @@ func (asp *AsyncSplitterPipeline) Check(chunks []metatypes.Chunk, fast bool) (st
 
 	// create an errgroup for all our check jobs,
 	// and one for the master jobs
-	storageGroup, ctx := errgroup.WithContext(context.Background())
+	parent := ctx
+	storageGroup, ctx := errgroup.WithContext(parent)
 	group, ctx := errgroup.WithContext(ctx)
 
 	// spawn our chunk fetcher
@@ -563,7 +681,7 @@ func (asp *AsyncSplitterPipeline) Check(chunks []metatypes.Chunk, fast bool) (st
 			)
 			for index := range indexCh {
 				chunk = &chunks[index]
-				status, err = asp.storage.CheckChunk(storage.ChunkConfig{
+				status, err = asp.storage.CheckChunkContext(ctx, storage.ChunkConfig{
 					Size:    chunk.Size,
 					Objects: chunk.Objects,
 				}, fast)
@@ -602,7 +720,7 @@ func (asp *AsyncSplitterPipeline) Check(chunks []metatypes.Chunk, fast bool) (st
 	// simply wait for all jobs to finish,
 	// afterward returns either the storage-originated error,
 	// or compute the data's current status based on the received information
-	err := group.Wait()
+	err := contextError(parent, group.Wait())
 	switch err {
 	case nil:
 		if dataIsOptimal {
@@ -624,6 +742,11 @@ var (
 
 // Repair implements Pipeline.Repair
 func (asp *AsyncSplitterPipeline) Repair(chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
+	return asp.RepairContext(context.Background(), chunks)
+}
+
+// RepairContext implements Pipeline.RepairContext
+func (asp *AsyncSplitterPipeline) RepairContext(ctx context.Context, chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
 	chunkLength := len(chunks)
 	if chunkLength == 0 {
 		return nil, errors.New("no chunks given to repair")
@@ -637,7 +760,7 @@ func (asp *AsyncSplitterPipeline) Repair(chunks []metatypes.Chunk) ([]metatypes.
 			processor: asp.processor,
 			storage:   asp.storage,
 		}
-		return sop.Repair(chunks)
+		return sop.RepairContext(ctx, chunks)
 	}
 
 	// limit our job count,
@@ -649,7 +772,8 @@ func (asp *AsyncSplitterPipeline) Repair(chunks []metatypes.Chunk) ([]metatypes.
 
 	// create an errgroup for all our repair jobs,
 	// and one for the master jobs
-	storageGroup, ctx := errgroup.WithContext(context.Background())
+	parent := ctx
+	storageGroup, ctx := errgroup.WithContext(parent)
 	group, ctx := errgroup.WithContext(ctx)
 
 	// spawn our chunk fetcher
@@ -681,7 +805,7 @@ func (asp *AsyncSplitterPipeline) Repair(chunks []metatypes.Chunk) ([]metatypes.
 			)
 			for index := range indexCh {
 				chunk = &chunks[index]
-				cfg, err = asp.storage.RepairChunk(storage.ChunkConfig{
+				cfg, err = asp.storage.RepairChunkContext(ctx, storage.ChunkConfig{
 					Size:    chunk.Size,
 					Objects: chunk.Objects,
 				})
@@ -713,6 +837,7 @@ func (asp *AsyncSplitterPipeline) Repair(chunks []metatypes.Chunk) ([]metatypes.
 				Size:    result.Config.Size,
 				Objects: result.Config.Objects,
 				Hash:    chunks[result.Index].Hash,
+				Offset:  chunks[result.Index].Offset,
 			}
 		}
 		return nil
@@ -720,12 +845,17 @@ func (asp *AsyncSplitterPipeline) Repair(chunks []metatypes.Chunk) ([]metatypes.
 
 	// simply wait for all jobs to finish,
 	// and return its (nil) error + the output chunks
-	err := group.Wait()
+	err := contextError(parent, group.Wait())
 	return outputChunks, err
 }
 
 // Delete implements Pipeline.Delete
 func (asp *AsyncSplitterPipeline) Delete(chunks []metatypes.Chunk) error {
+	return asp.DeleteContext(context.Background(), chunks)
+}
+
+// DeleteContext implements Pipeline.DeleteContext
+func (asp *AsyncSplitterPipeline) DeleteContext(ctx context.Context, chunks []metatypes.Chunk) error {
 	chunkLength := len(chunks)
 	if chunkLength == 0 {
 		return errors.New("no chunks given to delete")
@@ -739,7 +869,7 @@ func (asp *AsyncSplitterPipeline) Delete(chunks []metatypes.Chunk) error {
 			processor: asp.processor,
 			storage:   asp.storage,
 		}
-		return sop.Delete(chunks)
+		return sop.DeleteContext(ctx, chunks)
 	}
 
 	// limit our job count,
@@ -750,7 +880,8 @@ func (asp *AsyncSplitterPipeline) Delete(chunks []metatypes.Chunk) error {
 	}
 
 	// create an errgroup for all our delete jobs
-	group, ctx := errgroup.WithContext(context.Background())
+	parent := ctx
+	group, ctx := errgroup.WithContext(parent)
 
 	// spawn our chunk fetcher
 	indexCh := make(chan int, jobCount)
@@ -774,7 +905,7 @@ func (asp *AsyncSplitterPipeline) Delete(chunks []metatypes.Chunk) error {
 			)
 			for index := range indexCh {
 				chunk = &chunks[index]
-				err = asp.storage.DeleteChunk(storage.ChunkConfig{
+				err = asp.storage.DeleteChunkContext(ctx, storage.ChunkConfig{
 					Size:    chunk.Size,
 					Objects: chunk.Objects,
 				})
@@ -788,7 +919,7 @@ func (asp *AsyncSplitterPipeline) Delete(chunks []metatypes.Chunk) error {
 
 	// simply wait for all jobs to finish,
 	// and return its (nil) error
-	return group.Wait()
+	return contextError(parent, group.Wait())
 }
 
 // ChunkSize implements Pipeline.ChunkSize
@@ -801,9 +932,47 @@ func (asp *AsyncSplitterPipeline) Close() error {
 	return asp.storage.Close()
 }
 
+// contextError returns the error of the given context in case it was cancelled,
+// or the given error otherwise. This way a cancellation is reported as such,
+// rather than as one of the errors it caused within the different stages of a pipeline.
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+// rollbackChunks deletes all given chunks, on a best-effort basis,
+// returning the given error as is in case all chunks could be deleted,
+// and no other objects remain, or as part of a *storage.RollbackError if not.
+//
+// The chunks are deleted using a detached context,
+// as the context of the failed write might be the cause of its failure.
+func rollbackChunks(cs storage.ChunkStorage, chunks []metatypes.Chunk, remaining []metatypes.Object, err error) error {
+	for _, chunk := range chunks {
+		if len(chunk.Objects) == 0 {
+			// chunk was never stored
+			continue
+		}
+		delErr := cs.DeleteChunkContext(context.Background(), storage.ChunkConfig{
+			Size:    chunk.Size,
+			Objects: chunk.Objects,
+		})
+		if delErr != nil {
+			log.WithError(delErr).Errorf("failed to roll back chunk %x of failed write", chunk.Hash)
+			remaining = append(remaining, chunk.Objects...)
+		}
+	}
+	if len(remaining) > 0 {
+		return &storage.RollbackError{Err: err, Objects: remaining}
+	}
+	return err
+}
+
 type indexedDataChunk struct {
-	Index int
-	Data  []byte
+	Index  int
+	Offset int64
+	Data   []byte
 }
 
 // newAsyncDataSplitter creates a functional data splitter,
@@ -821,7 +990,7 @@ func newAsyncDataSplitter(ctx context.Context, r io.Reader, chunkSize, _bufferSi
 				data := make([]byte, n)
 				copy(data, buf)
 				select {
-				case inputCh <- indexedDataChunk{index, data}:
+				case inputCh <- indexedDataChunk{Index: index, Data: data}:
 					index++
 				case <-ctx.Done():
 					return nil
@@ -839,3 +1008,42 @@ func newAsyncDataSplitter(ctx context.Context, r io.Reader, chunkSize, _bufferSi
 		}
 	}
 }
+
+// newAsyncContentDefinedSplitter creates a functional data splitter,
+// which can be used to split streaming input data into content-defined chunks,
+// in an asynchronous fashion. Each chunk defines its offset within the input data.
+func newAsyncContentDefinedSplitter(ctx context.Context, r io.Reader, cfg ChunkingConfig) (<-chan indexedDataChunk, func() error) {
+	inputCh := make(chan indexedDataChunk)
+	return inputCh, func() error {
+		defer close(inputCh)
+		var (
+			index   int
+			offset  int64
+			chunker = newChunker(r, cfg)
+		)
+		for {
+			buf, err := chunker.Next()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			data := make([]byte, len(buf))
+			copy(data, buf)
+			select {
+			case inputCh <- indexedDataChunk{index, offset, data}:
+				index++
+				offset += int64(len(data))
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+var (
+	_ Pipeline         = (*AsyncSplitterPipeline)(nil)
+	_ LookupPipeline   = (*AsyncSplitterPipeline)(nil)
+	_ ChunkingPipeline = (*AsyncSplitterPipeline)(nil)
+)
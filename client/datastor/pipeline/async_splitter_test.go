@@ -27,6 +27,7 @@ import (
 
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline/crypto"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
 	"github.com/threefoldtech/0-stor/client/processing"
 
 	"github.com/stretchr/testify/assert"
@@ -75,6 +76,91 @@ func testAsyncSplitterPipelineWriteReadDeleteCheckCycle(t *testing.T, cfg Object
 	testPipelineWriteReadDeleteCheck(t, pipeline)
 }
 
+func TestAsyncSplitterPipeline_WriteRollback(t *testing.T) {
+	require := require.New(t)
+
+	cfg := ObjectDistributionConfig{DataShardCount: 2, ParityShardCount: 1}
+	cluster, cleanup, err := newZdbServerCluster(requiredShardCount(cfg))
+	require.NoError(err)
+	defer cleanup()
+
+	cs, err := NewChunkStorage(cfg, cluster, -1)
+	require.NoError(err)
+	input := make([]byte, 64*8)
+	rand.Read(input)
+
+	// all written chunks are deleted when a chunk write fails
+	fs := &faultyChunkStorage{ChunkStorage: cs, maxWriteCount: 4}
+	pipeline := NewAsyncSplitterPipeline(fs, 8, nil, nil, 2)
+	chunks, err := pipeline.Write(bytes.NewReader(input))
+	require.Equal(errFaultyStorage, err)
+	require.Nil(chunks)
+	count, err := objectCount(cluster)
+	require.NoError(err)
+	require.Zero(count)
+
+	// the objects of chunks which couldn't be deleted are reported
+	fs = &faultyChunkStorage{ChunkStorage: cs, maxWriteCount: 4, failDelete: true}
+	pipeline = NewAsyncSplitterPipeline(fs, 8, nil, nil, 2)
+	chunks, err = pipeline.Write(bytes.NewReader(input))
+	require.Nil(chunks)
+	require.IsType((*storage.RollbackError)(nil), err)
+	rbErr := err.(*storage.RollbackError)
+	require.Equal(errFaultyStorage, rbErr.Err)
+	require.Len(rbErr.Objects, 4*requiredShardCount(cfg))
+	count, err = objectCount(cluster)
+	require.NoError(err)
+	require.Equal(len(rbErr.Objects), count)
+}
+
+func TestAsyncSplitterPipeline_WriteLookup(t *testing.T) {
+	require := require.New(t)
+
+	cfg := ObjectDistributionConfig{DataShardCount: 2, ParityShardCount: 1}
+	cluster, cleanup, err := newZdbServerCluster(requiredShardCount(cfg))
+	require.NoError(err)
+	defer cleanup()
+
+	cs, err := NewChunkStorage(cfg, cluster, -1)
+	require.NoError(err)
+	input := make([]byte, 8*4)
+	rand.Read(input)
+	stored, err := NewAsyncSplitterPipeline(cs, 8, nil, nil, 2).Write(bytes.NewReader(input))
+	require.NoError(err)
+	require.Len(stored, 4)
+
+	// only the chunks which aren't returned by the lookup are stored
+	lookup := func(hash []byte) (*metatypes.Chunk, error) {
+		for _, index := range []int{0, 2} {
+			if bytes.Equal(stored[index].Hash, hash) {
+				chunk := stored[index]
+				return &chunk, nil
+			}
+		}
+		return nil, nil
+	}
+	fs := &faultyChunkStorage{ChunkStorage: cs, maxWriteCount: 2}
+	chunks, err := NewAsyncSplitterPipeline(fs, 8, nil, nil, 2).WriteLookup(context.Background(), bytes.NewReader(input), lookup)
+	require.NoError(err)
+	require.Len(chunks, 4)
+	require.Equal(int32(2), fs.writeCount)
+	require.Equal(stored[0], chunks[0])
+	require.NotEqual(stored[1].Objects, chunks[1].Objects)
+	require.Equal(stored[2], chunks[2])
+	require.NotEqual(stored[3].Objects, chunks[3].Objects)
+
+	// the chunks returned by the lookup are never rolled back
+	fs = &faultyChunkStorage{ChunkStorage: cs}
+	_, err = NewAsyncSplitterPipeline(fs, 8, nil, nil, 2).WriteLookup(context.Background(), bytes.NewReader(input), lookup)
+	require.Equal(errFaultyStorage, err)
+	count, err := objectCount(cluster)
+	require.NoError(err)
+	require.Equal(6*requiredShardCount(cfg), count)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(NewAsyncSplitterPipeline(cs, 8, nil, nil, 2).Read(stored, buf))
+	require.Equal(input, buf.Bytes())
+}
+
 func TestAsyncSplitterPipeline_CheckRepair(t *testing.T) {
 	t.Run("block_size=1+pure-default", func(t *testing.T) {
 		testAsyncSplitterPipelineCheckRepairCycle(t, ObjectDistributionConfig{}, 1, nil, nil)
@@ -199,6 +285,62 @@ func testDefaultAsyncSplitterPipeline(t *testing.T, cfg ObjectDistributionConfig
 	testPipelineWriteReadDelete(t, pipeline)
 }
 
+func TestContentDefinedSplitterPipeline(t *testing.T) {
+	require := require.New(t)
+
+	cfg := ObjectDistributionConfig{DataShardCount: 2, ParityShardCount: 1}
+	cluster, cleanup, err := newZdbServerCluster(requiredShardCount(cfg))
+	require.NoError(err)
+	defer cleanup()
+
+	os, err := NewChunkStorage(cfg, cluster, -1)
+	require.NoError(err)
+
+	require.Panics(func() {
+		NewContentDefinedSplitterPipeline(os, ChunkingConfig{AverageSize: 1}, nil, nil, -1)
+	}, "invalid chunking config")
+
+	pipeline := NewContentDefinedSplitterPipeline(os, ChunkingConfig{AverageSize: 8}, nil, nil, -1)
+	require.Equal(metatypes.VariableChunkSize, pipeline.ChunkSize())
+	testPipelineWriteReadDeleteCheck(t, pipeline)
+	testPipelineCheckRepair(t, pipeline)
+
+	// each chunk defines its offset, which is kept when repairing it
+	input := make([]byte, 4096)
+	_, err = rand.Read(input)
+	require.NoError(err)
+	chunks, err := pipeline.Write(bytes.NewReader(input))
+	require.NoError(err)
+	require.True(len(chunks) > 1)
+	var offset int64
+	for _, chunk := range chunks {
+		require.Equal(offset, chunk.Offset)
+		buf := bytes.NewBuffer(nil)
+		require.NoError(pipeline.Read([]metatypes.Chunk{chunk}, buf))
+		require.Equal(input[offset:offset+int64(buf.Len())], buf.Bytes())
+		offset += int64(buf.Len())
+	}
+	require.Equal(int64(len(input)), offset)
+
+	// the chunker splits the content into the same chunks, without writing it
+	chunker := pipeline.NewChunker(bytes.NewReader(input))
+	for _, chunk := range chunks {
+		data, err := chunker.Next()
+		require.NoError(err)
+		require.Equal(input[chunk.Offset:chunk.Offset+int64(len(data))], data)
+	}
+	_, err = chunker.Next()
+	require.Equal(io.EOF, err)
+	require.Nil(NewAsyncSplitterPipeline(os, 8, nil, nil, -1).NewChunker(bytes.NewReader(input)))
+
+	repaired, err := pipeline.Repair(chunks)
+	require.NoError(err)
+	for index, chunk := range repaired {
+		require.Equal(chunks[index].Offset, chunk.Offset)
+	}
+	require.NoError(pipeline.Delete(repaired))
+}
+
 func TestAsyncDataSplitter(t *testing.T) {
 	testCases := []struct {
 		Input          string
@@ -0,0 +1,160 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// chunkingNormalization is the level of normalized chunking,
+// used to make the size of content-defined chunks converge to the average size.
+// The boundary of a chunk smaller than the average size is only found
+// for a hash which has chunkingNormalization bits more unset than required for the average size,
+// while that of a chunk larger than the average size is found for a hash with that many bits less.
+const chunkingNormalization = 2
+
+// gearTable maps each byte value to a pseudo-random value,
+// used to compute the rolling (gear) hash of the content.
+//
+// The table is generated from a fixed seed, and may never change,
+// as that would change the boundaries of all content-defined chunks,
+// making it impossible to deduplicate data written prior to that change.
+var gearTable = func() (table [256]uint64) {
+	// splitmix64
+	state := uint64(0x0123456789abcdef)
+	for i := range table {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return
+}()
+
+// normalize returns the chunking configuration with all its default sizes defined,
+// or an error in case the configuration is invalid.
+func (cfg ChunkingConfig) normalize() (ChunkingConfig, error) {
+	if cfg.AverageSize < 1<<(chunkingNormalization+1) {
+		return cfg, fmt.Errorf(
+			"invalid chunking config: average size %d has to be at least %d",
+			cfg.AverageSize, 1<<(chunkingNormalization+1))
+	}
+	if cfg.MinSize <= 0 {
+		cfg.MinSize = cfg.AverageSize / 4
+	}
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = cfg.AverageSize * 8
+	}
+	if cfg.MinSize > cfg.AverageSize || cfg.AverageSize > cfg.MaxSize {
+		return cfg, fmt.Errorf(
+			"invalid chunking config: sizes have to be ordered as min (%d) <= average (%d) <= max (%d)",
+			cfg.MinSize, cfg.AverageSize, cfg.MaxSize)
+	}
+	return cfg, nil
+}
+
+// chunker splits the data of a reader into content-defined chunks,
+// using the FastCDC algorithm, such that the boundaries of the chunks
+// only depend upon the content that precedes them within the chunk.
+// Inserting or removing data thus only changes the chunks around that data,
+// rather than all chunks that follow it, as is the case when splitting at a fixed size.
+type chunker struct {
+	r io.Reader
+
+	minSize, avgSize, maxSize int
+	maskS, maskL              uint64
+
+	buf        []byte
+	start, end int
+	eof        bool
+}
+
+// newChunker creates a chunker for the given reader,
+// using the given (normalized) chunking configuration.
+func newChunker(r io.Reader, cfg ChunkingConfig) *chunker {
+	// the amount of bits that have to be unset for a chunk of the average size
+	n := bits.Len(uint(cfg.AverageSize)) - 1
+	return &chunker{
+		r:       r,
+		minSize: cfg.MinSize,
+		avgSize: cfg.AverageSize,
+		maxSize: cfg.MaxSize,
+		maskS:   ^uint64(0) << uint(64-n-chunkingNormalization),
+		maskL:   ^uint64(0) << uint(64-n+chunkingNormalization),
+		buf:     make([]byte, cfg.MaxSize),
+	}
+}
+
+// Next returns the data of the next chunk, which is only valid until the next call,
+// or io.EOF in case all data has been read from the reader.
+func (c *chunker) Next() ([]byte, error) {
+	if !c.eof && c.end-c.start < c.maxSize {
+		c.end = copy(c.buf, c.buf[c.start:c.end])
+		c.start = 0
+		n, err := io.ReadFull(c.r, c.buf[c.end:])
+		c.end += n
+		if err != nil {
+			if err != io.EOF && err != io.ErrUnexpectedEOF {
+				return nil, err
+			}
+			c.eof = true
+		}
+	}
+	if c.start == c.end {
+		return nil, io.EOF
+	}
+	data := c.buf[c.start:c.end]
+	data = data[:c.cut(data)]
+	c.start += len(data)
+	return data, nil
+}
+
+// cut returns the length of the chunk at the start of the given data
+func (c *chunker) cut(data []byte) int {
+	n := len(data)
+	if n <= c.minSize {
+		return n
+	}
+	if n > c.maxSize {
+		n = c.maxSize
+	}
+	normal := c.avgSize
+	if normal > n {
+		normal = n
+	}
+
+	var (
+		hash uint64
+		i    = c.minSize
+	)
+	for ; i < normal; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&c.maskS == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&c.maskL == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
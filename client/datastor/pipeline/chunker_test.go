@@ -0,0 +1,131 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkingConfigNormalize(t *testing.T) {
+	cfg, err := ChunkingConfig{AverageSize: 1024}.normalize()
+	require.NoError(t, err)
+	require.Equal(t, ChunkingConfig{MinSize: 256, AverageSize: 1024, MaxSize: 8192}, cfg)
+
+	cfg, err = ChunkingConfig{MinSize: 1, AverageSize: 8, MaxSize: 8}.normalize()
+	require.NoError(t, err)
+	require.Equal(t, ChunkingConfig{MinSize: 1, AverageSize: 8, MaxSize: 8}, cfg)
+
+	_, err = ChunkingConfig{AverageSize: 4}.normalize()
+	require.Error(t, err)
+	_, err = ChunkingConfig{MinSize: 2048, AverageSize: 1024}.normalize()
+	require.Error(t, err)
+	_, err = ChunkingConfig{AverageSize: 1024, MaxSize: 512}.normalize()
+	require.Error(t, err)
+}
+
+func TestChunker(t *testing.T) {
+	cfg, err := ChunkingConfig{AverageSize: 1024}.normalize()
+	require.NoError(t, err)
+
+	// use the same data at all times, as the amount of chunks which change
+	// depends upon whether the boundaries around the inserted data resynchronize
+	data := make([]byte, 256*1024)
+	_, err = mrand.New(mrand.NewSource(42)).Read(data)
+	require.NoError(t, err)
+
+	chunks := chunkData(t, data, cfg)
+	require.Equal(t, data, bytes.Join(chunks, nil))
+	for index, chunk := range chunks {
+		require.True(t, len(chunk) <= cfg.MaxSize)
+		if index < len(chunks)-1 {
+			require.True(t, len(chunk) >= cfg.MinSize)
+		}
+	}
+	// the chunks converge to the average size
+	average := len(data) / len(chunks)
+	require.True(t, average >= cfg.AverageSize/2 && average <= cfg.AverageSize*2,
+		"average chunk size: %d", average)
+
+	// inserting data only changes the chunks around that data
+	modified := append(append(append([]byte(nil), data[:len(data)/2]...), 42), data[len(data)/2:]...)
+	modifiedChunks := chunkData(t, modified, cfg)
+	require.Equal(t, modified, bytes.Join(modifiedChunks, nil))
+	known := make(map[string]struct{}, len(chunks))
+	for _, chunk := range chunks {
+		known[string(chunk)] = struct{}{}
+	}
+	var changed int
+	for _, chunk := range modifiedChunks {
+		if _, ok := known[string(chunk)]; !ok {
+			changed++
+		}
+	}
+	require.True(t, changed <= 3, "changed chunks: %d", changed)
+
+	// data smaller than the min size is a single chunk
+	require.Equal(t, [][]byte{data[:10]}, chunkData(t, data[:10], cfg))
+	require.Empty(t, chunkData(t, nil, cfg))
+}
+
+func TestAsyncContentDefinedSplitter(t *testing.T) {
+	cfg, err := ChunkingConfig{AverageSize: 64}.normalize()
+	require.NoError(t, err)
+
+	data := make([]byte, 4096)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	inputCh, splitter := newAsyncContentDefinedSplitter(context.Background(), bytes.NewReader(data), cfg)
+	go func() {
+		require.NoError(t, splitter())
+	}()
+
+	var (
+		index  int
+		offset int64
+	)
+	for input := range inputCh {
+		require.Equal(t, index, input.Index)
+		require.Equal(t, offset, input.Offset)
+		require.Equal(t, data[offset:offset+int64(len(input.Data))], input.Data)
+		index++
+		offset += int64(len(input.Data))
+	}
+	require.Equal(t, int64(len(data)), offset)
+}
+
+func chunkData(t *testing.T, data []byte, cfg ChunkingConfig) [][]byte {
+	var (
+		chunks  [][]byte
+		chunker = newChunker(bytes.NewReader(data), cfg)
+	)
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			return chunks
+		}
+		require.NoError(t, err)
+		chunks = append(chunks, append([]byte(nil), chunk...))
+	}
+}
@@ -67,6 +67,15 @@ func NewPipeline(cfg Config, cluster datastor.Cluster, jobCount int) (Pipeline,
 		return nil, err
 	}
 
+	// return a content-defined splitter pipeline
+	if cfg.Chunking.AverageSize > 0 {
+		chunking, err := cfg.Chunking.normalize()
+		if err != nil {
+			return nil, err
+		}
+		return NewContentDefinedSplitterPipeline(os, chunking, pc, hc, jobCount), nil
+	}
+
 	// return a sequential pipeline
 	if cfg.BlockSize <= 0 {
 		return NewSingleObjectPipeline(os, pc, hc), nil
@@ -158,12 +167,26 @@ func NewChunkStorage(cfg ObjectDistributionConfig, cluster datastor.Cluster, job
 			return storage.NewRandomChunkStorage(cluster)
 		}
 
-		return storage.NewReplicatedChunkStorage(
+		rs, err := storage.NewReplicatedChunkStorage(
 			cluster, cfg.DataShardCount, jobCount)
+		if err != nil {
+			return nil, err
+		}
+		if err = rs.SetReadConfig(cfg.Read); err != nil {
+			return nil, err
+		}
+		return rs, nil
 	}
 
-	return storage.NewDistributedChunkStorage(
+	ds, err := storage.NewDistributedChunkStorage(
 		cluster, cfg.DataShardCount, cfg.ParityShardCount, jobCount)
+	if err != nil {
+		return nil, err
+	}
+	if err = ds.SetReadConfig(cfg.Read); err != nil {
+		return nil, err
+	}
+	return ds, nil
 }
 
 // Config is used to configure and create a pipeline.
@@ -194,6 +217,13 @@ type Config struct {
 	// into multiple objects prior to writing.
 	BlockSize int `yaml:"block_size" json:"block_size"`
 
+	// Chunking defines, when enabled, how to split the data into chunks of variable size,
+	// using content-defined chunking, in which case the BlockSize is ignored.
+	// Disabled by default.
+	//
+	// See `ChunkingConfig` for more about its individual properties.
+	Chunking ChunkingConfig `yaml:"chunking" json:"chunking"`
+
 	// Hashing can not be disabled, as it is an essential part of the pipeline.
 	// The keys of all stored blocks (in zstordb), are generated and
 	// are equal to the checksum/signature of that block's (binary) data.
@@ -288,6 +318,32 @@ type HashingConfig struct {
 	PrivateKey string `yaml:"private_key" json:"private_key"`
 }
 
+// ChunkingConfig defines the configuration used to split data
+// into chunks of variable size, using content-defined chunking.
+//
+// As the boundaries of content-defined chunks only depend upon the data itself,
+// inserting or removing data only changes the chunks around that data,
+// rather than all chunks that follow it, as is the case for "fixed-size" blocks.
+// This makes it the preferred way to split data which is deduplicated,
+// such as (versioned) backups which are mostly equal to one another.
+type ChunkingConfig struct {
+	// AverageSize defines the size which the size of the chunks converges to.
+	// Content-defined chunking is enabled as soon as this size is positive,
+	// in which case it has to be at least 8 bytes.
+	// As the rolling hash used to find the boundaries covers the last 64 bytes,
+	// it is recommended to use an average size (far) above that, such as 64 KiB.
+	AverageSize int `yaml:"average_size" json:"average_size"`
+
+	// MinSize defines the minimum size of each chunk,
+	// except for the last chunk which might be smaller.
+	// By default it is a quarter of the average size.
+	MinSize int `yaml:"min_size" json:"min_size"`
+
+	// MaxSize defines the maximum size of each chunk.
+	// By default it is eight times the average size.
+	MaxSize int `yaml:"max_size" json:"max_size"`
+}
+
 // CompressionConfig defines the configuration used to create a
 // compressor-decompressor Processor.
 type CompressionConfig struct {
@@ -375,4 +431,27 @@ type ObjectDistributionConfig struct {
 	// you would still be able to read data, writing and repairing would no longer be possible.
 	// There in our example it would be better if we provide more than 13 shards.
 	ParityShardCount int `yaml:"parity_shards" json:"parity_shards"`
+
+	// Read defines how the objects of replicated and distributed blocks are read,
+	// preferring the fastest shards, and hedging slow reads.
+	// It doesn't affect how data is stored, and can be changed at any time.
+	//
+	// See `storage.ReadConfig` for more about its individual properties.
+	Read storage.ReadConfig `yaml:"read" json:"read"`
+}
+
+// MaxShardsPerDomain returns the max amount of objects of a single chunk,
+// which the chunk storage created for this config stores within a single failure domain,
+// such that the loss of a single domain never makes a chunk unreadable.
+// It returns 0 in case a chunk is stored as a single object, in which case it isn't limited.
+func (cfg ObjectDistributionConfig) MaxShardsPerDomain() int {
+	if cfg.DataShardCount <= 0 {
+		return 0
+	}
+	if cfg.ParityShardCount <= 0 {
+		// one replica has to survive
+		return cfg.DataShardCount - 1
+	}
+	// no more parts than there is parity can be lost
+	return cfg.ParityShardCount
 }
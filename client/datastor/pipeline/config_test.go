@@ -129,6 +129,11 @@ var configTestCases = []struct {
 	{"chunk_42", Config{BlockSize: 42}},
 	{"chunk_128", Config{BlockSize: 128}},
 	{"chunk_4096", Config{BlockSize: 4096}},
+	{"content_defined_chunk_64", Config{Chunking: ChunkingConfig{AverageSize: 64}}},
+	{"content_defined_chunk_16_32_128", Config{
+		BlockSize: 4096,
+		Chunking:  ChunkingConfig{MinSize: 16, AverageSize: 32, MaxSize: 128},
+	}},
 
 	// distribution-only configs
 	{"replication_2", Config{
@@ -286,6 +291,16 @@ compression:
 distribution:
     data_shards: 10
     parity_shards: 3
+`}, {`content_defined_chunk(64)+compression(default)+erasure_code(k=1_m=1)`, `---
+chunking:
+    average_size: 64
+    min_size: 32
+    max_size: 256
+compression:
+    mode: default
+distribution:
+    data_shards: 1
+    parity_shards: 1
 `},
 }
 
@@ -17,6 +17,7 @@
 package pipeline
 
 import (
+	"context"
 	"crypto/md5"
 	"errors"
 	"io"
@@ -39,9 +40,16 @@ func NewDevNull(chunkSize int) Pipeline {
 }
 
 func (d *devNull) Write(r io.Reader) ([]metatypes.Chunk, error) {
+	return d.WriteContext(context.Background(), r)
+}
+
+func (d *devNull) WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error) {
 	var chunks []metatypes.Chunk
 	buf := make([]byte, d.chunkSize)
 	for {
+		if err := ctx.Err(); err != nil {
+			return chunks, err
+		}
 		reader := io.LimitReader(r, int64(d.chunkSize))
 		hasher := md5.New()
 		n, err := io.CopyBuffer(hasher, reader, buf)
@@ -68,24 +76,40 @@ func (d *devNull) Read(chunks []metatypes.Chunk, w io.Writer) error {
 	return errOperationNotSupported
 }
 
+func (d *devNull) ReadContext(ctx context.Context, chunks []metatypes.Chunk, w io.Writer) error {
+	return errOperationNotSupported
+}
+
 // Check if content stored on a zstordb cluster is (still) valid,
 // the details depend upon the specific implementation.
 func (d *devNull) Check(chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
 	return storage.CheckStatusInvalid, errOperationNotSupported
 }
 
+func (d *devNull) CheckContext(ctx context.Context, chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
+	return storage.CheckStatusInvalid, errOperationNotSupported
+}
+
 // Repair content stored on a zstordb cluster,
 // the details depend upon the specific implementation.
 func (d *devNull) Repair(chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
 	return nil, errOperationNotSupported
 }
 
+func (d *devNull) RepairContext(ctx context.Context, chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
+	return nil, errOperationNotSupported
+}
+
 // Delete content stored on a zstordb cluster,
 // the details depend upon the specific implementation.
 func (d *devNull) Delete(chunks []metatypes.Chunk) error {
 	return errOperationNotSupported
 }
 
+func (d *devNull) DeleteContext(ctx context.Context, chunks []metatypes.Chunk) error {
+	return errOperationNotSupported
+}
+
 // ChunkSize returns the fixed chunk size, which is size used for all chunks,
 // except for the last chunk which might be less or equal to that chunk size.
 func (d *devNull) ChunkSize() int {
@@ -29,6 +29,9 @@
 // without splitting the data up, this would be impossible,
 // due to the fact that for processing all this data has to be read into
 // memory of both the client and server, prior to storage in the database.
+// Data can also be split into blocks of variable size instead,
+// using content-defined chunking, such that inserting or removing data
+// only changes the blocks around that data.
 //
 // Each object has a key, which identifies the data.
 // In this pipeline model, the key is generated automatically,
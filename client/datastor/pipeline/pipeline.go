@@ -17,6 +17,7 @@
 package pipeline
 
 import (
+	"context"
 	"io"
 
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline/crypto"
@@ -38,33 +39,95 @@ import (
 // content was compressed and encrypted using a certain configuration,
 // it will have to be decrypted and decompressed using that same configuration,
 // or else the content will not be able to be read.
+//
+// Each method which interacts with the zstordb cluster has a variant taking a context,
+// which can be used to cancel the operation or give it a deadline.
+// When that happens, the context's error is returned.
+// The variants without a context use context.Background().
 type Pipeline interface {
 	// Write content to a zstordb cluster,
 	// the details depend upon the specific implementation.
+	//
+	// In case the write fails, all objects which were already written
+	// are deleted on a best-effort basis. If not all of them could be deleted,
+	// a *storage.RollbackError is returned, listing the objects which remain stored.
 	Write(r io.Reader) ([]metatypes.Chunk, error)
+	// WriteContext writes content just like Write,
+	// aborting as soon as the given context is done.
+	WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error)
 	// Read content from a zstordb cluster,
 	// the details depend upon the specific implementation.
 	Read(chunks []metatypes.Chunk, w io.Writer) error
+	// ReadContext reads content just like Read,
+	// aborting as soon as the given context is done.
+	ReadContext(ctx context.Context, chunks []metatypes.Chunk, w io.Writer) error
 
 	// Check if content stored on a zstordb cluster is (still) valid,
 	// the details depend upon the specific implementation.
 	Check(chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error)
+	// CheckContext checks content just like Check,
+	// aborting as soon as the given context is done.
+	CheckContext(ctx context.Context, chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error)
 	// Repair content stored on a zstordb cluster,
 	// the details depend upon the specific implementation.
 	Repair(chunks []metatypes.Chunk) ([]metatypes.Chunk, error)
+	// RepairContext repairs content just like Repair,
+	// aborting as soon as the given context is done.
+	RepairContext(ctx context.Context, chunks []metatypes.Chunk) ([]metatypes.Chunk, error)
 
 	// Delete content stored on a zstordb cluster,
 	// the details depend upon the specific implementation.
 	Delete(chunks []metatypes.Chunk) error
+	// DeleteContext deletes content just like Delete,
+	// aborting as soon as the given context is done.
+	DeleteContext(ctx context.Context, chunks []metatypes.Chunk) error
 
 	// ChunkSize returns the fixed chunk size, which is size used for all chunks,
 	// except for the last chunk which might be less or equal to that chunk size.
+	// metatypes.VariableChunkSize is returned in case the chunks are of variable size,
+	// in which case each written chunk defines its offset.
 	ChunkSize() int
 
 	// Close any open resources.
 	Close() error
 }
 
+// LookupPipeline defines a pipeline which can look up each chunk prior to processing and storing it,
+// such that data which is stored already doesn't have to be stored again.
+type LookupPipeline interface {
+	Pipeline
+
+	// WriteLookup writes content just like WriteContext, looking up the hash of each chunk
+	// using the given lookup, and only processing and storing the chunks
+	// for which the lookup doesn't return a stored chunk.
+	// Chunks returned by the lookup are never deleted by the pipeline,
+	// not even in case the write fails.
+	WriteLookup(ctx context.Context, r io.Reader, lookup ChunkLookup) ([]metatypes.Chunk, error)
+}
+
+// ChunkingPipeline defines a pipeline which can split content into chunks without writing it,
+// such that the boundaries of content-defined chunks can be found prior to writing the content.
+type ChunkingPipeline interface {
+	Pipeline
+
+	// NewChunker returns a Chunker which splits the content of the given reader
+	// into the same chunks as the pipeline splits that content into when writing it,
+	// or nil in case the pipeline splits content into chunks of a fixed size (see ChunkSize).
+	NewChunker(r io.Reader) Chunker
+}
+
+// Chunker splits content into chunks.
+type Chunker interface {
+	// Next returns the data of the next chunk, which is only valid until the next call,
+	// or io.EOF in case all content has been split.
+	Next() ([]byte, error)
+}
+
+// ChunkLookup is the type of function used by a LookupPipeline,
+// to look up the stored chunk of the data with the given hash.
+// It returns nil in case no chunk is stored for that hash yet.
+type ChunkLookup func(hash []byte) (*metatypes.Chunk, error)
+
 // Constructor types which are used to create unique instances of the types involved,
 // for each branch (goroutine) of a pipeline.
 type (
@@ -18,6 +18,7 @@ package pipeline
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -73,6 +74,11 @@ type SingleObjectPipeline struct {
 }
 
 // Write implements Pipeline.Write
+func (sop *SingleObjectPipeline) Write(r io.Reader) ([]metatypes.Chunk, error) {
+	return sop.WriteContext(context.Background(), r)
+}
+
+// WriteContext implements Pipeline.WriteContext
 //
 // The following graph visualizes the logic of this pipeline's Write method:
 //
@@ -85,7 +91,12 @@ type SingleObjectPipeline struct {
 //
 // When an error is returned by a sub-call, at any point,
 // the function will return immediately with that error.
-func (sop *SingleObjectPipeline) Write(r io.Reader) ([]metatypes.Chunk, error) {
+func (sop *SingleObjectPipeline) WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error) {
+	return sop.WriteLookup(ctx, r, nil)
+}
+
+// WriteLookup implements LookupPipeline.WriteLookup
+func (sop *SingleObjectPipeline) WriteLookup(ctx context.Context, r io.Reader, lookup ChunkLookup) ([]metatypes.Chunk, error) {
 	if r == nil {
 		return nil, errors.New("no reader given to read from")
 	}
@@ -105,13 +116,25 @@ func (sop *SingleObjectPipeline) Write(r io.Reader) ([]metatypes.Chunk, error) {
 		return nil, err
 	}
 	hash := hasher.HashBytes(input)
+	if lookup != nil {
+		chunk, err := lookup(hash)
+		if err != nil {
+			return nil, err
+		}
+		if chunk != nil {
+			// the offset is defined by the written data
+			stored := *chunk
+			stored.Offset = 0
+			return []metatypes.Chunk{stored}, nil
+		}
+	}
 
 	data, err := processor.WriteProcess(input)
 	if err != nil {
 		return nil, err
 	}
 
-	cfg, err := sop.storage.WriteChunk(data)
+	cfg, err := sop.storage.WriteChunkContext(ctx, data)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +149,11 @@ func (sop *SingleObjectPipeline) Write(r io.Reader) ([]metatypes.Chunk, error) {
 }
 
 // Read implements Pipeline.Read
+func (sop *SingleObjectPipeline) Read(chunks []metatypes.Chunk, w io.Writer) error {
+	return sop.ReadContext(context.Background(), chunks, w)
+}
+
+// ReadContext implements Pipeline.ReadContext
 //
 // The following graph visualizes the logic of this pipeline's Read method:
 //
@@ -143,7 +171,7 @@ func (sop *SingleObjectPipeline) Write(r io.Reader) ([]metatypes.Chunk, error) {
 //
 // When an error is returned by a sub-call, at any point,
 // the function will return immediately with that error.
-func (sop *SingleObjectPipeline) Read(chunks []metatypes.Chunk, w io.Writer) error {
+func (sop *SingleObjectPipeline) ReadContext(ctx context.Context, chunks []metatypes.Chunk, w io.Writer) error {
 	if len(chunks) != 1 {
 		return errUnexpectedChunkCount
 	}
@@ -161,7 +189,7 @@ func (sop *SingleObjectPipeline) Read(chunks []metatypes.Chunk, w io.Writer) err
 		return err
 	}
 
-	data, err := sop.storage.ReadChunk(storage.ChunkConfig{
+	data, err := sop.storage.ReadChunkContext(ctx, storage.ChunkConfig{
 		Size:    chunks[0].Size,
 		Objects: chunks[0].Objects,
 	})
@@ -183,10 +211,15 @@ func (sop *SingleObjectPipeline) Read(chunks []metatypes.Chunk, w io.Writer) err
 
 // Check implements Pipeline.Check
 func (sop *SingleObjectPipeline) Check(chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
+	return sop.CheckContext(context.Background(), chunks, fast)
+}
+
+// CheckContext implements Pipeline.CheckContext
+func (sop *SingleObjectPipeline) CheckContext(ctx context.Context, chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
 	if len(chunks) != 1 {
 		return storage.CheckStatus(0), errUnexpectedChunkCount
 	}
-	return sop.storage.CheckChunk(storage.ChunkConfig{
+	return sop.storage.CheckChunkContext(ctx, storage.ChunkConfig{
 		Size:    chunks[0].Size,
 		Objects: chunks[0].Objects,
 	}, fast)
@@ -194,10 +227,15 @@ func (sop *SingleObjectPipeline) Check(chunks []metatypes.Chunk, fast bool) (sto
 
 // Repair implements Pipeline.Repair
 func (sop *SingleObjectPipeline) Repair(chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
+	return sop.RepairContext(context.Background(), chunks)
+}
+
+// RepairContext implements Pipeline.RepairContext
+func (sop *SingleObjectPipeline) RepairContext(ctx context.Context, chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
 	if len(chunks) != 1 {
 		return nil, errUnexpectedChunkCount
 	}
-	cfg, err := sop.storage.RepairChunk(storage.ChunkConfig{
+	cfg, err := sop.storage.RepairChunkContext(ctx, storage.ChunkConfig{
 		Size:    chunks[0].Size,
 		Objects: chunks[0].Objects,
 	})
@@ -213,10 +251,15 @@ func (sop *SingleObjectPipeline) Repair(chunks []metatypes.Chunk) ([]metatypes.C
 
 // Delete implements Pipeline.Delete
 func (sop *SingleObjectPipeline) Delete(chunks []metatypes.Chunk) error {
+	return sop.DeleteContext(context.Background(), chunks)
+}
+
+// DeleteContext implements Pipeline.DeleteContext
+func (sop *SingleObjectPipeline) DeleteContext(ctx context.Context, chunks []metatypes.Chunk) error {
 	if len(chunks) != 1 {
 		return errUnexpectedChunkCount
 	}
-	return sop.storage.DeleteChunk(storage.ChunkConfig{
+	return sop.storage.DeleteChunkContext(ctx, storage.ChunkConfig{
 		Size:    chunks[0].Size,
 		Objects: chunks[0].Objects,
 	})
@@ -238,5 +281,6 @@ var (
 )
 
 var (
-	_ Pipeline = (*SingleObjectPipeline)(nil)
+	_ Pipeline       = (*SingleObjectPipeline)(nil)
+	_ LookupPipeline = (*SingleObjectPipeline)(nil)
 )
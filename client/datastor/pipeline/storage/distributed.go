@@ -63,6 +63,7 @@ func NewDistributedChunkStorageWithEncoderDecoder(cluster datastor.Cluster, dec
 		cluster:  cluster,
 		dec:      dec,
 		jobCount: jobCount,
+		reader:   newObjectReader(cluster),
 	}
 }
 
@@ -70,6 +71,8 @@ func NewDistributedChunkStorageWithEncoderDecoder(cluster datastor.Cluster, dec
 // which splits and distributes data over a secure amount of shards,
 // rather than just writing it to a single shard as it is.
 // This to provide protection against data loss when one of the used shards drops.
+// The parts are spread over the failure domains (zones, racks and hosts) of the shards,
+// never storing more parts within a single domain than there are parity parts.
 //
 // By default the erasure code algorithms as implemented in
 // the github.com/templexxx/reedsolomon library are used,
@@ -77,22 +80,47 @@ func NewDistributedChunkStorageWithEncoderDecoder(cluster datastor.Cluster, dec
 // When using this default distributed encoder-decoder,
 // you need to provide at least 2 shards (1 data- and 1 parity- shard).
 //
+// The parts are read from the shards which responded the fastest recently,
+// sending hedged reads for other parts in case a read is slow,
+// see `ReadConfig` for more information.
+//
 // When creating a DistributedChunkStorage you can also pass in your
 // own DistributedEncoderDecoder should you not be satisfied with the default implementation.
 type DistributedChunkStorage struct {
 	cluster  datastor.Cluster
 	dec      DistributedEncoderDecoder
 	jobCount int
+	reader   *objectReader
+}
+
+// SetReadConfig configures how the distributed parts are read.
+// See `ReadConfig` for more information.
+//
+// It should be called prior to using the storage,
+// and returns an error in case the given config is invalid.
+func (ds *DistributedChunkStorage) SetReadConfig(cfg ReadConfig) error {
+	cfg, err := sanitizeReadConfig(cfg)
+	if err != nil {
+		return err
+	}
+	ds.reader.cfg = cfg
+	return nil
 }
 
 // WriteChunk implements storage.ChunkStorage.WriteChunk
 func (ds *DistributedChunkStorage) WriteChunk(data []byte) (*ChunkConfig, error) {
+	return ds.WriteChunkContext(context.Background(), data)
+}
+
+// WriteChunkContext implements storage.ChunkStorage.WriteChunkContext
+func (ds *DistributedChunkStorage) WriteChunkContext(ctx context.Context, data []byte) (*ChunkConfig, error) {
 	parts, err := ds.dec.Encode(data)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	parent := ctx
+	ctx, cancel := context.WithCancel(parent)
 	defer cancel()
 	group, ctx := errgroup.WithContext(ctx)
 
@@ -123,10 +151,12 @@ func (ds *DistributedChunkStorage) WriteChunk(data []byte) (*ChunkConfig, error)
 		}
 	}()
 
-	// create a channel-based iterator, to fetch the shards,
-	// randomly and thread-save
-	shardCh := datastor.ShardIteratorChannel(ctx,
-		ds.cluster.GetShardIterator(nil))
+	// create a thread-safe placement, to fetch the shards,
+	// spread over the failure domains,
+	// such that the loss of a single domain never loses more parts than there is parity
+	placement := newShardPlacement(placementShardIterator(ds.cluster, data, nil, nil,
+		ds.dec.RequiredShardCount()-ds.dec.MinimumValidShardCount()))
+	creator := newObjectCreator(data)
 
 	// write all the different parts to their own separate shard,
 	// and return the written object information over the resultCh,
@@ -162,32 +192,31 @@ func (ds *DistributedChunkStorage) WriteChunk(data []byte) (*ChunkConfig, error)
 				// or until we have written to a shard
 			writeLoop:
 				for {
-					// fetch a random shard,
+					// fetch the next shard,
 					// it's an error if this is not possible,
 					// as a shard is expected to be still available at this stage
-					select {
-					case shard, open = <-shardCh:
-						if !open {
-							// not enough shards are available,
-							// we know this because the iterator ch has already been closed
-							return ErrShardsUnavailable
-						}
-					case <-ctx.Done():
+					if ctx.Err() != nil {
 						return errors.New("context was unexpectedly cancelled, " +
 							"while fetching shard for a distribute-write request")
 					}
+					shard, open = placement.next()
+					if !open {
+						// not enough shards are available,
+						// as no more shards can be placed
+						return ErrShardsUnavailable
+					}
 
-					// do the actual storage
-					key, err = shard.CreateObject(part.Data)
+					// do the actual storage, using the parent context,
+					// such that an in-flight write isn't abandoned when another worker fails,
+					// as the key of an abandoned object is unknown, making it impossible to roll back
+					key, err = creator.create(parent, shard, part.Index, part.Data)
+					placement.done(shard, err == nil)
 					if err == nil {
 						object := metatypes.Object{Key: key, ShardID: shard.Identifier()}
-						select {
-						case resultCh <- indexedObject{part.Index, object}:
-							break writeLoop
-						case <-ctx.Done():
-							return errors.New("context was unexpectedly cancelled, " +
-								"while returning the identifier of a shard for a distribute-write request")
-						}
+						// always return the written object, even when the context is cancelled,
+						// such that it can be rolled back in case the write fails
+						resultCh <- indexedObject{part.Index, object}
+						break writeLoop
 					}
 
 					// check if the error is because the namespace if full
@@ -226,20 +255,29 @@ func (ds *DistributedChunkStorage) WriteChunk(data []byte) (*ChunkConfig, error)
 		resultCount++
 	}
 
-	cfg := ChunkConfig{Size: int64(len(data)), Objects: objects}
-	// check if we have sufficient distributions
+	// check if we have sufficient distributions,
+	// rolling back the written objects if not
 	if resultCount < partsCount {
-		return &cfg, ErrShardsUnavailable
+		err := parent.Err()
+		if err == nil {
+			err = ErrShardsUnavailable
+		}
+		return nil, rollbackWrite(ds.cluster, objects, err)
 	}
-	return &cfg, nil
+	return &ChunkConfig{Size: int64(len(data)), Objects: objects}, nil
 }
 
 // ReadChunk implements storage.ChunkStorage.ReadChunk
 func (ds *DistributedChunkStorage) ReadChunk(cfg ChunkConfig) ([]byte, error) {
-	return ds.readChunk(cfg, false)
+	return ds.ReadChunkContext(context.Background(), cfg)
 }
 
-func (ds *DistributedChunkStorage) readChunk(cfg ChunkConfig, checkStatus bool) ([]byte, error) {
+// ReadChunkContext implements storage.ChunkStorage.ReadChunkContext
+func (ds *DistributedChunkStorage) ReadChunkContext(ctx context.Context, cfg ChunkConfig) ([]byte, error) {
+	return ds.readChunk(ctx, cfg, false)
+}
+
+func (ds *DistributedChunkStorage) readChunk(ctx context.Context, cfg ChunkConfig, checkStatus bool) ([]byte, error) {
 	// validate the input object count
 	objectCount := len(cfg.Objects)
 
@@ -249,141 +287,42 @@ func (ds *DistributedChunkStorage) readChunk(cfg ChunkConfig, checkStatus bool)
 	}
 	minimumShardCount := ds.dec.MinimumValidShardCount()
 
-	// define the jobCount
-	jobCount := ds.jobCount
-	if jobCount > objectCount {
-		jobCount = objectCount
-	}
-
-	// create our sync-purpose variables
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	group, ctx := errgroup.WithContext(ctx)
-
-	// create a channel-based iterator, to get the objects,
-	// in sequence as given, and thread-save.
-	objectIndexCh := make(chan int, jobCount)
-	go func() {
-		defer close(objectIndexCh)
-		for index := range cfg.Objects {
-			select {
-			case objectIndexCh <- index:
-			case <-ctx.Done():
-				return
-			}
-		}
-	}()
-
-	type readResult struct {
-		Index int
-		Data  []byte
-	}
-
-	// read all the needed parts,
-	// from the available datashards
-	resultCh := make(chan readResult, jobCount)
-	// create all the actual workers
-	for i := 0; i < jobCount; i++ {
-		group.Go(func() error {
-			var (
-				open        bool
-				object      *datastor.Object
-				err         error
-				shard       datastor.Shard
-				index       int
-				inputObject metatypes.Object
-			)
-			for {
-				// fetch a random shard
-				select {
-				case index, open = <-objectIndexCh:
-					if !open {
-						return nil
-					}
-				case <-ctx.Done():
-					return nil
-				}
-				inputObject = cfg.Objects[index]
-				shard, err = ds.cluster.GetShard(inputObject.ShardID)
+	// read the minimum amount of parts needed, from the fastest shards,
+	// reading other parts in case a read fails or is slow
+	results, err := ds.reader.read(ctx, cfg.Objects, minimumShardCount, ds.jobCount,
+		func(ctx context.Context, shard datastor.Shard, inputObject metatypes.Object) ([]byte, error) {
+			if checkStatus {
+				// check chunk status. Used for repair
+				// we need to know if we can use this shard to reconstruct
+				//  the file or not
+				status, err := shard.GetObjectStatusContext(ctx, inputObject.Key)
 				if err != nil {
-					// casually log the shard-get error,
-					// and continue trying with another object...
-					log.WithFields(log.Fields{
-						"shard":  inputObject.ShardID,
-						"object": inputObject.Key,
-					}).WithError(err).Errorf("failed to get object")
-					continue
+					return nil, err
 				}
-
-				if checkStatus {
-					// check chunk status. Used for repair
-					// we need to know if we can use this shard to reconstruct
-					//  the file or not
-					status, err := shard.GetObjectStatus(inputObject.Key)
-					if err != nil {
-						log.WithFields(log.Fields{
-							"shard":  inputObject.ShardID,
-							"object": inputObject.Key,
-						}).WithError(err).Errorf("error while checking status of object")
-						continue
-					}
-					if status != datastor.ObjectStatusOK {
-						log.Debugf("object %q stored on shard %q is not valid: %s",
-							inputObject.Key, inputObject.Key, status)
-						continue
-					}
+				if status != datastor.ObjectStatusOK {
+					return nil, fmt.Errorf("object is not valid: %s", status)
 				}
+			}
 
-				// fetch the data part
-				object, err = shard.GetObject(inputObject.Key)
-				if err != nil {
-					// casually log the shard-read error,
-					// and continue trying with another shard...
-					log.WithFields(log.Fields{
-						"shard":  inputObject.ShardID,
-						"object": inputObject.Key,
-					}).WithError(err).Errorf("failed to read object")
-					continue // try another shard
-				}
-				result := readResult{
-					Index: index,
-					Data:  object.Data,
-				}
-				select {
-				case resultCh <- result:
-				case <-ctx.Done():
-					// this can be expected in case we reached the minimum shards needed
-					return nil
-				}
+			// fetch the data part
+			object, err := shard.GetObjectContext(ctx, inputObject.Key)
+			if err != nil {
+				return nil, err
 			}
+			return object.Data, nil
 		})
+	if err != nil {
+		return nil, err
 	}
 
-	// close the result channel,
-	// when all grouped goroutines are finished, so it can be used as an iterator
-	go func() {
-		err := group.Wait()
-		if err != nil {
-			log.WithError(err).Errorf("distribute-read has failed due to an error")
-		}
-		close(resultCh)
-	}()
-
-	// collect all the different distributed parts
+	// put all the different distributed parts in the correct slot
 	var (
-		resultCount int
+		resultCount = len(results)
 
 		parts = make([][]byte, requiredObjectCount)
 	)
-
-	for result := range resultCh {
-		// put the part in the correct slot
-		parts[result.Index] = result.Data
-		resultCount++
-
-		if resultCount == minimumShardCount {
-			break
-		}
+	for index, data := range results {
+		parts[index] = data
 	}
 
 	// ensure that we have received all the different parts
@@ -406,6 +345,11 @@ func (ds *DistributedChunkStorage) readChunk(cfg ChunkConfig, checkStatus bool)
 
 // CheckChunk implements storage.ChunkStorage.CheckChunk
 func (ds *DistributedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckStatus, error) {
+	return ds.CheckChunkContext(context.Background(), cfg, fast)
+}
+
+// CheckChunkContext implements storage.ChunkStorage.CheckChunkContext
+func (ds *DistributedChunkStorage) CheckChunkContext(ctx context.Context, cfg ChunkConfig, fast bool) (CheckStatus, error) {
 	// validate the input shard count
 	objectCount := len(cfg.Objects)
 
@@ -428,7 +372,8 @@ func (ds *DistributedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (Check
 		jobCount = searchObjectCount
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	parent := ctx
+	ctx, cancel := context.WithCancel(parent)
 	defer cancel()
 	group, ctx := errgroup.WithContext(ctx)
 
@@ -515,7 +460,7 @@ func (ds *DistributedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (Check
 					}
 
 					// validate if the object's status for this shard is OK
-					status, err = shard.GetObjectStatus(object.Key)
+					status, err = shard.GetObjectStatusContext(ctx, object.Key)
 					if err != nil {
 						log.WithFields(log.Fields{
 							"shard":  object.ShardID,
@@ -560,6 +505,9 @@ func (ds *DistributedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (Check
 	}
 
 	// return the result
+	if err := parent.Err(); err != nil {
+		return CheckStatusInvalid, err
+	}
 	if validObjectCount == requiredObjectCount {
 		return CheckStatusOptimal, nil
 	}
@@ -571,15 +519,25 @@ func (ds *DistributedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (Check
 
 // RepairChunk implements storage.ChunkStorage.RepairChunk
 func (ds *DistributedChunkStorage) RepairChunk(cfg ChunkConfig) (*ChunkConfig, error) {
-	obj, err := ds.readChunk(cfg, true)
+	return ds.RepairChunkContext(context.Background(), cfg)
+}
+
+// RepairChunkContext implements storage.ChunkStorage.RepairChunkContext
+func (ds *DistributedChunkStorage) RepairChunkContext(ctx context.Context, cfg ChunkConfig) (*ChunkConfig, error) {
+	obj, err := ds.readChunk(ctx, cfg, true)
 	if err != nil {
 		return nil, err
 	}
-	return ds.WriteChunk(obj)
+	return ds.WriteChunkContext(ctx, obj)
 }
 
 // DeleteChunk implements storage.ChunkStorage.DeleteChunk
 func (ds *DistributedChunkStorage) DeleteChunk(cfg ChunkConfig) error {
+	return ds.DeleteChunkContext(context.Background(), cfg)
+}
+
+// DeleteChunkContext implements storage.ChunkStorage.DeleteChunkContext
+func (ds *DistributedChunkStorage) DeleteChunkContext(ctx context.Context, cfg ChunkConfig) error {
 	objectLength := len(cfg.Objects)
 	if objectLength == 0 {
 		// if no objects are given, something is wrong
@@ -594,7 +552,7 @@ func (ds *DistributedChunkStorage) DeleteChunk(cfg ChunkConfig) error {
 		if err != nil {
 			return err
 		}
-		return shard.DeleteObject(obj.Key)
+		return shard.DeleteObjectContext(ctx, obj.Key)
 	}
 
 	// limit our job count,
@@ -605,7 +563,7 @@ func (ds *DistributedChunkStorage) DeleteChunk(cfg ChunkConfig) error {
 	}
 
 	// create an errgroup for all our delete jobs
-	group, ctx := errgroup.WithContext(context.Background())
+	group, ctx := errgroup.WithContext(ctx)
 
 	// spawn our object fetcher
 	indexCh := make(chan int, jobCount)
@@ -634,7 +592,7 @@ func (ds *DistributedChunkStorage) DeleteChunk(cfg ChunkConfig) error {
 				if err != nil {
 					return err
 				}
-				err = shard.DeleteObject(obj.Key)
+				err = shard.DeleteObjectContext(ctx, obj.Key)
 				if err != nil {
 					return err
 				}
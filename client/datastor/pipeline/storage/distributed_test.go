@@ -308,6 +308,98 @@ func invalidateObjects(t *testing.T, objects []metatypes.Object, n int, cluster
 	}
 }
 
+func TestDistributedStoragePlacement(t *testing.T) {
+	require := require.New(t)
+
+	// 4 data and 2 parity parts, spread over 3 zones,
+	// can store at most 2 parts per zone
+	cluster, cleanup, err := newZdbServerTopologyCluster(zoneTopologies(3, "a", "b", "c"))
+	require.NoError(err)
+	defer cleanup()
+
+	storage, err := NewDistributedChunkStorage(cluster, 4, 2, 0)
+	require.NoError(err)
+
+	for i := 0; i < 16; i++ {
+		data := make([]byte, 1024)
+		_, err = rand.Read(data)
+		require.NoError(err)
+
+		cfg, err := storage.WriteChunk(data)
+		require.NoError(err)
+		require.Len(cfg.Objects, 6)
+		counts, err := zoneCounts(cluster, cfg.Objects)
+		require.NoError(err)
+		require.Equal(map[string]int{"a": 2, "b": 2, "c": 2}, counts)
+
+		// losing any zone still leaves enough parts to read the chunk
+		for zone := range counts {
+			lost := ChunkConfig{Size: cfg.Size, Objects: make([]metatypes.Object, len(cfg.Objects))}
+			for index, obj := range cfg.Objects {
+				shard, err := cluster.GetShard(obj.ShardID)
+				require.NoError(err)
+				if shard.Topology().Zone != zone {
+					lost.Objects[index] = obj
+				}
+			}
+			out, err := storage.ReadChunk(lost)
+			require.NoError(err)
+			require.Equal(data, out)
+		}
+	}
+}
+
+func TestDistributedStoragePlacementFailedWrites(t *testing.T) {
+	require := require.New(t)
+
+	// 4 data and 2 parity parts, spread over 3 zones,
+	// where a single shard of each zone fails all writes
+	cluster, cleanup, err := newZdbServerTopologyCluster(zoneTopologies(3, "a", "b", "c"))
+	require.NoError(err)
+	defer cleanup()
+
+	faulty := &faultyCluster{Cluster: cluster, failWrite: make(map[string]bool)}
+	faultyZones := make(map[string]bool)
+	it := cluster.GetShardIterator(nil)
+	for it.Next() {
+		zone := it.Shard().Topology().Zone
+		if !faultyZones[zone] {
+			faultyZones[zone] = true
+			faulty.failWrite[it.Shard().Identifier()] = true
+		}
+	}
+
+	storage, err := NewDistributedChunkStorage(faulty, 4, 2, 0)
+	require.NoError(err)
+
+	// the failed shards don't take the place of the other shards of their zone
+	for i := 0; i < 16; i++ {
+		cfg, err := storage.WriteChunk([]byte("data"))
+		require.NoError(err)
+		counts, err := zoneCounts(cluster, cfg.Objects)
+		require.NoError(err)
+		require.Equal(map[string]int{"a": 2, "b": 2, "c": 2}, counts)
+	}
+}
+
+func TestDistributedStoragePlacementErrors(t *testing.T) {
+	require := require.New(t)
+
+	// 2 zones can only hold 4 parts
+	cluster, cleanup, err := newZdbServerTopologyCluster(zoneTopologies(4, "a", "b"))
+	require.NoError(err)
+	defer cleanup()
+
+	storage, err := NewDistributedChunkStorage(cluster, 4, 2, 0)
+	require.NoError(err)
+
+	_, err = storage.WriteChunk([]byte("data"))
+	require.Equal(ErrShardsUnavailable, err)
+	count, err := (&faultyCluster{Cluster: cluster}).objectCount()
+	require.NoError(err)
+	require.Zero(count)
+}
+
 func TestReedSolomonEncoderDecoderErrors(t *testing.T) {
 	require := require.New(t)
 
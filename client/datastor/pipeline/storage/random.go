@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"context"
 	"errors"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
@@ -47,18 +48,25 @@ type RandomChunkStorage struct {
 
 // WriteChunk implements storage.ChunkStorage.WriteChunk
 func (rs *RandomChunkStorage) WriteChunk(data []byte) (*ChunkConfig, error) {
+	return rs.WriteChunkContext(context.Background(), data)
+}
+
+// WriteChunkContext implements storage.ChunkStorage.WriteChunkContext
+func (rs *RandomChunkStorage) WriteChunkContext(ctx context.Context, data []byte) (*ChunkConfig, error) {
 	var (
 		key   []byte
 		err   error
 		shard datastor.Shard
 	)
 
-	// go through all shards, in pseudo-random fashion,
+	// go through all shards, in pseudo-random fashion
+	// (or in the order defined by the data, if supported by the cluster),
 	// until the data could be written to one of them.
-	it := rs.cluster.GetShardIterator(nil)
+	creator := newObjectCreator(data)
+	it := seededShardIterator(rs.cluster, data, nil)
 	for it.Next() {
 		shard = it.Shard()
-		key, err = shard.CreateObject(data)
+		key, err = creator.create(ctx, shard, 0, data)
 		if err == nil {
 			return &ChunkConfig{
 				Size: int64(len(data)),
@@ -70,6 +78,11 @@ func (rs *RandomChunkStorage) WriteChunk(data []byte) (*ChunkConfig, error) {
 				},
 			}, nil
 		}
+		// no point in trying other shards,
+		// in case the context was cancelled or its deadline expired
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 
 		// check if the error is because the namespace if full
 		// if it is, we don't log the error.
@@ -86,6 +99,11 @@ func (rs *RandomChunkStorage) WriteChunk(data []byte) (*ChunkConfig, error) {
 
 // ReadChunk implements storage.ChunkStorage.ReadChunk
 func (rs *RandomChunkStorage) ReadChunk(cfg ChunkConfig) ([]byte, error) {
+	return rs.ReadChunkContext(context.Background(), cfg)
+}
+
+// ReadChunkContext implements storage.ChunkStorage.ReadChunkContext
+func (rs *RandomChunkStorage) ReadChunkContext(ctx context.Context, cfg ChunkConfig) ([]byte, error) {
 	if len(cfg.Objects) != 1 {
 		return nil, ErrUnexpectedObjectCount
 	}
@@ -96,7 +114,7 @@ func (rs *RandomChunkStorage) ReadChunk(cfg ChunkConfig) ([]byte, error) {
 		return nil, err
 	}
 
-	object, err := shard.GetObject(obj.Key)
+	object, err := shard.GetObjectContext(ctx, obj.Key)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +127,11 @@ func (rs *RandomChunkStorage) ReadChunk(cfg ChunkConfig) ([]byte, error) {
 
 // CheckChunk implements storage.ChunkStorage.CheckChunk
 func (rs *RandomChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckStatus, error) {
+	return rs.CheckChunkContext(context.Background(), cfg, fast)
+}
+
+// CheckChunkContext implements storage.ChunkStorage.CheckChunkContext
+func (rs *RandomChunkStorage) CheckChunkContext(ctx context.Context, cfg ChunkConfig, fast bool) (CheckStatus, error) {
 	if len(cfg.Objects) != 1 {
 		return CheckStatusInvalid, ErrUnexpectedObjectCount
 	}
@@ -119,7 +142,10 @@ func (rs *RandomChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckStatu
 		return CheckStatusInvalid, nil
 	}
 
-	status, err := shard.GetObjectStatus(obj.Key)
+	status, err := shard.GetObjectStatusContext(ctx, obj.Key)
+	if ctx.Err() != nil {
+		return CheckStatusInvalid, ctx.Err()
+	}
 	if err != nil || status != datastor.ObjectStatusOK {
 		return CheckStatusInvalid, nil
 	}
@@ -129,11 +155,21 @@ func (rs *RandomChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckStatu
 
 // RepairChunk implements storage.ChunkStorage.RepairChunk
 func (rs *RandomChunkStorage) RepairChunk(cfg ChunkConfig) (*ChunkConfig, error) {
+	return rs.RepairChunkContext(context.Background(), cfg)
+}
+
+// RepairChunkContext implements storage.ChunkStorage.RepairChunkContext
+func (rs *RandomChunkStorage) RepairChunkContext(ctx context.Context, cfg ChunkConfig) (*ChunkConfig, error) {
 	return nil, ErrNotSupported
 }
 
 // DeleteChunk implements storage.ChunkStorage.DeleteChunk
 func (rs *RandomChunkStorage) DeleteChunk(cfg ChunkConfig) error {
+	return rs.DeleteChunkContext(context.Background(), cfg)
+}
+
+// DeleteChunkContext implements storage.ChunkStorage.DeleteChunkContext
+func (rs *RandomChunkStorage) DeleteChunkContext(ctx context.Context, cfg ChunkConfig) error {
 	if len(cfg.Objects) != 1 {
 		return ErrUnexpectedObjectCount
 	}
@@ -142,7 +178,7 @@ func (rs *RandomChunkStorage) DeleteChunk(cfg ChunkConfig) error {
 	if err != nil {
 		return err
 	}
-	return shard.DeleteObject(obj.Key)
+	return shard.DeleteObjectContext(ctx, obj.Key)
 }
 
 // Close implements ChunkStorage.Close
@@ -0,0 +1,260 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultHedgePercentile is the hedge percentile used,
+	// in case no hedge percentile is defined in the ReadConfig.
+	DefaultHedgePercentile = 95
+	// DefaultHedgeDelay is the hedge delay used,
+	// in case no hedge delay is defined in the ReadConfig.
+	DefaultHedgeDelay = 50 * time.Millisecond
+)
+
+// ReadConfig defines how the objects of a chunk are read from their shards.
+//
+// The read latency of each shard is tracked, and the objects stored on
+// the fastest shards are read first. In case a read takes longer than
+// the hedge percentile of the latency of its shard, a hedged read is sent
+// to an alternate shard, and the slower of both reads is cancelled.
+type ReadConfig struct {
+	// HedgePercentile defines the percentile, in the range of (0, 100),
+	// of the read latency of a shard, after which a hedged read is sent to an alternate shard.
+	// DefaultHedgePercentile is used in case it is zero,
+	// use a negative percentile to disable hedged reads.
+	HedgePercentile float64 `yaml:"hedge_percentile" json:"hedge_percentile"`
+
+	// HedgeDelay defines the minimum time a read takes before a hedged read is sent,
+	// also used for shards of which the latency isn't known yet.
+	// DefaultHedgeDelay is used in case it is zero.
+	HedgeDelay time.Duration `yaml:"hedge_delay" json:"hedge_delay"`
+}
+
+// sanitizeReadConfig returns a copy of the given config, with defaults applied,
+// or an error in case the config is invalid.
+func sanitizeReadConfig(cfg ReadConfig) (ReadConfig, error) {
+	if cfg.HedgePercentile >= 100 {
+		return cfg, fmt.Errorf("invalid hedge percentile %v", cfg.HedgePercentile)
+	}
+	if cfg.HedgeDelay < 0 {
+		return cfg, fmt.Errorf("invalid (negative) hedge delay %v", cfg.HedgeDelay)
+	}
+	if cfg.HedgePercentile == 0 {
+		cfg.HedgePercentile = DefaultHedgePercentile
+	}
+	if cfg.HedgeDelay == 0 {
+		cfg.HedgeDelay = DefaultHedgeDelay
+	}
+	return cfg, nil
+}
+
+// newObjectReader creates a new objectReader, using the default ReadConfig.
+func newObjectReader(cluster datastor.Cluster) *objectReader {
+	cfg, _ := sanitizeReadConfig(ReadConfig{})
+	return &objectReader{
+		cluster: cluster,
+		cfg:     cfg,
+		latency: datastor.NewLatencyTracker(0),
+	}
+}
+
+// objectReader reads objects from their shards,
+// preferring the fastest shards, and hedging slow reads.
+type objectReader struct {
+	cluster datastor.Cluster
+	cfg     ReadConfig
+	latency *datastor.LatencyTracker
+}
+
+// readObjectFunc reads the given object from the given shard.
+type readObjectFunc func(ctx context.Context, shard datastor.Shard, object metatypes.Object) ([]byte, error)
+
+// read reads count of the given objects, using the given function,
+// returning the read data mapped to the index of their object.
+// No more than jobCount reads are made at once, not counting hedged reads.
+//
+// Less than count objects are returned in case not enough objects could be read,
+// in which case the error of the context is returned, if any.
+func (r *objectReader) read(ctx context.Context, objects []metatypes.Object, count, jobCount int, fn readObjectFunc) (map[int][]byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type readResult struct {
+		Index int
+		Data  []byte
+		Err   error
+	}
+	type pendingRead struct {
+		shard   string
+		started time.Time
+		hedgeAt time.Time
+		hedged  bool
+	}
+
+	var (
+		order   = r.order(objects)
+		next    int
+		pending = make(map[int]*pendingRead, len(objects))
+		hedged  int
+		results = make(map[int][]byte, count)
+		// buffered, such that cancelled reads never block
+		resultCh = make(chan readResult, len(objects))
+	)
+	defer func() {
+		// a cancelled read took at least as long as it has been running,
+		// recording it ensures that a slow shard doesn't keep its fast reputation
+		for _, read := range pending {
+			r.latency.Record(read.shard, time.Since(read.started))
+		}
+	}()
+
+	// launch starts reading the next available object,
+	// returning false in case no objects are left to be read
+	launch := func() bool {
+		for next < len(order) {
+			index := order[next]
+			next++
+			object := objects[index]
+			shard, err := r.cluster.GetShard(object.ShardID)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"shard":  object.ShardID,
+					"object": object.Key,
+				}).WithError(err).Errorf("failed to get shard")
+				continue
+			}
+			read := &pendingRead{shard: object.ShardID, started: time.Now()}
+			read.hedgeAt = read.started.Add(r.hedgeDelay(object.ShardID))
+			pending[index] = read
+			go func() {
+				data, err := fn(ctx, shard, object)
+				resultCh <- readResult{Index: index, Data: data, Err: err}
+			}()
+			return true
+		}
+		return false
+	}
+	// fill ensures that enough reads are pending,
+	// allowing an extra read for each pending read which has been hedged
+	fill := func() {
+		limit := count - len(results)
+		if limit > jobCount {
+			limit = jobCount
+		}
+		for len(pending) < limit+hedged && launch() {
+		}
+	}
+
+	fill()
+	for len(results) < count && len(pending) > 0 {
+		// wait for the first pending read to pass its hedge threshold,
+		// as long as alternate objects are available
+		var (
+			hedgeIndex = -1
+			hedgeAt    time.Time
+			timerCh    <-chan time.Time
+		)
+		if r.cfg.HedgePercentile > 0 && next < len(order) {
+			for index, read := range pending {
+				if !read.hedged && (hedgeIndex < 0 || read.hedgeAt.Before(hedgeAt)) {
+					hedgeIndex, hedgeAt = index, read.hedgeAt
+				}
+			}
+		}
+		var timer *time.Timer
+		if hedgeIndex >= 0 {
+			timer = time.NewTimer(time.Until(hedgeAt))
+			timerCh = timer.C
+		}
+
+		select {
+		case result := <-resultCh:
+			read := pending[result.Index]
+			delete(pending, result.Index)
+			if read.hedged {
+				hedged--
+			}
+			if result.Err != nil {
+				if ctx.Err() == nil {
+					log.WithFields(log.Fields{
+						"shard":  objects[result.Index].ShardID,
+						"object": objects[result.Index].Key,
+					}).WithError(result.Err).Errorf("failed to read object")
+				}
+			} else {
+				r.latency.Record(read.shard, time.Since(read.started))
+				results[result.Index] = result.Data
+			}
+
+		case <-timerCh:
+			read := pending[hedgeIndex]
+			read.hedged = true
+			hedged++
+			log.WithField("shard", read.shard).Debugf(
+				"hedging read of object %q, pending for %v", objects[hedgeIndex].Key, time.Since(read.started))
+
+		case <-ctx.Done():
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+		fill()
+	}
+	return results, nil
+}
+
+// hedgeDelay returns the time a read from the given shard can take,
+// before a hedged read is sent.
+func (r *objectReader) hedgeDelay(shard string) time.Duration {
+	delay, ok := r.latency.Percentile(shard, r.cfg.HedgePercentile)
+	if !ok || delay < r.cfg.HedgeDelay {
+		return r.cfg.HedgeDelay
+	}
+	return delay
+}
+
+// order returns the indices of the given objects,
+// sorted by the median read latency of their shards, fastest first.
+// Shards of which the latency isn't known yet are read first,
+// such that their latency becomes known.
+func (r *objectReader) order(objects []metatypes.Object) []int {
+	order := make([]int, len(objects))
+	latencies := make([]time.Duration, len(objects))
+	for index, object := range objects {
+		order[index] = index
+		latencies[index], _ = r.latency.Percentile(object.ShardID, 50)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return latencies[order[i]] < latencies[order[j]]
+	})
+	return order
+}
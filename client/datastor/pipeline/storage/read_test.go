@@ -0,0 +1,105 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConfig(t *testing.T) {
+	require := require.New(t)
+
+	cfg, err := sanitizeReadConfig(ReadConfig{})
+	require.NoError(err)
+	require.Equal(ReadConfig{HedgePercentile: DefaultHedgePercentile, HedgeDelay: DefaultHedgeDelay}, cfg)
+
+	_, err = sanitizeReadConfig(ReadConfig{HedgePercentile: 100})
+	require.Error(err)
+	_, err = sanitizeReadConfig(ReadConfig{HedgeDelay: -time.Second})
+	require.Error(err)
+}
+
+func TestReplicatedStorageHedgedRead(t *testing.T) {
+	require := require.New(t)
+
+	zdbCluster, cleanup, err := newZdbServerCluster(3)
+	require.NoError(err)
+	defer cleanup()
+	cluster := &faultyCluster{Cluster: zdbCluster, slowRead: make(map[string]time.Duration)}
+
+	storage, err := NewReplicatedChunkStorage(cluster, 3, 0)
+	require.NoError(err)
+	require.NoError(storage.SetReadConfig(ReadConfig{HedgeDelay: 10 * time.Millisecond}))
+
+	data := []byte("some data")
+	cfg, err := storage.WriteChunk(data)
+	require.NoError(err)
+
+	// the first replica is read first, as no latency is known yet,
+	// but it is slow, and thus a hedged read is sent to another shard
+	cluster.slowRead[cfg.Objects[0].ShardID] = 2 * time.Second
+	start := time.Now()
+	output, err := storage.ReadChunk(*cfg)
+	require.NoError(err)
+	require.Equal(data, output)
+	require.True(time.Since(start) < time.Second, "slow read should have been hedged")
+
+	// the slow shard is read last from now on
+	order := storage.reader.order(cfg.Objects)
+	require.Equal(0, order[len(order)-1])
+
+	// without hedging, the slow read is waited for
+	cluster.slowRead[cfg.Objects[0].ShardID] = 100 * time.Millisecond
+	require.NoError(storage.SetReadConfig(ReadConfig{HedgePercentile: -1}))
+	start = time.Now()
+	output, err = storage.ReadChunk(ChunkConfig{Size: cfg.Size, Objects: cfg.Objects[:1]})
+	require.NoError(err)
+	require.Equal(data, output)
+	require.True(time.Since(start) >= 100*time.Millisecond, "slow read shouldn't have been hedged")
+}
+
+func TestDistributedStorageHedgedRead(t *testing.T) {
+	require := require.New(t)
+
+	zdbCluster, cleanup, err := newZdbServerCluster(4)
+	require.NoError(err)
+	defer cleanup()
+	cluster := &faultyCluster{Cluster: zdbCluster, slowRead: make(map[string]time.Duration)}
+
+	storage, err := NewDistributedChunkStorage(cluster, 2, 2, 0)
+	require.NoError(err)
+	require.NoError(storage.SetReadConfig(ReadConfig{HedgeDelay: 10 * time.Millisecond}))
+
+	data := []byte("some data, distributed over some shards")
+	cfg, err := storage.WriteChunk(data)
+	require.NoError(err)
+
+	// one of the first parts is slow, and is replaced by another part
+	cluster.slowRead[cfg.Objects[1].ShardID] = 2 * time.Second
+	start := time.Now()
+	output, err := storage.ReadChunk(*cfg)
+	require.NoError(err)
+	require.Equal(data, output)
+	require.True(time.Since(start) < time.Second, "slow read should have been hedged")
+
+	// the slow shard is read last from now on
+	order := storage.reader.order(cfg.Objects)
+	require.Equal(1, order[len(order)-1])
+}
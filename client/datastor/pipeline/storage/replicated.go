@@ -58,6 +58,7 @@ func NewReplicatedChunkStorage(cluster datastor.Cluster, dataShardCount, jobCoun
 		dataShardCount: dataShardCount,
 		jobCount:       jobCount,
 		writeJobCount:  writeJobCount,
+		reader:         newObjectReader(cluster),
 	}, nil
 }
 
@@ -65,10 +66,15 @@ func NewReplicatedChunkStorage(cluster datastor.Cluster, dataShardCount, jobCoun
 // which writes an object to multiple shards at once,
 // the amount of shards which is defined by the used dataShardCount.
 //
-// For reading it will try to a multitude of the possible shards at once,
+// For reading it will read from the shard which responded the fastest recently,
 // and return the object that it received first. As it is expected that all
 // shards return the same object for this key, when making use of this storage,
 // there is no need to read from all shards and wait for all of those results as well.
+// Only when that read is slow, a hedged read is sent to another shard,
+// see `ReadConfig` for more information.
+//
+// The replicas are spread over the failure domains (zones, racks and hosts) of the shards,
+// never storing all replicas within a single domain.
 //
 // Repairing is done by first assembling a list of corrupt, OK and dead shards.
 // Once that's done, the corrupt shards will be simply tried to be written to again,
@@ -77,48 +83,64 @@ type ReplicatedChunkStorage struct {
 	cluster                 datastor.Cluster
 	dataShardCount          int
 	jobCount, writeJobCount int
+	reader                  *objectReader
+}
+
+// SetReadConfig configures how the replicated objects are read.
+// See `ReadConfig` for more information.
+//
+// It should be called prior to using the storage,
+// and returns an error in case the given config is invalid.
+func (rs *ReplicatedChunkStorage) SetReadConfig(cfg ReadConfig) error {
+	cfg, err := sanitizeReadConfig(cfg)
+	if err != nil {
+		return err
+	}
+	rs.reader.cfg = cfg
+	return nil
 }
 
 // WriteChunk implements storage.ChunkStorage.WriteChunk
 func (rs *ReplicatedChunkStorage) WriteChunk(data []byte) (*ChunkConfig, error) {
-	return rs.write(nil, rs.dataShardCount, data)
+	return rs.WriteChunkContext(context.Background(), data)
+}
+
+// WriteChunkContext implements storage.ChunkStorage.WriteChunkContext
+func (rs *ReplicatedChunkStorage) WriteChunkContext(ctx context.Context, data []byte) (*ChunkConfig, error) {
+	return rs.write(ctx, nil, nil, rs.dataShardCount, data)
 }
 
 // ReadChunk implements storage.ChunkStorage.ReadChunk
 func (rs *ReplicatedChunkStorage) ReadChunk(cfg ChunkConfig) ([]byte, error) {
+	return rs.ReadChunkContext(context.Background(), cfg)
+}
+
+// ReadChunkContext implements storage.ChunkStorage.ReadChunkContext
+func (rs *ReplicatedChunkStorage) ReadChunkContext(ctx context.Context, cfg ChunkConfig) ([]byte, error) {
 	// ensure that at least 1 shard is given
 	if len(cfg.Objects) == 0 {
 		return nil, ErrUnexpectedObjectCount
 	}
 
-	var (
-		err    error
-		object *datastor.Object
-		shard  datastor.Shard
-	)
-	// simply try to read sequentially until one could be read,
-	// as we should in most scenarios only ever have to read from 1 (and 2 or 3 in bad situations),
-	// it would be bad for performance to try to read from multiple goroutines and shards for all calls.
-	for _, obj := range cfg.Objects {
-		shard, err = rs.cluster.GetShard(obj.ShardID)
-		if err != nil {
-			log.Errorf("failed to get shard %q for object %q: %v",
-				obj.Key, obj.ShardID, err)
-			continue
-		}
-
-		object, err = shard.GetObject(obj.Key)
-		if err != nil {
-			log.Errorf("failed to read %q from replicated shard %q: %v",
-				obj.Key, obj.ShardID, err)
-			continue
-		}
-
-		if int64(len(object.Data)) == cfg.Size {
+	// read from the fastest shard, only reading from another shard in case that read fails,
+	// or is slow, as we should in most scenarios only ever have to read from 1 shard,
+	// it would be bad for performance to read from multiple shards for all calls.
+	results, err := rs.reader.read(ctx, cfg.Objects, 1, 1,
+		func(ctx context.Context, shard datastor.Shard, obj metatypes.Object) ([]byte, error) {
+			object, err := shard.GetObjectContext(ctx, obj.Key)
+			if err != nil {
+				return nil, err
+			}
+			if int64(len(object.Data)) != cfg.Size {
+				return nil, ErrInvalidDataSize
+			}
 			return object.Data, nil
-		}
-		log.Errorf("failed to read %q from replicated shard %q: invalid data size",
-			obj.Key, obj.ShardID)
+		})
+	if err != nil {
+		return nil, err
+	}
+	for _, data := range results {
+		return data, nil
 	}
 
 	// sadly, no shard was available
@@ -128,6 +150,11 @@ func (rs *ReplicatedChunkStorage) ReadChunk(cfg ChunkConfig) ([]byte, error) {
 
 // CheckChunk implements storage.ChunkStorage.CheckChunk
 func (rs *ReplicatedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckStatus, error) {
+	return rs.CheckChunkContext(context.Background(), cfg, fast)
+}
+
+// CheckChunkContext implements storage.ChunkStorage.CheckChunkContext
+func (rs *ReplicatedChunkStorage) CheckChunkContext(ctx context.Context, cfg ChunkConfig, fast bool) (CheckStatus, error) {
 	objectCount := len(cfg.Objects)
 	if objectCount == 0 {
 		return CheckStatusInvalid, ErrUnexpectedObjectCount
@@ -139,7 +166,8 @@ func (rs *ReplicatedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckS
 		jobCount = objectCount
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	parent := ctx
+	ctx, cancel := context.WithCancel(parent)
 	defer cancel()
 
 	// create a channel-based fetcher to get all the objects
@@ -205,8 +233,11 @@ func (rs *ReplicatedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckS
 				}
 
 				// validate if the object's status for this shard is OK
-				status, err = shard.GetObjectStatus(object.Key)
+				status, err = shard.GetObjectStatusContext(ctx, object.Key)
 				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
 					log.Errorf("error while validating %q stored on shard %q: %v",
 						object.Key, object.ShardID, err)
 					continue
@@ -241,7 +272,7 @@ func (rs *ReplicatedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckS
 			}
 			return CheckStatusValid, nil
 		case <-ctx.Done():
-			return CheckStatusInvalid, nil
+			return CheckStatusInvalid, parent.Err()
 		}
 	}
 
@@ -255,6 +286,9 @@ func (rs *ReplicatedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckS
 		}
 	}
 
+	if err := parent.Err(); err != nil {
+		return CheckStatusInvalid, err
+	}
 	if validObjectCount > 0 {
 		return CheckStatusValid, nil
 	}
@@ -263,6 +297,11 @@ func (rs *ReplicatedChunkStorage) CheckChunk(cfg ChunkConfig, fast bool) (CheckS
 
 // RepairChunk implements storage.ChunkStorage.RepairChunk
 func (rs *ReplicatedChunkStorage) RepairChunk(cfg ChunkConfig) (*ChunkConfig, error) {
+	return rs.RepairChunkContext(context.Background(), cfg)
+}
+
+// RepairChunkContext implements storage.ChunkStorage.RepairChunkContext
+func (rs *ReplicatedChunkStorage) RepairChunkContext(ctx context.Context, cfg ChunkConfig) (*ChunkConfig, error) {
 	objectCount := len(cfg.Objects)
 	if objectCount == 0 {
 		// we can't do anything if no shards are given
@@ -275,7 +314,7 @@ func (rs *ReplicatedChunkStorage) RepairChunk(cfg ChunkConfig) (*ChunkConfig, er
 		if err != nil {
 			return nil, ErrShardsUnavailable
 		}
-		status, err := shard.GetObjectStatus(cfg.Objects[0].Key)
+		status, err := shard.GetObjectStatusContext(ctx, cfg.Objects[0].Key)
 		if err != nil || status != datastor.ObjectStatusOK {
 			return nil, ErrShardsUnavailable
 		}
@@ -284,7 +323,10 @@ func (rs *ReplicatedChunkStorage) RepairChunk(cfg ChunkConfig) (*ChunkConfig, er
 	}
 
 	// first, let's collect all valid and invalid shards in 2 separate slices
-	validObjects, invalidObjects := rs.splitObjects(cfg.Objects)
+	validObjects, invalidObjects := rs.splitObjects(ctx, cfg.Objects)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
 	// NOTE: len(validObjects)+len(invalidObjects) < len(cfg.Objects)
 	//       is valid, and is the scenario possible when some shards
 	//       returned an error and thus indicated they were actually non functional
@@ -318,8 +360,11 @@ func (rs *ReplicatedChunkStorage) RepairChunk(cfg ChunkConfig) (*ChunkConfig, er
 			continue
 		}
 
-		object, err = shard.GetObject(obj.Key)
+		object, err = shard.GetObjectContext(ctx, obj.Key)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			log.Errorf("failed to read %q from replicated shard %q: %v",
 				obj.Key, obj.ShardID, err)
 			validObjects = validObjects[1:]
@@ -349,9 +394,9 @@ func (rs *ReplicatedChunkStorage) RepairChunk(cfg ChunkConfig) (*ChunkConfig, er
 	for _, obj := range validObjects {
 		exceptShards = append(exceptShards, obj.ShardID)
 	}
-	outputCfg, err := rs.write(exceptShards, rs.dataShardCount-objectCount, object.Data)
+	outputCfg, err := rs.write(ctx, exceptShards, validObjects, rs.dataShardCount-objectCount, object.Data)
 	if err != nil {
-		return outputCfg, err
+		return nil, err
 	}
 
 	// add our shards to our output cfg
@@ -365,8 +410,8 @@ func (rs *ReplicatedChunkStorage) RepairChunk(cfg ChunkConfig) (*ChunkConfig, er
 
 // splitObjects is a private utility method,
 // to help us split the given objects into valid and invalid ones.
-func (rs *ReplicatedChunkStorage) splitObjects(allObjects []metatypes.Object) (validObjects []metatypes.Object, invalidObjects []metatypes.Object) {
-	ctx, cancel := context.WithCancel(context.Background())
+func (rs *ReplicatedChunkStorage) splitObjects(ctx context.Context, allObjects []metatypes.Object) (validObjects []metatypes.Object, invalidObjects []metatypes.Object) {
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	// create a channel-based fetcher to get all the objects
@@ -434,7 +479,7 @@ func (rs *ReplicatedChunkStorage) splitObjects(allObjects []metatypes.Object) (v
 
 				var result checkResult
 
-				status, err = shard.GetObjectStatus(object.Key)
+				status, err = shard.GetObjectStatusContext(ctx, object.Key)
 				if err == nil {
 					if status == datastor.ObjectStatusOK {
 						result.Valid = true
@@ -466,8 +511,8 @@ func (rs *ReplicatedChunkStorage) splitObjects(allObjects []metatypes.Object) (v
 	return
 }
 
-func (rs *ReplicatedChunkStorage) write(exceptShards []string, dataShardCount int, data []byte) (*ChunkConfig, error) {
-	group, ctx := errgroup.WithContext(context.Background())
+func (rs *ReplicatedChunkStorage) write(parent context.Context, exceptShards []string, placed []metatypes.Object, dataShardCount int, data []byte) (*ChunkConfig, error) {
+	group, ctx := errgroup.WithContext(parent)
 
 	jobCount := rs.jobCount
 	if jobCount > dataShardCount {
@@ -488,10 +533,12 @@ func (rs *ReplicatedChunkStorage) write(exceptShards []string, dataShardCount in
 		}
 	}()
 
-	// create a channel-based iterator, to fetch the shards,
-	// randomly and thread-save
-	shardCh := datastor.ShardIteratorChannel(ctx,
-		rs.cluster.GetShardIterator(exceptShards))
+	// create a thread-safe placement, to fetch the shards,
+	// spread over the failure domains,
+	// such that the loss of a single domain always leaves a replica
+	placement := newShardPlacement(
+		placementShardIterator(rs.cluster, data, exceptShards, placed, rs.dataShardCount-1))
+	creator := newObjectCreator(data)
 
 	// write to dataShardCount amount of shards,
 	// and return their identifiers over the resultCh,
@@ -522,32 +569,32 @@ func (rs *ReplicatedChunkStorage) write(exceptShards []string, dataShardCount in
 				// or until we have written to a shard
 			writeLoop:
 				for {
-					// fetch a random shard,
+					// fetch the next shard,
 					// it's an error if this is not possible,
 					// as a shard is expected to be still available at this stage
-					select {
-					case shard, open = <-shardCh:
-						if !open {
-							// not enough shards are available,
-							// we know this because the iterator ch has already been closed
-							return ErrShardsUnavailable
-						}
-					case <-ctx.Done():
+					if ctx.Err() != nil {
 						return errors.New("context was unexpectedly cancelled, " +
 							"while fetching shard for a replicate-write request")
 					}
+					shard, open = placement.next()
+					if !open {
+						// not enough shards are available,
+						// as no more shards can be placed
+						return ErrShardsUnavailable
+					}
 
-					// do the actual storage
-					object.Key, err = shard.CreateObject(data)
+					// do the actual storage, using the parent context,
+					// such that an in-flight write isn't abandoned when another worker fails,
+					// as the key of an abandoned object is unknown, making it impossible to roll back
+					// all replicas are the same part of the chunk, stored on different shards
+					object.Key, err = creator.create(parent, shard, 0, data)
+					placement.done(shard, err == nil)
 					if err == nil {
 						object.ShardID = shard.Identifier()
-						select {
-						case resultCh <- object:
-							break writeLoop
-						case <-ctx.Done():
-							return errors.New("context was unexpectedly cancelled, " +
-								"while returning the identifier of a shard for a replicate-write request")
-						}
+						// always return the written object, even when the context is cancelled,
+						// such that it can be rolled back in case the write fails
+						resultCh <- object
+						break writeLoop
 					}
 
 					// check if the error is because the namespace if full
@@ -582,15 +629,25 @@ func (rs *ReplicatedChunkStorage) write(exceptShards []string, dataShardCount in
 		cfg.Objects = append(cfg.Objects, object)
 	}
 
-	// check if we have sufficient replications
+	// check if we have sufficient replications,
+	// rolling back the written objects if not
 	if len(cfg.Objects) < dataShardCount {
-		return cfg, ErrShardsUnavailable
+		err := parent.Err()
+		if err == nil {
+			err = ErrShardsUnavailable
+		}
+		return nil, rollbackWrite(rs.cluster, cfg.Objects, err)
 	}
 	return cfg, nil
 }
 
 // DeleteChunk implements storage.ChunkStorage.DeleteChunk
 func (rs *ReplicatedChunkStorage) DeleteChunk(cfg ChunkConfig) error {
+	return rs.DeleteChunkContext(context.Background(), cfg)
+}
+
+// DeleteChunkContext implements storage.ChunkStorage.DeleteChunkContext
+func (rs *ReplicatedChunkStorage) DeleteChunkContext(ctx context.Context, cfg ChunkConfig) error {
 	objectLength := len(cfg.Objects)
 	if objectLength == 0 {
 		// if no objects are given, something is wrong
@@ -605,7 +662,7 @@ func (rs *ReplicatedChunkStorage) DeleteChunk(cfg ChunkConfig) error {
 		if err != nil {
 			return err
 		}
-		return shard.DeleteObject(obj.Key)
+		return shard.DeleteObjectContext(ctx, obj.Key)
 	}
 
 	// limit our job count,
@@ -616,7 +673,7 @@ func (rs *ReplicatedChunkStorage) DeleteChunk(cfg ChunkConfig) error {
 	}
 
 	// create an errgroup for all our delete jobs
-	group, ctx := errgroup.WithContext(context.Background())
+	group, ctx := errgroup.WithContext(ctx)
 
 	// spawn our object fetcher
 	indexCh := make(chan int, jobCount)
@@ -645,7 +702,7 @@ func (rs *ReplicatedChunkStorage) DeleteChunk(cfg ChunkConfig) error {
 				if err != nil {
 					return err
 				}
-				err = shard.DeleteObject(obj.Key)
+				err = shard.DeleteObjectContext(ctx, obj.Key)
 				if err != nil {
 					return err
 				}
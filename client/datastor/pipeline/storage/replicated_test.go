@@ -20,6 +20,8 @@ import (
 	"crypto/rand"
 	"testing"
 
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -258,3 +260,53 @@ func testReplicatedStorageCheckRepair(t *testing.T, dataShardCount, jobCount int
 	_, err = storage.RepairChunk(*cfg)
 	require.Error(err)
 }
+
+func TestReplicatedStoragePlacement(t *testing.T) {
+	require := require.New(t)
+
+	// 3 replicas over 2 zones, such that the loss of a zone always leaves a replica
+	cluster, cleanup, err := newZdbServerTopologyCluster(zoneTopologies(3, "a", "b"))
+	require.NoError(err)
+	defer cleanup()
+
+	storage, err := NewReplicatedChunkStorage(cluster, 3, 0)
+	require.NoError(err)
+
+	for i := 0; i < 16; i++ {
+		cfg, err := storage.WriteChunk([]byte("data"))
+		require.NoError(err)
+		require.Len(cfg.Objects, 3)
+		counts, err := zoneCounts(cluster, cfg.Objects)
+		require.NoError(err)
+		require.Len(counts, 2)
+		require.Equal(3, counts["a"]+counts["b"])
+	}
+
+	// a repair takes the remaining replicas into account
+	cfg, err := storage.WriteChunk([]byte("data"))
+	require.NoError(err)
+	counts, err := zoneCounts(cluster, cfg.Objects)
+	require.NoError(err)
+	var lost, kept []metatypes.Object
+	for _, obj := range cfg.Objects {
+		shard, err := cluster.GetShard(obj.ShardID)
+		require.NoError(err)
+		if counts[shard.Topology().Zone] == 1 {
+			lost = append(lost, obj)
+		} else {
+			kept = append(kept, obj)
+		}
+	}
+	require.Len(lost, 1)
+	shard, err := cluster.GetShard(lost[0].ShardID)
+	require.NoError(err)
+	require.NoError(shard.DeleteObject(lost[0].Key))
+
+	repaired, err := storage.RepairChunk(*cfg)
+	require.NoError(err)
+	require.Len(repaired.Objects, 3)
+	counts, err = zoneCounts(cluster, repaired.Objects)
+	require.NoError(err)
+	require.Len(counts, 2)
+	require.Subset(repaired.Objects, kept)
+}
@@ -17,10 +17,17 @@
 package storage
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"runtime"
+	"sync"
 
+	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/blake2b"
 )
 
 var (
@@ -38,17 +45,31 @@ var (
 )
 
 // ChunkStorage is used store a chunk on a given cluster.
+//
+// Each method has a variant taking a context, which is passed on to the used shards,
+// such that an operation can be cancelled or given a deadline.
+// The variants without a context use context.Background().
 type ChunkStorage interface {
 	// WriteChunk writes a data chunk as one or multiple objects to a storage,
 	// returning the generated chunk's config used to store it.
 	//
 	// Remember to store the returned config,
 	// as you'll need it in order to read the chunk back back.
+	//
+	// In case the write fails, all objects which were already written
+	// are deleted on a best-effort basis. If not all of them could be deleted,
+	// a *RollbackError is returned, listing the objects which remain stored.
 	WriteChunk(data []byte) (*ChunkConfig, error)
+	// WriteChunkContext writes a data chunk just like WriteChunk,
+	// aborting as soon as the given context is done.
+	WriteChunkContext(ctx context.Context, data []byte) (*ChunkConfig, error)
 
 	// ReadChunk reads the chunk's objects from a storage,
 	// using the chunk config generated while writing the object, previously.
 	ReadChunk(cfg ChunkConfig) ([]byte, error)
+	// ReadChunkContext reads a chunk just like ReadChunk,
+	// aborting as soon as the given context is done.
+	ReadChunkContext(ctx context.Context, cfg ChunkConfig) ([]byte, error)
 
 	// CheckChunk checks if a chunk is in a valid condition.
 	//
@@ -61,11 +82,17 @@ type ChunkStorage interface {
 	// is not compatible with the used storage.
 	// Meaning the chunk cannot be read, written or repaired.
 	CheckChunk(cfg ChunkConfig, fast bool) (CheckStatus, error)
+	// CheckChunkContext checks a chunk just like CheckChunk,
+	// aborting as soon as the given context is done.
+	CheckChunkContext(ctx context.Context, cfg ChunkConfig, fast bool) (CheckStatus, error)
 
 	// RepairChunk will try to repair a chunk, already stored within the Storage.
 	// If the chunk could be repaired, the updated chunk config will be returned and
 	// indicate the current objects are stored/used for this chunk.
 	RepairChunk(cfg ChunkConfig) (*ChunkConfig, error)
+	// RepairChunkContext repairs a chunk just like RepairChunk,
+	// aborting as soon as the given context is done.
+	RepairChunkContext(ctx context.Context, cfg ChunkConfig) (*ChunkConfig, error)
 
 	// DeleteChunk will delete a chunk, previously stored within the Storage.
 	// If the chunk was written using a different type of ChunkStorage,
@@ -73,6 +100,9 @@ type ChunkStorage interface {
 	// When an error is returned it should be assumed the chunk wasn't deleted,
 	// if no error is returned, however, it can be assumed that the chunk was deleted.
 	DeleteChunk(cfg ChunkConfig) error
+	// DeleteChunkContext deletes a chunk just like DeleteChunk,
+	// aborting as soon as the given context is done.
+	DeleteChunkContext(ctx context.Context, cfg ChunkConfig) error
 
 	// Close any open resources.
 	Close() error
@@ -86,6 +116,168 @@ type ChunkConfig struct {
 	Objects []metatypes.Object
 }
 
+// RollbackError is returned in case a write failed,
+// and not all objects, written prior to that failure, could be deleted.
+type RollbackError struct {
+	// Err is the error which caused the write to fail.
+	Err error
+	// Objects which couldn't be deleted, and thus remain stored
+	// without being referenced by any chunk config.
+	Objects []metatypes.Object
+}
+
+// Error implements error.Error
+func (err *RollbackError) Error() string {
+	return fmt.Sprintf("%v (rollback failed: %d object(s) could not be deleted)",
+		err.Err, len(err.Objects))
+}
+
+// rollbackWrite deletes all given objects, on a best-effort basis,
+// returning the given error as is in case all objects could be deleted,
+// or as part of a *RollbackError if not.
+//
+// The objects are deleted using a detached context,
+// as the context of the failed write might be the cause of its failure.
+func rollbackWrite(cluster datastor.Cluster, objects []metatypes.Object, err error) error {
+	var remaining []metatypes.Object
+	for _, object := range objects {
+		if len(object.Key) == 0 {
+			// object was never written
+			continue
+		}
+		shard, shardErr := listedShard(cluster, object.ShardID)
+		if shardErr == nil {
+			shardErr = shard.DeleteObjectContext(context.Background(), object.Key)
+		}
+		if shardErr != nil {
+			log.WithField("shard", object.ShardID).WithError(shardErr).Errorf(
+				"failed to roll back object %q of failed write", object.Key)
+			remaining = append(remaining, object)
+		}
+	}
+	if len(remaining) > 0 {
+		return &RollbackError{Err: err, Objects: remaining}
+	}
+	return err
+}
+
+// listedShard returns the shard with the given identifier,
+// even when it is unhealthy, in case the cluster supports it,
+// as the objects stored on an unhealthy shard still have to be rolled back,
+// and still count for the failure domains of their chunk.
+func listedShard(cluster datastor.Cluster, id string) (datastor.Shard, error) {
+	if listed, ok := cluster.(datastor.ListedCluster); ok {
+		return listed.GetListedShard(id)
+	}
+	return cluster.GetShard(id)
+}
+
+// newObjectCreator creates a new objectCreator, creating the objects of the given chunk data.
+func newObjectCreator(data []byte) *objectCreator {
+	return &objectCreator{data: data}
+}
+
+// objectCreator creates the objects which store the parts of a single chunk.
+// The keys of these objects are derived from the hash of the chunk and the index of each part,
+// in case the shard defines the keys of the objects it creates (see datastor.KeyModeUser),
+// such that a rewritten chunk part is stored under the same key.
+// The chunk data is only hashed once, and only when a shard requires it.
+type objectCreator struct {
+	data []byte
+	once sync.Once
+	hash []byte
+}
+
+// create creates an object on the given shard,
+// storing the given data as the part with the given index of the chunk.
+func (oc *objectCreator) create(ctx context.Context, shard datastor.Shard, part int, data []byte) ([]byte, error) {
+	kc, ok := shard.(datastor.KeyedClient)
+	if !ok || kc.KeyMode() != datastor.KeyModeUser {
+		return shard.CreateObjectContext(ctx, data)
+	}
+	oc.once.Do(func() {
+		sum := blake2b.Sum256(oc.data)
+		oc.hash = sum[:]
+	})
+	return kc.CreateObjectPartContext(ctx, oc.hash, part, data)
+}
+
+// seededShardIterator returns an iterator over the shards of the given cluster,
+// except the given shards, walking them in an order defined by the given seed,
+// in case the cluster supports it, such that the same data is placed on the same shards.
+func seededShardIterator(cluster datastor.Cluster, seed []byte, exceptShards []string) datastor.ShardIterator {
+	if sc, ok := cluster.(datastor.SeededCluster); ok {
+		return sc.GetSeededShardIterator(seed, exceptShards)
+	}
+	return cluster.GetShardIterator(exceptShards)
+}
+
+// placementShardIterator returns a seeded iterator over the shards of the given cluster,
+// except the given shards, spreading the parts of a chunk over different failure domains,
+// such that no domain holds more than maxPerDomain parts,
+// including the already placed parts, stored by the given objects.
+func placementShardIterator(cluster datastor.Cluster, seed []byte, exceptShards []string, placed []metatypes.Object, maxPerDomain int) *datastor.PlacementShardIterator {
+	topologies := make([]datastor.Topology, 0, len(placed))
+	for _, obj := range placed {
+		shard, err := listedShard(cluster, obj.ShardID)
+		if err != nil {
+			log.Errorf("failed to get the topology of shard %q: %v", obj.ShardID, err)
+			continue
+		}
+		topologies = append(topologies, shard.Topology())
+	}
+	return datastor.NewPlacementShardIterator(
+		seededShardIterator(cluster, seed, exceptShards), topologies, maxPerDomain)
+}
+
+// newShardPlacement creates a new shardPlacement, placing the shards of the given iterator.
+func newShardPlacement(it *datastor.PlacementShardIterator) *shardPlacement {
+	p := &shardPlacement{it: it}
+	p.cond = sync.NewCond(&p.mux)
+	return p
+}
+
+// shardPlacement hands out the shards of a placement iterator to concurrent writers.
+// A shard only keeps its place in its failure domains in case it was written to,
+// such that a failed write doesn't prevent the other shards of its domains from being used.
+type shardPlacement struct {
+	mux     sync.Mutex
+	cond    *sync.Cond
+	it      *datastor.PlacementShardIterator
+	pending int
+}
+
+// next returns the next shard to write to, or false in case no shard is available.
+// While no shard can be placed, it waits for the pending writes to finish,
+// as a failed write gives back its place to the other shards of its domains.
+// Each returned shard has to be passed to done, once it has been written to.
+func (p *shardPlacement) next() (datastor.Shard, bool) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	for {
+		if p.it.Next() {
+			p.pending++
+			return p.it.Shard(), true
+		}
+		if p.pending == 0 {
+			return nil, false
+		}
+		p.cond.Wait()
+	}
+}
+
+// done marks a write to a shard returned by next as finished,
+// giving back its place in case the write failed.
+func (p *shardPlacement) done(shard datastor.Shard, written bool) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if !written {
+		p.it.Release(shard)
+	}
+	p.pending--
+	p.cond.Broadcast()
+}
+
 // CheckStatus is the status returned when checking the
 // state of a chunk using the `(ChunkStorage).Check` method,
 // and indicates whether a chunk can, should or shouldn't be repaired.
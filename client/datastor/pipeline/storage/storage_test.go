@@ -17,12 +17,18 @@
 package storage
 
 import (
+	"context"
 	"crypto/rand"
 	"math"
 	mathRand "math/rand"
 	"testing"
 
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
 )
 
 func testStorageReadCheckWriteDelete(t *testing.T, storage ChunkStorage) {
@@ -79,6 +85,108 @@ func testStorageReadCheckWriteDeleteCycle(t *testing.T, storage ChunkStorage, da
 	require.Equal(CheckStatusInvalid, status)
 }
 
+func TestWriteChunkRollback(t *testing.T) {
+	cluster, cleanup, err := newZdbServerCluster(4)
+	require.NoError(t, err)
+	defer cleanup()
+
+	var shards []string
+	it := cluster.GetShardIterator(nil)
+	for it.Next() {
+		shards = append(shards, it.Shard().Identifier())
+	}
+
+	t.Run("replicated", func(t *testing.T) {
+		testWriteChunkRollback(t, cluster, shards, func(cluster datastor.Cluster) ChunkStorage {
+			storage, err := NewReplicatedChunkStorage(cluster, 4, 1)
+			require.NoError(t, err)
+			return storage
+		})
+	})
+	t.Run("distributed", func(t *testing.T) {
+		testWriteChunkRollback(t, cluster, shards, func(cluster datastor.Cluster) ChunkStorage {
+			storage, err := NewDistributedChunkStorage(cluster, 2, 2, 1)
+			require.NoError(t, err)
+			return storage
+		})
+	})
+}
+
+func testWriteChunkRollback(t *testing.T, cluster datastor.Cluster, shards []string, newStorage func(datastor.Cluster) ChunkStorage) {
+	require := require.New(t)
+	data := []byte("Hello, World!")
+
+	// one shard fails to write, all written objects should be rolled back
+	fc := &faultyCluster{
+		Cluster:   cluster,
+		failWrite: map[string]bool{shards[0]: true},
+	}
+	storage := newStorage(fc)
+	cfg, err := storage.WriteChunk(data)
+	require.Equal(ErrShardsUnavailable, err)
+	require.Nil(cfg)
+	count, err := fc.objectCount()
+	require.NoError(err)
+	require.Zero(count)
+
+	// one shard fails to write, another fails to delete,
+	// the object which could not be rolled back should be reported
+	fc.failDelete = map[string]bool{shards[1]: true}
+	cfg, err = storage.WriteChunk(data)
+	require.Nil(cfg)
+	require.IsType((*RollbackError)(nil), err)
+	rbErr := err.(*RollbackError)
+	require.Equal(ErrShardsUnavailable, rbErr.Err)
+	require.Len(rbErr.Objects, 1)
+	require.Equal(shards[1], rbErr.Objects[0].ShardID)
+	count, err = fc.objectCount()
+	require.NoError(err)
+	require.Equal(1, count)
+
+	// clean up the remaining object
+	shard, err := cluster.GetShard(shards[1])
+	require.NoError(err)
+	require.NoError(shard.DeleteObject(rbErr.Objects[0].Key))
+
+	// one shard fails to write, another turns unhealthy while being written to,
+	// the object written to the unhealthy shard should be rolled back as well
+	fc.failDelete = nil
+	fc.unhealthy = map[string]bool{shards[1]: true}
+	cfg, err = storage.WriteChunk(data)
+	require.Equal(ErrShardsUnavailable, err)
+	require.Nil(cfg)
+	count, err = fc.objectCount()
+	require.NoError(err)
+	require.Zero(count)
+}
+
+func TestPlacementUnhealthyShard(t *testing.T) {
+	require := require.New(t)
+
+	cluster, cleanup, err := newZdbServerTopologyCluster(zoneTopologies(2, "a", "b"))
+	require.NoError(err)
+	defer cleanup()
+
+	var placed metatypes.Object
+	it := cluster.GetShardIterator(nil)
+	for it.Next() {
+		if it.Shard().Topology().Zone == "a" {
+			placed.ShardID = it.Shard().Identifier()
+			break
+		}
+	}
+	require.NotEmpty(placed.ShardID)
+
+	// the failure domains of a placed object count, even when its shard is unhealthy
+	fc := &faultyCluster{Cluster: cluster, unhealthy: map[string]bool{placed.ShardID: true}}
+	pit := placementShardIterator(fc, []byte("data"), []string{placed.ShardID}, []metatypes.Object{placed}, 1)
+	var zones []string
+	for pit.Next() {
+		zones = append(zones, pit.Shard().Topology().Zone)
+	}
+	require.Equal([]string{"b"}, zones)
+}
+
 func TestCheckStatusString(t *testing.T) {
 	require := require.New(t)
 
@@ -90,3 +198,65 @@ func TestCheckStatusString(t *testing.T) {
 	// invalid enum value
 	require.Empty(CheckStatus(math.MaxUint8).String())
 }
+
+func TestWriteChunkUserKeys(t *testing.T) {
+	cluster, cleanup, err := newZdbServerCluster(4)
+	require.NoError(t, err)
+	defer cleanup()
+
+	// switch all (empty) namespaces and shards to user keys
+	it := cluster.GetShardIterator(nil)
+	for it.Next() {
+		shard := it.Shard().(*zerodb.Shard)
+		admin, err := zerodb.NewAdminClient(shard.Address(), "", nil, datastor.ConnectionConfig{})
+		require.NoError(t, err)
+		err = admin.SetNamespaceKeyMode(context.Background(), shard.Namespace(), datastor.KeyModeUser)
+		admin.Close()
+		require.NoError(t, err)
+		shard.SetKeyMode(datastor.KeyModeUser)
+	}
+
+	data := []byte("Hello, World!")
+	sum := blake2b.Sum256(data)
+
+	t.Run("replicated", func(t *testing.T) {
+		require := require.New(t)
+		storage, err := NewReplicatedChunkStorage(cluster, 4, 1)
+		require.NoError(err)
+
+		// all replicas are stored under the same key,
+		// and writing the same chunk again doesn't store it twice
+		for i := 0; i < 2; i++ {
+			cfg, err := storage.WriteChunk(data)
+			require.NoError(err)
+			require.Len(cfg.Objects, 4)
+			for _, object := range cfg.Objects {
+				require.Equal(zerodb.UserKey(sum[:], 0), object.Key)
+			}
+		}
+		count, err := (&faultyCluster{Cluster: cluster}).objectCount()
+		require.NoError(err)
+		require.Equal(4, count)
+
+		cfg, err := storage.WriteChunk(data)
+		require.NoError(err)
+		require.NoError(storage.DeleteChunk(*cfg))
+	})
+	t.Run("distributed", func(t *testing.T) {
+		require := require.New(t)
+		storage, err := NewDistributedChunkStorage(cluster, 2, 2, 1)
+		require.NoError(err)
+
+		// each part is stored under the chunk hash and its index
+		cfg, err := storage.WriteChunk(data)
+		require.NoError(err)
+		require.Len(cfg.Objects, 4)
+		for index, object := range cfg.Objects {
+			require.Equal(zerodb.UserKey(sum[:], index), object.Key)
+		}
+		output, err := storage.ReadChunk(*cfg)
+		require.NoError(err)
+		require.Equal(data, output)
+		require.NoError(storage.DeleteChunk(*cfg))
+	})
+}
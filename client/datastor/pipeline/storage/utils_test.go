@@ -17,14 +17,24 @@
 package storage
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"math"
+	"time"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
 	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
 )
 
 func newZdbServerCluster(count int) (clu *zerodb.Cluster, cleanup func(), err error) {
+	return newZdbServerTopologyCluster(make([]datastor.Topology, count))
+}
+
+// create a cluster with a server for each of the given topologies
+func newZdbServerTopologyCluster(topologies []datastor.Topology) (clu *zerodb.Cluster, cleanup func(), err error) {
 	var (
 		addresses []datastor.ShardConfig
 		cleanups  []func()
@@ -36,13 +46,18 @@ func newZdbServerCluster(count int) (clu *zerodb.Cluster, cleanup func(), err er
 		passwd    = "passwd"
 	)
 
-	for i := 0; i < count; i++ {
+	for _, topology := range topologies {
 		_, addr, cleanup, err = zdbtest.NewInMem0DBServer(namespace)
 		if err != nil {
 			return
 		}
 		cleanups = append(cleanups, cleanup)
-		addresses = append(addresses, datastor.ShardConfig{Address: addr})
+		addresses = append(addresses, datastor.ShardConfig{
+			Address: addr,
+			Zone:    topology.Zone,
+			Rack:    topology.Rack,
+			Host:    topology.Host,
+		})
 	}
 
 	clu, err = zerodb.NewCluster(addresses, passwd, namespace, nil, datastor.SpreadingTypeRandom)
@@ -67,7 +82,7 @@ func newZdbServerClient(passwd, namespace string) (cli *zerodb.Client, addr stri
 	if err != nil {
 		return
 	}
-	cli, err = zerodb.NewClient(addr, passwd, namespace)
+	cli, err = zerodb.NewClient(addr, passwd, namespace, nil)
 	if err != nil {
 		return
 	}
@@ -89,8 +104,154 @@ func (dc dummyCluster) GetShardIterator(exceptShards []string) datastor.ShardIte
 func (dc dummyCluster) ListedShardCount() int {
 	return int(math.MaxInt32)
 }
+func (dc dummyCluster) GetShardHealth() []datastor.ShardHealth {
+	panic("dummy::GetShardHealth")
+}
 func (dc dummyCluster) Close() error { return nil }
 
 var (
 	_ datastor.Cluster = dummyCluster{}
 )
+
+// faultyCluster wraps a cluster, such that writes and/or deletes
+// fail for the shards that are marked as such,
+// reads are delayed for the shards that are marked as slow,
+// and the shards that are marked as unhealthy are only handed out as listed shards.
+type faultyCluster struct {
+	datastor.Cluster
+	failWrite  map[string]bool
+	failDelete map[string]bool
+	slowRead   map[string]time.Duration
+	unhealthy  map[string]bool
+}
+
+func (fc *faultyCluster) GetShard(id string) (datastor.Shard, error) {
+	if fc.unhealthy[id] {
+		return nil, datastor.ErrShardUnhealthy
+	}
+	shard, err := fc.Cluster.GetShard(id)
+	if err != nil {
+		return nil, err
+	}
+	return fc.wrap(shard), nil
+}
+
+func (fc *faultyCluster) GetListedShard(id string) (datastor.Shard, error) {
+	shard, err := fc.Cluster.(datastor.ListedCluster).GetListedShard(id)
+	if err != nil {
+		return nil, err
+	}
+	return fc.wrap(shard), nil
+}
+
+func (fc *faultyCluster) GetShardIterator(exceptShards []string) datastor.ShardIterator {
+	return &faultyShardIterator{
+		ShardIterator: fc.Cluster.GetShardIterator(exceptShards),
+		cluster:       fc,
+	}
+}
+
+func (fc *faultyCluster) wrap(shard datastor.Shard) datastor.Shard {
+	return &faultyShard{
+		Shard:      shard,
+		failWrite:  fc.failWrite[shard.Identifier()],
+		failDelete: fc.failDelete[shard.Identifier()],
+		slowRead:   fc.slowRead[shard.Identifier()],
+	}
+}
+
+// objectCount returns the total amount of objects stored on all shards
+func (fc *faultyCluster) objectCount() (int, error) {
+	var count int
+	it := fc.Cluster.GetShardIterator(nil)
+	for it.Next() {
+		ch, err := it.Shard().ListObjectKeyIterator(context.Background())
+		if err != nil {
+			return 0, err
+		}
+		for result := range ch {
+			if result.Error != nil {
+				return 0, result.Error
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+type faultyShardIterator struct {
+	datastor.ShardIterator
+	cluster *faultyCluster
+}
+
+func (it *faultyShardIterator) Shard() datastor.Shard {
+	return it.cluster.wrap(it.ShardIterator.Shard())
+}
+
+type faultyShard struct {
+	datastor.Shard
+	failWrite, failDelete bool
+	slowRead              time.Duration
+}
+
+func (fs *faultyShard) GetObjectContext(ctx context.Context, key []byte) (*datastor.Object, error) {
+	if fs.slowRead > 0 {
+		select {
+		case <-time.After(fs.slowRead):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return fs.Shard.GetObjectContext(ctx, key)
+}
+
+func (fs *faultyShard) CreateObjectContext(ctx context.Context, data []byte) ([]byte, error) {
+	if fs.failWrite {
+		return nil, errFaultyShard
+	}
+	return fs.Shard.CreateObjectContext(ctx, data)
+}
+
+func (fs *faultyShard) DeleteObjectContext(ctx context.Context, key []byte) error {
+	if fs.failDelete {
+		return errFaultyShard
+	}
+	return fs.Shard.DeleteObjectContext(ctx, key)
+}
+
+// zoneTopologies returns the topologies of shardsPerZone shards in each of the given zones,
+// each shard having a host of its own.
+func zoneTopologies(shardsPerZone int, zones ...string) []datastor.Topology {
+	var topologies []datastor.Topology
+	for _, zone := range zones {
+		for i := 0; i < shardsPerZone; i++ {
+			topologies = append(topologies, datastor.Topology{
+				Zone: zone,
+				Host: fmt.Sprintf("host%d", i),
+			})
+		}
+	}
+	return topologies
+}
+
+// zoneCounts returns the amount of given objects stored in each zone.
+func zoneCounts(cluster datastor.Cluster, objects []metatypes.Object) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, obj := range objects {
+		shard, err := cluster.GetShard(obj.ShardID)
+		if err != nil {
+			return nil, err
+		}
+		counts[shard.Topology().Zone]++
+	}
+	return counts, nil
+}
+
+var (
+	errFaultyShard = errors.New("faulty shard")
+)
+
+var (
+	_ datastor.Cluster = (*faultyCluster)(nil)
+	_ datastor.Shard   = (*faultyShard)(nil)
+)
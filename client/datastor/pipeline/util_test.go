@@ -17,7 +17,13 @@
 package pipeline
 
 import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
 	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
 	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
 	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
 )
@@ -65,7 +71,7 @@ func newZdbServerClient(passwd, namespace string) (cli *zerodb.Client, addr stri
 	if err != nil {
 		return
 	}
-	cli, err = zerodb.NewClient(addr, passwd, namespace)
+	cli, err = zerodb.NewClient(addr, passwd, namespace, nil)
 	if err != nil {
 		return
 	}
@@ -76,3 +82,56 @@ func newZdbServerClient(passwd, namespace string) (cli *zerodb.Client, addr stri
 	}
 	return
 }
+
+// objectCount returns the total amount of objects stored on all shards
+func objectCount(cluster datastor.Cluster) (int, error) {
+	var count int
+	it := cluster.GetShardIterator(nil)
+	for it.Next() {
+		ch, err := it.Shard().ListObjectKeyIterator(context.Background())
+		if err != nil {
+			return 0, err
+		}
+		for result := range ch {
+			if result.Error != nil {
+				return 0, result.Error
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// faultyChunkStorage wraps a chunk storage,
+// such that all writes fail after a given amount of writes,
+// and optionally all deletes fail.
+type faultyChunkStorage struct {
+	storage.ChunkStorage
+	writeCount, writtenCount, maxWriteCount int32
+	failDelete                              bool
+}
+
+func (fs *faultyChunkStorage) WriteChunkContext(ctx context.Context, data []byte) (*storage.ChunkConfig, error) {
+	if atomic.AddInt32(&fs.writeCount, 1) > fs.maxWriteCount {
+		// only fail once all allowed writes are finished,
+		// such that the amount of written chunks is known
+		for atomic.LoadInt32(&fs.writtenCount) < fs.maxWriteCount {
+			time.Sleep(time.Millisecond)
+		}
+		return nil, errFaultyStorage
+	}
+	cfg, err := fs.ChunkStorage.WriteChunkContext(ctx, data)
+	atomic.AddInt32(&fs.writtenCount, 1)
+	return cfg, err
+}
+
+func (fs *faultyChunkStorage) DeleteChunkContext(ctx context.Context, cfg storage.ChunkConfig) error {
+	if fs.failDelete {
+		return errFaultyStorage
+	}
+	return fs.ChunkStorage.DeleteChunkContext(ctx, cfg)
+}
+
+var (
+	errFaultyStorage = errors.New("faulty storage")
+)
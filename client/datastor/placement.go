@@ -0,0 +1,171 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+// Topology defines the failure domains a shard belongs to.
+// All labels are optional, a shard which doesn't define a label,
+// is considered to be a failure domain of its own on that level.
+//
+// Racks are scoped by their zone, and hosts by their rack,
+// such that equal labels in different zones (or racks) are different domains.
+type Topology struct {
+	Zone string `yaml:"zone" json:"zone"`
+	Rack string `yaml:"rack" json:"rack"`
+	Host string `yaml:"host" json:"host"`
+}
+
+// domains returns the keys of the failure domains of the topology,
+// starting from the widest domain, with an empty key for unlabeled levels.
+func (t Topology) domains() [3]string {
+	var keys [3]string
+	if t.Zone != "" {
+		keys[0] = "zone:" + t.Zone
+	}
+	if t.Rack != "" {
+		keys[1] = "rack:" + t.Zone + "/" + t.Rack
+	}
+	if t.Host != "" {
+		keys[2] = "host:" + t.Zone + "/" + t.Rack + "/" + t.Host
+	}
+	return keys
+}
+
+// NewPlacementShardIterator creates a new placement shard iterator,
+// placing shards from the given iterator, in addition to the already placed topologies.
+// See `PlacementShardIterator` for more information.
+//
+// maxPerDomain limits the amount of shards placed in a single zone, rack or host,
+// including the already placed ones, it can be `<= 0` in order to not limit them at all.
+// The given iterator is exhausted as part of this call.
+func NewPlacementShardIterator(it ShardIterator, placed []Topology, maxPerDomain int) *PlacementShardIterator {
+	if it == nil {
+		panic("no shard iterator given")
+	}
+	pit := &PlacementShardIterator{
+		counts:       make(map[string]int),
+		maxPerDomain: maxPerDomain,
+	}
+	for _, topology := range placed {
+		pit.place(topology.domains())
+	}
+	for it.Next() {
+		pit.candidates = append(pit.candidates, it.Shard())
+	}
+	return pit
+}
+
+// PlacementShardIterator implements the ShardIterator interface,
+// returning the shards of another iterator in an order which maximizes
+// the diversity of the failure domains of all shards returned so far.
+// A shard is considered placed as soon as it is returned,
+// until it is released, e.g. because it couldn't be written to.
+//
+// Each iteration it returns the shard which shares the least placed shards in its zone,
+// followed by its rack and host, preferring the order of the original iterator in case of a tie.
+// Shards which would exceed the max amount of shards per domain are never returned,
+// such that the failure of a single domain never loses more than that amount of shards.
+// The iterator is finished when no more shards can be placed.
+type PlacementShardIterator struct {
+	candidates   []Shard
+	counts       map[string]int
+	maxPerDomain int
+	current      Shard
+}
+
+// Next implements ShardIterator.Next
+func (it *PlacementShardIterator) Next() bool {
+	var (
+		best      = -1
+		bestScore [3]int
+	)
+	for index, shard := range it.candidates {
+		score, ok := it.score(shard.Topology().domains())
+		if !ok {
+			continue
+		}
+		if best == -1 || lessScore(score, bestScore) {
+			best, bestScore = index, score
+		}
+	}
+	if best == -1 {
+		// candidates are kept, as releasing a shard can make them placeable again
+		return false
+	}
+
+	it.current = it.candidates[best]
+	it.candidates = append(it.candidates[:best], it.candidates[best+1:]...)
+	it.place(it.current.Topology().domains())
+	return true
+}
+
+// Shard implements ShardIterator.Shard
+func (it *PlacementShardIterator) Shard() Shard {
+	if it.current == nil {
+		panic("invalid shard iterator, ensure to make a successful Next() call first")
+	}
+	return it.current
+}
+
+// Release gives back the place of a returned shard in its domains,
+// such that other shards of these domains can be placed instead,
+// in case the shard couldn't be used (e.g. because writing to it failed).
+// The released shard itself is never returned again.
+func (it *PlacementShardIterator) Release(shard Shard) {
+	for _, key := range shard.Topology().domains() {
+		if key != "" && it.counts[key] > 0 {
+			it.counts[key]--
+		}
+	}
+}
+
+// score returns the amount of placed shards in each of the given domains,
+// and false in case a shard can no longer be placed in one of them.
+func (it *PlacementShardIterator) score(domains [3]string) (score [3]int, ok bool) {
+	for level, key := range domains {
+		if key == "" {
+			continue
+		}
+		count := it.counts[key]
+		if it.maxPerDomain > 0 && count >= it.maxPerDomain {
+			return score, false
+		}
+		score[level] = count
+	}
+	return score, true
+}
+
+func (it *PlacementShardIterator) place(domains [3]string) {
+	for _, key := range domains {
+		if key != "" {
+			it.counts[key]++
+		}
+	}
+}
+
+// lessScore compares two scores, the widest domain first.
+func lessScore(a, b [3]int) bool {
+	for level := range a {
+		if a[level] != b[level] {
+			return a[level] < b[level]
+		}
+	}
+	return false
+}
+
+var (
+	_ ShardIterator = (*PlacementShardIterator)(nil)
+)
@@ -0,0 +1,107 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package datastor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlacementShardIteratorPanics(t *testing.T) {
+	require.Panics(t, func() {
+		NewPlacementShardIterator(nil, nil, 0)
+	}, "no iterator given")
+
+	it := NewPlacementShardIterator(&LeastUsedShardIterator{}, nil, 0)
+	require.Panics(t, func() {
+		it.Shard()
+	}, "no shard available")
+	require.False(t, it.Next())
+}
+
+func TestPlacementShardIterator(t *testing.T) {
+	// returns an iterator over stub shards, in the given order
+	shards := func(topologies ...Topology) ShardIterator {
+		slice := make([]Shard, len(topologies))
+		for i, topology := range topologies {
+			slice[i] = &stubShard{id: string('a' + rune(i)), topology: topology}
+		}
+		return &LeastUsedShardIterator{slice: slice}
+	}
+	ids := func(it ShardIterator) string {
+		var str string
+		for it.Next() {
+			str += it.Shard().Identifier()
+		}
+		return str
+	}
+
+	t.Run("unlabeled", func(t *testing.T) {
+		// shards without labels keep their original order, and aren't limited
+		it := NewPlacementShardIterator(shards(Topology{}, Topology{}, Topology{}), nil, 1)
+		require.Equal(t, "abc", ids(it))
+	})
+
+	t.Run("diversity", func(t *testing.T) {
+		it := NewPlacementShardIterator(shards(
+			Topology{Zone: "1", Rack: "1"},
+			Topology{Zone: "1", Rack: "1"},
+			Topology{Zone: "1", Rack: "2"},
+			Topology{Zone: "2", Rack: "1"},
+			Topology{Zone: "2", Rack: "1"},
+		), nil, 0)
+		require.Equal(t, "adceb", ids(it))
+	})
+
+	t.Run("placed", func(t *testing.T) {
+		it := NewPlacementShardIterator(shards(
+			Topology{Zone: "1"},
+			Topology{Zone: "2"},
+			Topology{Zone: "1"},
+			Topology{Zone: "3"},
+		), []Topology{{Zone: "1"}, {Zone: "2"}}, 2)
+		require.Equal(t, "dab", ids(it))
+	})
+
+	t.Run("hosts", func(t *testing.T) {
+		// equal host labels in different racks are different domains
+		it := NewPlacementShardIterator(shards(
+			Topology{Rack: "1", Host: "1"},
+			Topology{Rack: "1", Host: "1"},
+			Topology{Rack: "2", Host: "1"},
+			Topology{Host: "1"},
+		), nil, 1)
+		require.Equal(t, "acd", ids(it))
+	})
+
+	t.Run("release", func(t *testing.T) {
+		// a released shard gives back its place in its domains
+		it := NewPlacementShardIterator(shards(
+			Topology{Zone: "1"},
+			Topology{Zone: "1"},
+			Topology{Zone: "2"},
+		), nil, 1)
+		require.True(t, it.Next())
+		require.Equal(t, "a", it.Shard().Identifier())
+		require.True(t, it.Next())
+		require.Equal(t, "c", it.Shard().Identifier())
+		require.False(t, it.Next(), "zone 1 is full")
+		it.Release(&stubShard{id: "a", topology: Topology{Zone: "1"}})
+		require.Equal(t, "b", ids(it))
+	})
+}
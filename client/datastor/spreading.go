@@ -40,6 +40,23 @@ const (
 	// the shards that are the least used (have the more storage available)
 	SpreadingTypeLeastUsed
 
+	// SpreadingTypeMostFree is the enum constant that identifies
+	// a ShardIterator that will walk over the shards by returning first
+	// the shards which have the most free space left,
+	// as reported by their namespace
+	SpreadingTypeMostFree
+
+	// SpreadingTypeConsistentHash is the enum constant that identifies
+	// a ShardIterator that will walk over the shards of a consistent-hash ring,
+	// starting from the position of the (hash of the) data to be stored,
+	// such that the same data is always placed on the same shards
+	SpreadingTypeConsistentHash
+
+	// SpreadingTypeWeightedRandom is the enum constant that identifies
+	// a ShardIterator that will walk over the shards in a random way,
+	// where shards with a greater weight (or more free space) are more likely to be returned first
+	SpreadingTypeWeightedRandom
+
 	// DefaultSpreadingType represent the default value
 	// for the ShardIterator
 	//
@@ -58,7 +75,7 @@ const (
 	//         // ...
 	//    )
 	//
-	MaxStandardSpreadingType = SpreadingTypeLeastUsed
+	MaxStandardSpreadingType = SpreadingTypeWeightedRandom
 )
 
 // String implements Stringer.String
@@ -63,7 +63,7 @@ type (
 		WriteRequestPerHour int64
 		NrObjects           int64
 		Used                int64 //the number of bytes present in the namespace
-		Free                int64
+		Free                int64 // the number of bytes which can still be stored in the namespace
 		Health              *Health
 	}
 
@@ -78,10 +78,14 @@ type (
 	// of all objects stored in the current namespace.
 	//
 	// Only in case of an error, the Error property will be set,
-	// in all other cases only the Key property will be set.
+	// in all other cases the Key, Size and Timestamp properties will be set.
 	ObjectKeyResult struct {
-		Key   []byte
-		Error error
+		Key []byte
+		// Size of the stored object data, in bytes.
+		Size int64
+		// Timestamp (unix epoch, in seconds) of the moment the object was stored.
+		Timestamp int64
+		Error     error
 	}
 )
 
@@ -34,3 +34,26 @@ func TestObjectStatusString(t *testing.T) {
 	// invalid enum value
 	require.Empty(ObjectStatus(math.MaxUint8).String())
 }
+
+func TestKeyModeText(t *testing.T) {
+	require := require.New(t)
+
+	for _, mode := range []KeyMode{KeyModeSequential, KeyModeUser} {
+		text, err := mode.MarshalText()
+		require.NoError(err)
+		var parsed KeyMode
+		require.NoError(parsed.UnmarshalText(text))
+		require.Equal(mode, parsed)
+	}
+
+	var mode KeyMode
+	require.NoError(mode.UnmarshalText([]byte("USER")))
+	require.Equal(KeyModeUser, mode)
+	require.NoError(mode.UnmarshalText(nil))
+	require.Equal(DefaultKeyMode, mode)
+	require.Error(mode.UnmarshalText([]byte("foo")))
+
+	// invalid enum value
+	_, err := KeyMode(math.MaxUint8).MarshalText()
+	require.Error(err)
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zerodb
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+)
+
+// NamespaceConfig defines the settings of a 0-db namespace,
+// as applied by the AdminClient when provisioning it.
+type NamespaceConfig struct {
+	// Name of the namespace, required.
+	Name string
+	// Password of the namespace, the namespace isn't protected by a password if empty.
+	Password string
+	// MaxSize defines the size limit of the namespace in bytes,
+	// the namespace is unlimited if zero.
+	MaxSize int64
+	// Public defines whether or not the namespace can be read without password,
+	// in case it is protected by a password.
+	Public bool
+	// KeyMode defines the key mode of the namespace,
+	// which is only applied when the namespace is created,
+	// as 0-db doesn't allow to change the mode of a namespace which contains data.
+	KeyMode datastor.KeyMode
+}
+
+// AdminClient defines a client to administer the namespaces of a 0-db server,
+// using the 0-db admin commands.
+type AdminClient struct {
+	// client used to execute the admin commands,
+	// which isn't bound to any namespace
+	client *Client
+}
+
+// NewAdminClient creates a new admin client,
+// for the 0-db server at the given address.
+//
+// passwd is the admin password of the server, and is optional,
+// as all connections are admin in case the server doesn't define an admin password.
+//
+// tlsConfig is optional, when given all connections
+// to the 0-db server will be established using TLS.
+func NewAdminClient(addr, passwd string, tlsConfig *tls.Config, cfg datastor.ConnectionConfig) (*AdminClient, error) {
+	if len(addr) == 0 {
+		return nil, fmt.Errorf("no address given")
+	}
+
+	cfg = sanitizeConnectionConfig(cfg)
+	pool := newPool(addr, tlsConfig, cfg, func(conn redis.Conn) error {
+		if passwd == "" {
+			return nil
+		}
+		_, err := conn.Do("AUTH", passwd)
+		if err != nil {
+			return fmt.Errorf("failed to authenticate as admin: %v", err)
+		}
+		return nil
+	})
+	client := &AdminClient{client: &Client{pool: pool, cfg: cfg}}
+
+	// ensure the server can be reached, and the admin password is accepted
+	_, err := client.ListNamespaces(context.Background())
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// CreateNamespace creates a new namespace,
+// using the default settings of the server.
+func (c *AdminClient) CreateNamespace(ctx context.Context, name string) error {
+	_, err := c.client.do(ctx, "NSNEW", name)
+	return err
+}
+
+// DeleteNamespace deletes a namespace, and all objects stored within it.
+func (c *AdminClient) DeleteNamespace(ctx context.Context, name string) error {
+	_, err := c.client.do(ctx, "NSDEL", name)
+	return err
+}
+
+// ListNamespaces returns the names of all namespaces of the server.
+func (c *AdminClient) ListNamespaces(ctx context.Context) ([]string, error) {
+	return redis.Strings(c.client.do(ctx, "NSLIST"))
+}
+
+// GetNamespace returns the available information of a namespace.
+func (c *AdminClient) GetNamespace(ctx context.Context, name string) (*datastor.Namespace, error) {
+	infoStr, err := redis.String(c.client.do(ctx, "NSINFO", name))
+	if err != nil {
+		return nil, err
+	}
+	return parseNamespaceInfo(infoStr)
+}
+
+// SetNamespacePassword sets the password of a namespace,
+// an empty password removes the password protection of the namespace.
+func (c *AdminClient) SetNamespacePassword(ctx context.Context, name, password string) error {
+	if password == "" {
+		password = "*"
+	}
+	return c.setNamespace(ctx, name, "password", password)
+}
+
+// SetNamespaceMaxSize sets the size limit of a namespace in bytes,
+// a size of zero removes the size limit of the namespace.
+func (c *AdminClient) SetNamespaceMaxSize(ctx context.Context, name string, size int64) error {
+	if size < 0 {
+		return fmt.Errorf("invalid (negative) namespace size %d", size)
+	}
+	return c.setNamespace(ctx, name, "maxsize", size)
+}
+
+// SetNamespacePublic sets whether or not a password-protected namespace
+// can be read without password (public), or not at all (private).
+func (c *AdminClient) SetNamespacePublic(ctx context.Context, name string, public bool) error {
+	value := 0
+	if public {
+		value = 1
+	}
+	return c.setNamespace(ctx, name, "public", value)
+}
+
+// SetNamespaceKeyMode sets the key mode of a namespace,
+// which is only possible as long as the namespace is empty.
+// In user-key mode the keys of objects are defined by the client,
+// while in sequential mode they are generated by the server.
+func (c *AdminClient) SetNamespaceKeyMode(ctx context.Context, name string, mode datastor.KeyMode) error {
+	switch mode {
+	case datastor.KeyModeSequential:
+		return c.setNamespace(ctx, name, "mode", "seq")
+	case datastor.KeyModeUser:
+		return c.setNamespace(ctx, name, "mode", "user")
+	default:
+		return fmt.Errorf("invalid key mode %d", uint8(mode))
+	}
+}
+
+func (c *AdminClient) setNamespace(ctx context.Context, name, property string, value interface{}) error {
+	_, err := c.client.do(ctx, "NSSET", name, property, value)
+	return err
+}
+
+// ProvisionNamespace creates the namespace defined by the given config, if it doesn't exist yet,
+// and applies the settings of the config to it, whether or not it already existed,
+// except for the key mode, which is only applied when the namespace is created.
+// True is returned in case the namespace was created.
+func (c *AdminClient) ProvisionNamespace(ctx context.Context, cfg NamespaceConfig) (bool, error) {
+	if len(cfg.Name) == 0 {
+		return false, fmt.Errorf("no namespace given")
+	}
+	names, err := c.ListNamespaces(ctx)
+	if err != nil {
+		return false, err
+	}
+	created := true
+	for _, name := range names {
+		if name == cfg.Name {
+			created = false
+			break
+		}
+	}
+	if created {
+		err = c.CreateNamespace(ctx, cfg.Name)
+		if err != nil {
+			return false, fmt.Errorf("failed to create namespace %s: %v", cfg.Name, err)
+		}
+		if cfg.KeyMode != datastor.KeyModeSequential {
+			err = c.SetNamespaceKeyMode(ctx, cfg.Name, cfg.KeyMode)
+			if err != nil {
+				return true, fmt.Errorf("failed to configure namespace %s: %v", cfg.Name, err)
+			}
+		}
+	}
+
+	err = c.SetNamespacePassword(ctx, cfg.Name, cfg.Password)
+	if err == nil {
+		err = c.SetNamespaceMaxSize(ctx, cfg.Name, cfg.MaxSize)
+	}
+	if err == nil {
+		err = c.SetNamespacePublic(ctx, cfg.Name, cfg.Public)
+	}
+	if err != nil {
+		return created, fmt.Errorf("failed to configure namespace %s: %v", cfg.Name, err)
+	}
+	return created, nil
+}
+
+// Close any open resources.
+func (c *AdminClient) Close() error {
+	return c.client.Close()
+}
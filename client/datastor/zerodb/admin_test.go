@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zerodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
+)
+
+func TestNewAdminClientPanics(t *testing.T) {
+	_, err := NewAdminClient("", "", nil, datastor.ConnectionConfig{})
+	require.Error(t, err, "no address given")
+}
+
+func TestAdminClient(t *testing.T) {
+	require := require.New(t)
+
+	server, addr, cleanup, err := zdbtest.NewInMem0DBServer("default")
+	require.NoError(err)
+	defer cleanup()
+	server.SetAdminPassword("admin")
+
+	ctx := context.Background()
+
+	// the admin password is required to administer the namespaces
+	_, err = NewAdminClient(addr, "wrong", nil, datastor.ConnectionConfig{})
+	require.Error(err)
+	admin, err := NewAdminClient(addr, "", nil, datastor.ConnectionConfig{})
+	require.NoError(err)
+	require.Error(admin.CreateNamespace(ctx, "foo"))
+	require.NoError(admin.Close())
+
+	admin, err = NewAdminClient(addr, "admin", nil, datastor.ConnectionConfig{})
+	require.NoError(err)
+	defer admin.Close()
+
+	require.NoError(admin.CreateNamespace(ctx, "foo"))
+	require.Error(admin.CreateNamespace(ctx, "foo"), "namespace already exists")
+
+	names, err := admin.ListNamespaces(ctx)
+	require.NoError(err)
+	require.Equal([]string{"default", "foo"}, names)
+
+	require.NoError(admin.SetNamespaceMaxSize(ctx, "foo", 1024))
+	require.Error(admin.SetNamespaceMaxSize(ctx, "foo", -1))
+	ns, err := admin.GetNamespace(ctx, "foo")
+	require.NoError(err)
+	require.Equal("foo", ns.Label)
+	require.Equal(int64(1024), ns.Free)
+
+	// a private namespace can only be used with its password
+	require.NoError(admin.SetNamespacePassword(ctx, "foo", "bar"))
+	require.NoError(admin.SetNamespacePublic(ctx, "foo", false))
+	_, err = NewClient(addr, "", "foo", nil)
+	require.Error(err)
+	client, err := NewClient(addr, "bar", "foo", nil)
+	require.NoError(err)
+	require.NoError(client.Close())
+
+	// removing the password opens the namespace again
+	require.NoError(admin.SetNamespacePassword(ctx, "foo", ""))
+	client, err = NewClient(addr, "", "foo", nil)
+	require.NoError(err)
+	require.NoError(client.Close())
+
+	require.NoError(admin.DeleteNamespace(ctx, "foo"))
+	require.Error(admin.DeleteNamespace(ctx, "foo"), "namespace no longer exists")
+	names, err = admin.ListNamespaces(ctx)
+	require.NoError(err)
+	require.Equal([]string{"default"}, names)
+}
+
+func TestAdminClientProvisionNamespace(t *testing.T) {
+	require := require.New(t)
+
+	_, addr, cleanup, err := zdbtest.NewInMem0DBServer("default")
+	require.NoError(err)
+	defer cleanup()
+
+	admin, err := NewAdminClient(addr, "", nil, datastor.ConnectionConfig{})
+	require.NoError(err)
+	defer admin.Close()
+
+	ctx := context.Background()
+	_, err = admin.ProvisionNamespace(ctx, NamespaceConfig{})
+	require.Error(err, "no namespace given")
+
+	cfg := NamespaceConfig{Name: "foo", Password: "bar", MaxSize: 2048}
+	created, err := admin.ProvisionNamespace(ctx, cfg)
+	require.NoError(err)
+	require.True(created)
+
+	// provisioning an existing namespace only updates it
+	cfg.MaxSize = 4096
+	created, err = admin.ProvisionNamespace(ctx, cfg)
+	require.NoError(err)
+	require.False(created)
+
+	ns, err := admin.GetNamespace(ctx, "foo")
+	require.NoError(err)
+	require.Equal(int64(4096), ns.Free)
+
+	_, err = NewClient(addr, "", "foo", nil)
+	require.Error(err)
+	client, err := NewClient(addr, "bar", "foo", nil)
+	require.NoError(err)
+	require.NoError(client.Close())
+
+	// the key mode is applied when the namespace is created
+	created, err = admin.ProvisionNamespace(ctx, NamespaceConfig{Name: "baz", KeyMode: datastor.KeyModeUser})
+	require.NoError(err)
+	require.True(created)
+	client, err = NewClient(addr, "", "baz", nil)
+	require.NoError(err)
+	defer client.Close()
+	client.SetKeyMode(datastor.KeyModeUser)
+	key, err := client.CreateObjectContext(ctx, []byte("data"))
+	require.NoError(err)
+	sum := blake2b.Sum256([]byte("data"))
+	require.Equal(UserKey(sum[:], 0), key)
+}
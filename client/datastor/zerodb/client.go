@@ -18,42 +18,102 @@ package zerodb
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"math/rand"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/blake2b"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
 )
 
-var (
-	readTimeout    = 60 * time.Second
-	writeTimeout   = 60 * time.Second
-	connectTimeout = 3 * time.Second
-)
+// sanitizeConnectionConfig returns a copy of the given config,
+// with defaults applied, and disabled options set to zero.
+func sanitizeConnectionConfig(cfg datastor.ConnectionConfig) datastor.ConnectionConfig {
+	orDefault := func(value, def time.Duration) time.Duration {
+		if value == 0 {
+			return def
+		}
+		if value < 0 {
+			return 0
+		}
+		return value
+	}
+	if cfg.MaxActive == 0 {
+		cfg.MaxActive = datastor.DefaultMaxActive
+	} else if cfg.MaxActive < 0 {
+		cfg.MaxActive = 0
+	}
+	if cfg.MaxIdle <= 0 {
+		cfg.MaxIdle = cfg.MaxActive
+	}
+	cfg.ConnectTimeout = orDefault(cfg.ConnectTimeout, datastor.DefaultConnectTimeout)
+	cfg.ReadTimeout = orDefault(cfg.ReadTimeout, datastor.DefaultReadTimeout)
+	cfg.WriteTimeout = orDefault(cfg.WriteTimeout, datastor.DefaultWriteTimeout)
+	if cfg.Retry.MaxRetries == 0 {
+		cfg.Retry.MaxRetries = datastor.DefaultMaxRetries
+	} else if cfg.Retry.MaxRetries < 0 {
+		cfg.Retry.MaxRetries = 0
+	}
+	if cfg.Retry.InitialBackoff <= 0 {
+		cfg.Retry.InitialBackoff = datastor.DefaultInitialBackoff
+	}
+	if cfg.Retry.MaxBackoff <= 0 {
+		cfg.Retry.MaxBackoff = datastor.DefaultMaxBackoff
+	}
+	if cfg.Retry.MaxBackoff < cfg.Retry.InitialBackoff {
+		cfg.Retry.MaxBackoff = cfg.Retry.InitialBackoff
+	}
+	return cfg
+}
 
 // Client defines a data client,
 // to connect to a 0-db server
 type Client struct {
-	pool          *redis.Pool
-	namespace     string
-	utilization   int64
-	muUtilization sync.Mutex
+	pool      *redis.Pool
+	namespace string
+	cfg       datastor.ConnectionConfig
+	keyMode   datastor.KeyMode
+
+	// namespace information cached by the client,
+	// refreshed with each GetNamespace call
+	nsMux    sync.Mutex
+	cachedNS datastor.Namespace
+
+	// optional observer of the result of each call
+	observe func(latency time.Duration, err error)
 }
 
 // NewClient creates a new data client,
 // with given server address & password,
 // and use the given namespace.
-func NewClient(addr, passwd, namespace string) (*Client, error) {
-	var opts = []redis.DialOption{
-		redis.DialReadTimeout(readTimeout),
-		redis.DialWriteTimeout(writeTimeout),
-		redis.DialConnectTimeout(connectTimeout),
-	}
+//
+// tlsConfig is optional, when given all connections
+// to the 0-db server will be established using TLS,
+// and the client certificates it defines (if any) will be used for mutual TLS.
+//
+// The default connection pool, timeouts and retry policy are used,
+// see NewClientWithConfig for more information.
+func NewClient(addr, passwd, namespace string, tlsConfig *tls.Config) (*Client, error) {
+	return NewClientWithConfig(addr, passwd, namespace, tlsConfig, datastor.ConnectionConfig{})
+}
 
+// NewClientWithConfig creates a new data client, just like NewClient,
+// using the connection pool, timeouts and retry policy defined by the given config.
+//
+// Calls which fail with a retryable error (see datastor.IsRetryableError) are retried,
+// except for CreateObject calls in sequential key mode, as a retried call
+// would store the object twice, in case only the reply of the first call was lost.
+// Use user keys (see SetKeyMode) to retry CreateObject calls as well.
+func NewClientWithConfig(addr, passwd, namespace string, tlsConfig *tls.Config, cfg datastor.ConnectionConfig) (*Client, error) {
 	if len(addr) == 0 {
 		return nil, fmt.Errorf("no address given")
 	}
@@ -66,22 +126,70 @@ func NewClient(addr, passwd, namespace string) (*Client, error) {
 		selectArgs = append(selectArgs, passwd)
 	}
 
-	// creates pool
-	pool := &redis.Pool{
+	cfg = sanitizeConnectionConfig(cfg)
+	pool := newPool(addr, tlsConfig, cfg, func(conn redis.Conn) error {
+		_, err := conn.Do("SELECT", selectArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to select %s: %v", selectArgs[0], err)
+		}
+		return nil
+	})
+
+	client := &Client{
+		pool:      pool,
+		namespace: namespace,
+		cfg:       cfg,
+	}
+	// cache the current information of the namespace in the client object,
+	// this is then used (and refreshed) during the lifetime of the client
+	// to allow different sorting algorithms in ShardIterator
+	_, err := client.GetNamespaceContext(context.Background())
+	if err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// newPool creates a pool of connections to the 0-db server at the given address,
+// using the given (sanitized) config. The optional setup function is called
+// for each new connection, prior to it being used.
+func newPool(addr string, tlsConfig *tls.Config, cfg datastor.ConnectionConfig, setup func(conn redis.Conn) error) *redis.Pool {
+	var opts = []redis.DialOption{
+		redis.DialReadTimeout(cfg.ReadTimeout),
+		redis.DialWriteTimeout(cfg.WriteTimeout),
+		redis.DialConnectTimeout(cfg.ConnectTimeout),
+	}
+	if tlsConfig != nil {
+		opts = append(opts,
+			redis.DialUseTLS(true),
+			redis.DialTLSConfig(tlsConfig))
+	}
+
+	return &redis.Pool{
 		Wait:      true,
-		MaxActive: 5,
-		MaxIdle:   5,
-		Dial: func() (redis.Conn, error) {
-			conn, err := redis.Dial("tcp", addr, opts...)
+		MaxActive: cfg.MaxActive,
+		MaxIdle:   cfg.MaxIdle,
+		DialContext: func(ctx context.Context) (redis.Conn, error) {
+			conn, err := redis.DialContext(ctx, "tcp", addr, opts...)
 			if err != nil {
-				return nil, fmt.Errorf("failed to dial 0-db: %v", err)
+				return nil, fmt.Errorf("failed to dial 0-db: %w", err)
 			}
-
-			_, err = conn.Do("SELECT", selectArgs...)
-			if err != nil {
-				return nil, fmt.Errorf("failed to select %s: %v", selectArgs[0], err)
+			cc := cancelableConn{conn}
+			if setup != nil {
+				// the connection is closed when the context is done during the setup
+				stop := cc.closeOnDone(ctx)
+				err = setup(conn)
+				stop()
+				if err != nil {
+					conn.Close()
+					if ctxErr := ctx.Err(); ctxErr != nil {
+						return nil, ctxErr
+					}
+					return nil, err
+				}
 			}
-			return conn, err
+			return cc, nil
 		},
 		TestOnBorrow: func(c redis.Conn, t time.Time) error {
 			if time.Since(t) < time.Minute {
@@ -91,46 +199,99 @@ func NewClient(addr, passwd, namespace string) (*Client, error) {
 			return err
 		},
 	}
+}
 
-	client := &Client{
-		pool:      pool,
-		namespace: namespace,
-	}
-	// save the current utilization of the namespace in the client object
-	// this is then used during the lifetime of the client
-	// to allow different sorting algorithms in ShardIterator
-	ns, err := client.GetNamespace()
-	if err != nil {
-		return nil, err
-	}
-	client.utilization = ns.Used
-	return client, nil
+// SetKeyMode sets the mode used to define the keys of created objects,
+// datastor.KeyModeSequential being used by default.
+// It should be called prior to using the client.
+//
+// In datastor.KeyModeUser the key of an object is derived from the chunk it is part of
+// (see UserKey), which requires the namespace to be in user-key mode.
+// Creating an object which is already stored is a no-op,
+// such that retried calls never leave an orphan object behind.
+func (c *Client) SetKeyMode(mode datastor.KeyMode) {
+	c.keyMode = mode
+}
+
+// KeyMode implements datastor.KeyedClient.KeyMode
+func (c *Client) KeyMode() datastor.KeyMode {
+	return c.keyMode
+}
+
+// UserKey returns the key of the object which stores the part with the given index
+// of the chunk with the given hash, as used when creating objects in user-key mode.
+//
+// The hash is expected to be the hash of the chunk data as it is stored (e.g. encrypted),
+// such that objects with the same key are guaranteed to be identical,
+// even when a chunk is processed differently for each write.
+func UserKey(chunkHash []byte, part int) []byte {
+	key := make([]byte, len(chunkHash)+4)
+	copy(key, chunkHash)
+	binary.BigEndian.PutUint32(key[len(chunkHash):], uint32(part))
+	return key
 }
 
 // CreateObject implements datastor.Client.CreateObject
 func (c *Client) CreateObject(data []byte) (key []byte, err error) {
-	conn := c.pool.Get()
-	defer conn.Close()
+	return c.CreateObjectContext(context.Background(), data)
+}
 
-	key, err = redis.Bytes(conn.Do("SET", dummyKey, data))
+// CreateObjectContext implements datastor.Client.CreateObjectContext
+//
+// In user-key mode the object is considered to be the only part of a chunk,
+// its key being derived from the hash of the given data.
+func (c *Client) CreateObjectContext(ctx context.Context, data []byte) (key []byte, err error) {
+	var chunkHash []byte
+	if c.keyMode == datastor.KeyModeUser {
+		sum := blake2b.Sum256(data)
+		chunkHash = sum[:]
+	}
+	return c.CreateObjectPartContext(ctx, chunkHash, 0, data)
+}
+
+// CreateObjectPartContext implements datastor.KeyedClient.CreateObjectPartContext
+func (c *Client) CreateObjectPartContext(ctx context.Context, chunkHash []byte, part int, data []byte) (key []byte, err error) {
+	if c.keyMode == datastor.KeyModeUser {
+		key = UserKey(chunkHash, part)
+		_, err = redis.Bytes(c.do(ctx, "SET", key, data))
+		if err == redis.ErrNil {
+			// 0-db doesn't write an object which is already stored as is,
+			// which is the case when the call got retried after the object was stored,
+			// or when the same chunk part was stored already for other metadata
+			err = nil
+		}
+	} else {
+		// the server generates a new key for each attempt,
+		// such that a retried call would store the object twice in case only its reply got lost
+		key, err = redis.Bytes(c.doOnce(ctx, "SET", dummyKey, data))
+	}
 	if err != nil {
 		if err.Error() == "No space left on this namespace" {
 			err = datastor.ErrNamespaceFull
 		}
-		return key, err
+		return nil, err
 	}
-	c.muUtilization.Lock()
-	c.utilization += int64(len(data))
-	c.muUtilization.Unlock()
+	// keep the cached namespace up to date, until it is refreshed
+	c.nsMux.Lock()
+	c.cachedNS.Used += int64(len(data))
+	if c.cachedNS.Free > 0 {
+		c.cachedNS.Free -= int64(len(data))
+		if c.cachedNS.Free < 0 {
+			c.cachedNS.Free = 0
+		}
+	}
+	c.nsMux.Unlock()
 	return key, nil
 }
 
 // GetObject implements datastor.Client.GetObject
 func (c *Client) GetObject(key []byte) (*datastor.Object, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
+	return c.GetObjectContext(context.Background(), key)
+}
 
-	data, err := redis.Bytes(conn.Do("GET", key))
+// GetObjectContext implements datastor.Client.GetObjectContext
+func (c *Client) GetObjectContext(ctx context.Context, key []byte) (*datastor.Object, error) {
+	data, err := redis.Bytes(c.do(ctx, "GET", key))
 	if err != nil {
 		if err == redis.ErrNil {
 			return nil, datastor.ErrKeyNotFound
@@ -146,19 +307,23 @@ func (c *Client) GetObject(key []byte) (*datastor.Object, error) {
 
 // DeleteObject implements datastor.Client.DeleteObject
 func (c *Client) DeleteObject(key []byte) error {
-	conn := c.pool.Get()
-	defer conn.Close()
+	return c.DeleteObjectContext(context.Background(), key)
+}
 
-	_, err := conn.Do("DEL", key)
+// DeleteObjectContext implements datastor.Client.DeleteObjectContext
+func (c *Client) DeleteObjectContext(ctx context.Context, key []byte) error {
+	_, err := c.do(ctx, "DEL", key)
 	return err
 }
 
 // GetObjectStatus implements datastor.Client.GetObjectStatus
 func (c *Client) GetObjectStatus(key []byte) (datastor.ObjectStatus, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
+	return c.GetObjectStatusContext(context.Background(), key)
+}
 
-	status, err := redis.Int(conn.Do("CHECK", key))
+// GetObjectStatusContext implements datastor.Client.GetObjectStatusContext
+func (c *Client) GetObjectStatusContext(ctx context.Context, key []byte) (datastor.ObjectStatus, error) {
+	status, err := redis.Int(c.do(ctx, "CHECK", key))
 	if err != nil {
 		return 0, err
 	}
@@ -174,10 +339,12 @@ func (c *Client) GetObjectStatus(key []byte) (datastor.ObjectStatus, error) {
 
 // ExistObject implements datastor.Client.ExistObject
 func (c *Client) ExistObject(key []byte) (bool, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
+	return c.ExistObjectContext(context.Background(), key)
+}
 
-	exist, err := redis.Int(conn.Do("EXIST", key))
+// ExistObjectContext implements datastor.Client.ExistObjectContext
+func (c *Client) ExistObjectContext(ctx context.Context, key []byte) (bool, error) {
+	exist, err := redis.Int(c.do(ctx, "EXIST", key))
 	if err != nil {
 		return false, err
 	}
@@ -185,24 +352,130 @@ func (c *Client) ExistObject(key []byte) (bool, error) {
 }
 
 // ListObjectKeyIterator implements datastor.Client.ListObjectKeyIterator
-// TODO: depend on 	https://github.com/threefoldtech/0-db/issues/6
+//
+// The keys are walked using the SCAN command of 0-db,
+// fetching a batch of keys at a time.
+// The iterator stops as soon as the given context is done.
 func (c *Client) ListObjectKeyIterator(ctx context.Context) (<-chan datastor.ObjectKeyResult, error) {
-	return nil, fmt.Errorf("not supported")
+	if ctx == nil {
+		return nil, errNilContext
+	}
+	// fetch the first batch prior to starting the goroutine,
+	// such that static errors are returned directly
+	cursor, entries, err := c.scan(ctx, nil)
+	if err != nil {
+		if err == errNoMoreData {
+			ch := make(chan datastor.ObjectKeyResult)
+			close(ch)
+			return ch, nil
+		}
+		return nil, err
+	}
+
+	ch := make(chan datastor.ObjectKeyResult, len(entries))
+	go func() {
+		defer close(ch)
+		for {
+			for _, entry := range entries {
+				select {
+				case ch <- entry:
+				case <-ctx.Done():
+					return
+				}
+			}
+			cursor, entries, err = c.scan(ctx, cursor)
+			if err != nil {
+				if err == errNoMoreData {
+					return
+				}
+				select {
+				case ch <- datastor.ObjectKeyResult{Error: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// scan fetches the next batch of object keys,
+// starting after the given cursor, or from the start if no cursor is given.
+// errNoMoreData is returned in case all keys have been walked.
+func (c *Client) scan(ctx context.Context, cursor []byte) ([]byte, []datastor.ObjectKeyResult, error) {
+	var args []interface{}
+	if cursor != nil {
+		args = append(args, cursor)
+	}
+	reply, err := redis.Values(c.do(ctx, "SCAN", args...))
+	if err != nil {
+		if err.Error() == "No more data" {
+			return nil, nil, errNoMoreData
+		}
+		return nil, nil, err
+	}
+	if len(reply) != 2 {
+		return nil, nil, fmt.Errorf("invalid SCAN reply: expected 2 elements, received %d", len(reply))
+	}
+	cursor, err = redis.Bytes(reply[0], nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid SCAN cursor: %v", err)
+	}
+	rawEntries, err := redis.Values(reply[1], nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid SCAN entries: %v", err)
+	}
+
+	entries := make([]datastor.ObjectKeyResult, 0, len(rawEntries))
+	for _, rawEntry := range rawEntries {
+		values, err := redis.Values(rawEntry, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid SCAN entry: %v", err)
+		}
+		var entry datastor.ObjectKeyResult
+		_, err = redis.Scan(values, &entry.Key, &entry.Size, &entry.Timestamp)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid SCAN entry: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+	return cursor, entries, nil
 }
 
 // GetNamespace implements datastor.Client.GetNamespace
 func (c *Client) GetNamespace() (*datastor.Namespace, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
+	return c.GetNamespaceContext(context.Background())
+}
 
-	infoStr, err := redis.String(conn.Do("NSINFO", c.namespace))
+// GetNamespaceContext implements datastor.Client.GetNamespaceContext
+//
+// The returned information is cached by the client as well.
+func (c *Client) GetNamespaceContext(ctx context.Context) (*datastor.Namespace, error) {
+	infoStr, err := redis.String(c.do(ctx, "NSINFO", c.namespace))
 	if err != nil {
 		return nil, err
 	}
+	ns, err := parseNamespaceInfo(infoStr)
+	if err != nil {
+		return nil, err
+	}
+
+	c.nsMux.Lock()
+	c.cachedNS = *ns
+	c.nsMux.Unlock()
+	return ns, nil
+}
 
+// parseNamespaceInfo parses the information of a namespace,
+// as returned by the NSINFO command.
+func parseNamespaceInfo(infoStr string) (*datastor.Namespace, error) {
 	var ns datastor.Namespace
 	var health datastor.Health
 	ns.Health = &health
+	var (
+		limit int64
+		err   error
+	)
 
 	for _, info := range strings.Split(infoStr, "\n") {
 		elems := strings.Split(info, ":")
@@ -231,6 +504,11 @@ func (c *Client) GetNamespace() (*datastor.Namespace, error) {
 				return nil, err
 			}
 			ns.Free = free
+		case "data_limits_bytes":
+			limit, err = strconv.ParseInt(val, 10, 64)
+			if err != nil {
+				return nil, err
+			}
 		case "stats_index_io_errors":
 			count, err := strconv.ParseInt(val, 10, 64)
 			if err != nil {
@@ -271,13 +549,36 @@ func (c *Client) GetNamespace() (*datastor.Namespace, error) {
 			health.DataIOErrorLast = timestamp
 		}
 	}
-
+	if limit > 0 {
+		// the free space of a limited namespace is bound by its limit
+		free := limit - ns.Used
+		if free < 0 {
+			free = 0
+		}
+		if ns.Free <= 0 || free < ns.Free {
+			ns.Free = free
+		}
+	}
 	return &ns, nil
 }
 
+// CachedNamespace implements datastor.Client.CachedNamespace
+func (c *Client) CachedNamespace() *datastor.Namespace {
+	c.nsMux.Lock()
+	defer c.nsMux.Unlock()
+	ns := c.cachedNS
+	if ns.Health != nil {
+		health := *ns.Health
+		ns.Health = &health
+	}
+	return &ns
+}
+
 // Utilization returns the amount of storage used by the namespace
 func (c *Client) Utilization() int64 {
-	return c.utilization
+	c.nsMux.Lock()
+	defer c.nsMux.Unlock()
+	return c.cachedNS.Used
 }
 
 // Close implements datastor.Client.Close
@@ -285,10 +586,169 @@ func (c *Client) Close() error {
 	return c.pool.Close()
 }
 
+// do executes a single command on a pooled connection,
+// retrying it in case it failed with a retryable error,
+// and reporting the result of each attempt to the observer of the client, if any.
+func (c *Client) do(ctx context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	for attempt := 0; ; attempt++ {
+		reply, err := c.doObserved(ctx, cmd, args...)
+		if attempt >= c.cfg.Retry.MaxRetries || !datastor.IsRetryableError(err) {
+			return reply, err
+		}
+		backoff := c.backoff(attempt)
+		log.WithError(err).Debugf("zerodb: retrying %s command in %v", cmd, backoff)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// doOnce executes a command which isn't idempotent,
+// and therefore is never retried.
+func (c *Client) doOnce(ctx context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	return c.doObserved(ctx, cmd, args...)
+}
+
+// backoff returns the time to wait prior to retrying a command,
+// which failed the given amount of attempts before.
+func (c *Client) backoff(attempt int) time.Duration {
+	backoff := c.cfg.Retry.InitialBackoff
+	for i := 0; i < attempt && backoff < c.cfg.Retry.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.cfg.Retry.MaxBackoff {
+		backoff = c.cfg.Retry.MaxBackoff
+	}
+	// subtract a random jitter of up to half of the backoff,
+	// such that clients don't retry all at once
+	return backoff - time.Duration(rand.Int63n(int64(backoff)/2+1))
+}
+
+// doObserved executes a single command on a pooled connection,
+// reporting its result to the observer of the client, if any.
+func (c *Client) doObserved(ctx context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	if c.observe == nil {
+		return c.doCommand(ctx, cmd, args...)
+	}
+	start := time.Now()
+	reply, err := c.doCommand(ctx, cmd, args...)
+	c.observe(time.Since(start), err)
+	return reply, err
+}
+
+// doCommand executes a single command on a pooled connection,
+// returning early with the context's error when it gets cancelled,
+// in which case the connection is closed and discarded, rather than reused.
+// The deadline of the context (if any) is used as the read timeout,
+// in case it expires before the configured read timeout would.
+func (c *Client) doCommand(ctx context.Context, cmd string, args ...interface{}) (interface{}, error) {
+	if ctx == nil {
+		return nil, errNilContext
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+
+	timeout := c.cfg.ReadTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		untilDeadline := time.Until(deadline)
+		if untilDeadline <= 0 {
+			conn.Close()
+			return nil, context.DeadlineExceeded
+		}
+		if timeout == 0 || untilDeadline < timeout {
+			timeout = untilDeadline
+		}
+	}
+
+	// the context is passed as the last argument,
+	// such that the pooled connection can be closed when it is done (see cancelableConn)
+	reply, err := redis.DoWithTimeout(conn, timeout, cmd, append(args, commandContext{ctx})...)
+	conn.Close()
+	if err != nil && ctx.Err() != nil {
+		// the command got interrupted, or the read timed out because of the context's deadline
+		return nil, ctx.Err()
+	}
+	return reply, err
+}
+
+// commandContext is passed as the last argument of a command,
+// in order to pass its context to the cancelableConn it is executed on.
+type commandContext struct {
+	ctx context.Context
+}
+
+// cancelableConn wraps a connection created by the pool,
+// closing it as soon as the context of a command (see commandContext) is done,
+// such that a command blocked on an unresponsive server returns immediately.
+// A closed connection isn't returned to the pool, but discarded,
+// freeing its place in the pool for other commands.
+type cancelableConn struct {
+	redis.Conn
+}
+
+// DoWithTimeout implements redis.ConnWithTimeout.DoWithTimeout
+func (c cancelableConn) DoWithTimeout(timeout time.Duration, cmd string, args ...interface{}) (interface{}, error) {
+	n := len(args)
+	if n == 0 {
+		return redis.DoWithTimeout(c.Conn, timeout, cmd, args...)
+	}
+	cmdCtx, ok := args[n-1].(commandContext)
+	if !ok {
+		return redis.DoWithTimeout(c.Conn, timeout, cmd, args...)
+	}
+	stop := c.closeOnDone(cmdCtx.ctx)
+	reply, err := redis.DoWithTimeout(c.Conn, timeout, cmd, args[:n-1]...)
+	stop()
+	return reply, err
+}
+
+// closeOnDone closes the connection as soon as the given context is done,
+// until the returned function is called. Once that function returns,
+// the connection is known to be either closed or no longer watched.
+func (c cancelableConn) closeOnDone(ctx context.Context) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			c.Conn.Close()
+		case <-done:
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// ReceiveWithTimeout implements redis.ConnWithTimeout.ReceiveWithTimeout
+func (c cancelableConn) ReceiveWithTimeout(timeout time.Duration) (interface{}, error) {
+	return redis.ReceiveWithTimeout(c.Conn, timeout)
+}
+
 const (
 	dummyKey = ""
 )
 
 var (
-	_ datastor.Client = (*Client)(nil)
+	errNilContext = errors.New("zerodb: nil context")
+	errNoMoreData = errors.New("zerodb: no more data")
+)
+
+var (
+	_ datastor.Client      = (*Client)(nil)
+	_ datastor.KeyedClient = (*Client)(nil)
 )
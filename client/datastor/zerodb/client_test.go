@@ -17,9 +17,21 @@
 package zerodb
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
 	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
@@ -38,7 +50,7 @@ func TestRoundTrip(t *testing.T) {
 	defer cleanup()
 
 	// create client
-	c, err := NewClient(addr, "mypasswd", namespace)
+	c, err := NewClient(addr, "mypasswd", namespace, nil)
 	require.NoError(err)
 
 	// create object
@@ -82,14 +94,534 @@ func TestRoundTrip(t *testing.T) {
 	require.Equal(datastor.ObjectStatusMissing, status)
 }
 
+func TestClientContext(t *testing.T) {
+	const namespace = "ns"
+	require := require.New(t)
+
+	// create server
+	_, addr, cleanup, err := zdbtest.NewInMem0DBServer(namespace)
+	require.NoError(err)
+	defer cleanup()
+
+	// create client
+	c, err := NewClient(addr, "", namespace, nil)
+	require.NoError(err)
+	defer c.Close()
+
+	key, err := c.CreateObject([]byte("data"))
+	require.NoError(err)
+
+	// a cancelled context should abort the command
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.CreateObjectContext(ctx, []byte("data"))
+	require.Equal(context.Canceled, err)
+	_, err = c.GetObjectContext(ctx, key)
+	require.Equal(context.Canceled, err)
+	_, err = c.GetNamespaceContext(ctx)
+	require.Equal(context.Canceled, err)
+
+	// an expired deadline should abort the command as well
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	err = c.DeleteObjectContext(ctx, key)
+	require.Equal(context.DeadlineExceeded, err)
+	_, err = c.ExistObjectContext(ctx, key)
+	require.Equal(context.DeadlineExceeded, err)
+
+	// a deadline in the future should not prevent the command from succeeding
+	ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	obj, err := c.GetObjectContext(ctx, key)
+	require.NoError(err)
+	require.Equal([]byte("data"), obj.Data)
+
+	// a nil context is not allowed
+	_, err = c.GetObjectContext(nil, key)
+	require.Error(err)
+}
+
+func TestClientListObjectKeyIterator(t *testing.T) {
+	const namespace = "ns"
+	require := require.New(t)
+
+	// create server
+	_, addr, cleanup, err := zdbtest.NewInMem0DBServer(namespace)
+	require.NoError(err)
+	defer cleanup()
+
+	// create client
+	c, err := NewClient(addr, "", namespace, nil)
+	require.NoError(err)
+	defer c.Close()
+
+	// an empty namespace has no keys
+	ch, err := c.ListObjectKeyIterator(context.Background())
+	require.NoError(err)
+	for range ch {
+		t.Fatal("no keys expected")
+	}
+
+	// store more objects than can be returned in a single scan
+	objects := make(map[string]int64)
+	for i := 0; i < 50; i++ {
+		data := []byte(fmt.Sprintf("data-%d", i*i))
+		key, err := c.CreateObject(data)
+		require.NoError(err)
+		objects[string(key)] = int64(len(data))
+	}
+	// delete a couple of them
+	for i := 1; i <= 5; i++ {
+		key := fmt.Sprintf("key-%d", i*7)
+		require.NoError(c.DeleteObject([]byte(key)))
+		delete(objects, key)
+	}
+
+	ch, err = c.ListObjectKeyIterator(context.Background())
+	require.NoError(err)
+	listed := make(map[string]int64)
+	for result := range ch {
+		require.NoError(result.Error)
+		require.NotContains(listed, string(result.Key))
+		require.NotZero(result.Timestamp)
+		listed[string(result.Key)] = result.Size
+	}
+	require.Equal(objects, listed)
+
+	// a cancelled context stops the iterator
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err = c.ListObjectKeyIterator(ctx)
+	require.NoError(err)
+	<-ch
+	cancel()
+	count := 1
+	for range ch {
+		count++
+	}
+	require.True(count < len(objects))
+
+	// a nil context is not allowed
+	_, err = c.ListObjectKeyIterator(nil)
+	require.Error(err)
+}
+
+func TestClientTLS(t *testing.T) {
+	const namespace = "ns"
+
+	dir, err := ioutil.TempDir("", "0-stor-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certs, err := zdbtest.NewTLSCertificates(dir)
+	require.NoError(t, err)
+
+	caPEM, err := ioutil.ReadFile(certs.CAFile)
+	require.NoError(t, err)
+	rootCAs := x509.NewCertPool()
+	require.True(t, rootCAs.AppendCertsFromPEM(caPEM))
+	clientCert, err := tls.LoadX509KeyPair(certs.ClientCertFile, certs.ClientKeyFile)
+	require.NoError(t, err)
+
+	t.Run("TLS", func(t *testing.T) {
+		require := require.New(t)
+
+		serverConfig, err := certs.ServerTLSConfig(false)
+		require.NoError(err)
+		_, addr, cleanup, err := zdbtest.NewInMem0DBServerTLS(namespace, serverConfig)
+		require.NoError(err)
+		defer cleanup()
+
+		// a plain connection should fail
+		_, err = NewClient(addr, "", namespace, nil)
+		require.Error(err)
+
+		c, err := NewClient(addr, "", namespace, &tls.Config{
+			ServerName: zdbtest.TLSServerName,
+			RootCAs:    rootCAs,
+		})
+		require.NoError(err)
+		defer c.Close()
+
+		key, err := c.CreateObject([]byte("data"))
+		require.NoError(err)
+		obj, err := c.GetObject(key)
+		require.NoError(err)
+		require.Equal([]byte("data"), obj.Data)
+	})
+
+	t.Run("MutualTLS", func(t *testing.T) {
+		require := require.New(t)
+
+		serverConfig, err := certs.ServerTLSConfig(true)
+		require.NoError(err)
+		_, addr, cleanup, err := zdbtest.NewInMem0DBServerTLS(namespace, serverConfig)
+		require.NoError(err)
+		defer cleanup()
+
+		// without a client certificate the server should refuse the connection
+		_, err = NewClient(addr, "", namespace, &tls.Config{
+			ServerName: zdbtest.TLSServerName,
+			RootCAs:    rootCAs,
+		})
+		require.Error(err)
+
+		c, err := NewClient(addr, "", namespace, &tls.Config{
+			ServerName:   zdbtest.TLSServerName,
+			RootCAs:      rootCAs,
+			Certificates: []tls.Certificate{clientCert},
+		})
+		require.NoError(err)
+		defer c.Close()
+
+		key, err := c.CreateObject([]byte("data"))
+		require.NoError(err)
+		obj, err := c.GetObject(key)
+		require.NoError(err)
+		require.Equal([]byte("data"), obj.Data)
+	})
+}
+
 func TestNewClientPanics(t *testing.T) {
 	require := require.New(t)
 
-	client, err := NewClient("", "", "")
+	client, err := NewClient("", "", "", nil)
 	require.Error(err, "no address given")
 	require.Nil(client)
 
-	client, err = NewClient("foo", "", "")
+	client, err = NewClient("foo", "", "", nil)
 	require.Error(err, "no namespace given")
 	require.Nil(client)
 }
+
+func TestSanitizeConnectionConfig(t *testing.T) {
+	require := require.New(t)
+
+	cfg := sanitizeConnectionConfig(datastor.ConnectionConfig{})
+	require.Equal(datastor.DefaultMaxActive, cfg.MaxActive)
+	require.Equal(datastor.DefaultMaxActive, cfg.MaxIdle)
+	require.Equal(datastor.DefaultConnectTimeout, cfg.ConnectTimeout)
+	require.Equal(datastor.DefaultReadTimeout, cfg.ReadTimeout)
+	require.Equal(datastor.DefaultWriteTimeout, cfg.WriteTimeout)
+	require.Equal(datastor.DefaultMaxRetries, cfg.Retry.MaxRetries)
+
+	cfg = sanitizeConnectionConfig(datastor.ConnectionConfig{
+		MaxActive:   -1,
+		ReadTimeout: -1,
+		Retry:       datastor.RetryConfig{MaxRetries: -1},
+	})
+	require.Equal(0, cfg.MaxActive, "unlimited")
+	require.Equal(time.Duration(0), cfg.ReadTimeout, "disabled")
+	require.Equal(0, cfg.Retry.MaxRetries, "disabled")
+}
+
+func TestClientBackoff(t *testing.T) {
+	require := require.New(t)
+
+	c := &Client{cfg: sanitizeConnectionConfig(datastor.ConnectionConfig{
+		Retry: datastor.RetryConfig{
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     time.Second,
+		},
+	})}
+	for attempt, expected := range []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	} {
+		for i := 0; i < 16; i++ {
+			backoff := c.backoff(attempt)
+			require.True(backoff <= expected, "%v > %v", backoff, expected)
+			require.True(backoff >= expected/2, "%v < %v", backoff, expected/2)
+		}
+	}
+}
+
+func TestClientUserKeys(t *testing.T) {
+	const namespace = "ns"
+	require := require.New(t)
+
+	server, addr, cleanup, err := zdbtest.NewInMem0DBServer(namespace)
+	require.NoError(err)
+	defer cleanup()
+
+	ctx := context.Background()
+	admin, err := NewAdminClient(addr, "", nil, datastor.ConnectionConfig{})
+	require.NoError(err)
+	defer admin.Close()
+	require.NoError(admin.SetNamespaceKeyMode(ctx, namespace, datastor.KeyModeUser))
+
+	c, err := NewClient(addr, "", namespace, nil)
+	require.NoError(err)
+	defer c.Close()
+
+	// a user-key namespace doesn't generate keys
+	_, err = c.CreateObjectContext(ctx, []byte("foo"))
+	require.Error(err)
+
+	c.SetKeyMode(datastor.KeyModeUser)
+	key, err := c.CreateObjectContext(ctx, []byte("foo"))
+	require.NoError(err)
+	sum := blake2b.Sum256([]byte("foo"))
+	require.Equal(UserKey(sum[:], 0), key)
+
+	// creating the same object again is a no-op
+	again, err := c.CreateObjectContext(ctx, []byte("foo"))
+	require.NoError(err)
+	require.Equal(key, again)
+	require.Len(server.Keys(), 1)
+
+	// the parts of a chunk are stored under the chunk hash and their index
+	chunkHash := bytes.Repeat([]byte{1}, 32)
+	other, err := c.CreateObjectPartContext(ctx, chunkHash, 1, []byte("bar"))
+	require.NoError(err)
+	require.Equal(UserKey(chunkHash, 1), other)
+	require.NotEqual(UserKey(chunkHash, 0), other)
+	require.Len(server.Keys(), 2)
+
+	obj, err := c.GetObjectContext(ctx, other)
+	require.NoError(err)
+	require.Equal([]byte("bar"), obj.Data)
+
+	// user keys are listed in order of creation
+	ch, err := c.ListObjectKeyIterator(ctx)
+	require.NoError(err)
+	var listed [][]byte
+	for result := range ch {
+		require.NoError(result.Error)
+		listed = append(listed, result.Key)
+	}
+	require.Equal([][]byte{key, other}, listed)
+
+	// the mode of a namespace can't be changed once it contains data
+	require.Error(admin.SetNamespaceKeyMode(ctx, namespace, datastor.KeyModeSequential))
+}
+
+func TestClientUserKeysRetry(t *testing.T) {
+	const namespace = "ns"
+	require := require.New(t)
+
+	server, addr, cleanup, err := zdbtest.NewInMem0DBServer(namespace)
+	require.NoError(err)
+	defer cleanup()
+	admin, err := NewAdminClient(addr, "", nil, datastor.ConnectionConfig{})
+	require.NoError(err)
+	defer admin.Close()
+	require.NoError(admin.SetNamespaceKeyMode(context.Background(), namespace, datastor.KeyModeUser))
+
+	proxy, err := newResetProxy(addr)
+	require.NoError(err)
+	defer proxy.Close()
+
+	c, err := NewClientWithConfig(proxy.Address(), "", namespace, nil, datastor.ConnectionConfig{
+		MaxActive: 1,
+		Retry:     datastor.RetryConfig{MaxRetries: 1, InitialBackoff: time.Millisecond},
+	})
+	require.NoError(err)
+	defer c.Close()
+	c.SetKeyMode(datastor.KeyModeUser)
+
+	// drop the reply of the stored object, such that the call is retried
+	proxy.DropReply()
+	key, err := c.CreateObject([]byte("data"))
+	require.NoError(err)
+	require.Equal([]string{string(key)}, server.Keys(), "retried writes shouldn't create duplicates")
+}
+
+func TestClientRetry(t *testing.T) {
+	const namespace = "ns"
+	require := require.New(t)
+
+	_, addr, cleanup, err := zdbtest.NewInMem0DBServer(namespace)
+	require.NoError(err)
+	defer cleanup()
+	proxy, err := newResetProxy(addr)
+	require.NoError(err)
+	defer proxy.Close()
+
+	test := func(maxRetries int) error {
+		c, err := NewClientWithConfig(proxy.Address(), "", namespace, nil, datastor.ConnectionConfig{
+			MaxActive: 1,
+			Retry:     datastor.RetryConfig{MaxRetries: maxRetries, InitialBackoff: time.Millisecond},
+		})
+		require.NoError(err)
+		defer c.Close()
+
+		// reset the pooled connection, such that the next call fails
+		proxy.Reset()
+		_, err = c.ExistObject([]byte("key"))
+		return err
+	}
+
+	require.NoError(test(1), "the call should be retried on a new connection")
+	err = test(-1)
+	require.Error(err, "the call shouldn't be retried")
+	require.True(datastor.IsRetryableError(err))
+}
+
+func TestClientCreateObjectNoRetry(t *testing.T) {
+	const namespace = "ns"
+	require := require.New(t)
+
+	server, addr, cleanup, err := zdbtest.NewInMem0DBServer(namespace)
+	require.NoError(err)
+	defer cleanup()
+	proxy, err := newResetProxy(addr)
+	require.NoError(err)
+	defer proxy.Close()
+
+	c, err := NewClientWithConfig(proxy.Address(), "", namespace, nil, datastor.ConnectionConfig{
+		MaxActive: 1,
+		Retry:     datastor.RetryConfig{MaxRetries: 2, InitialBackoff: time.Millisecond},
+	})
+	require.NoError(err)
+	defer c.Close()
+
+	// the object is stored, but its reply got lost
+	proxy.DropReply()
+	_, err = c.CreateObject([]byte("data"))
+	require.Error(err, "a sequential create shouldn't be retried")
+	require.Len(server.Keys(), 1, "the object shouldn't be stored twice")
+}
+
+func TestClientContextStuckServer(t *testing.T) {
+	const namespace = "ns"
+	require := require.New(t)
+
+	_, addr, cleanup, err := zdbtest.NewInMem0DBServer(namespace)
+	require.NoError(err)
+	defer cleanup()
+	proxy, err := newResetProxy(addr)
+	require.NoError(err)
+	defer proxy.Close()
+
+	c, err := NewClientWithConfig(proxy.Address(), "", namespace, nil, datastor.ConnectionConfig{
+		MaxActive:   1,
+		ReadTimeout: time.Minute,
+	})
+	require.NoError(err)
+	defer c.Close()
+
+	// cancelled commands waiting for replies which never arrive
+	// shouldn't keep their connections out of the pool
+	for i := 0; i < 3; i++ {
+		proxy.StallReply()
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		_, err = c.ExistObjectContext(ctx, []byte("key"))
+		cancel()
+		require.Equal(context.DeadlineExceeded, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	exists, err := c.ExistObjectContext(ctx, []byte("key"))
+	require.NoError(err)
+	require.False(exists)
+}
+
+// resetProxy forwards connections to a server,
+// and allows to reset all forwarded connections at once.
+type resetProxy struct {
+	net.Listener
+	target string
+
+	mux        sync.Mutex
+	conns      []net.Conn
+	dropReply  bool
+	stallReply bool
+}
+
+func newResetProxy(target string) (*resetProxy, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	proxy := &resetProxy{Listener: listener, target: target}
+	go proxy.serve()
+	return proxy, nil
+}
+
+func (p *resetProxy) Address() string {
+	return p.Listener.Addr().String()
+}
+
+func (p *resetProxy) serve() {
+	for {
+		conn, err := p.Accept()
+		if err != nil {
+			return
+		}
+		server, err := net.Dial("tcp", p.target)
+		if err != nil {
+			conn.Close()
+			continue
+		}
+		p.mux.Lock()
+		p.conns = append(p.conns, conn, server)
+		p.mux.Unlock()
+		go io.Copy(server, conn)
+		go p.reply(conn, server)
+	}
+}
+
+// reply forwards the replies of the server,
+// dropping the connection instead of forwarding a reply, when requested.
+func (p *resetProxy) reply(conn, server net.Conn) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := server.Read(buf)
+		if err != nil {
+			conn.Close()
+			return
+		}
+		p.mux.Lock()
+		drop, stall := p.dropReply, p.stallReply
+		p.dropReply, p.stallReply = false, false
+		p.mux.Unlock()
+		if drop {
+			conn.Close()
+			server.Close()
+			return
+		}
+		if stall {
+			// the connection remains open, without ever receiving the reply
+			continue
+		}
+		if _, err = conn.Write(buf[:n]); err != nil {
+			server.Close()
+			return
+		}
+	}
+}
+
+// DropReply drops the next reply of the server,
+// closing the connection it was meant for, as if the reply got lost.
+func (p *resetProxy) DropReply() {
+	p.mux.Lock()
+	p.dropReply = true
+	p.mux.Unlock()
+}
+
+// StallReply holds back the next reply of the server,
+// keeping the connection it was meant for open, as if the server got stuck.
+func (p *resetProxy) StallReply() {
+	p.mux.Lock()
+	p.stallReply = true
+	p.mux.Unlock()
+}
+
+// Reset closes all forwarded connections.
+func (p *resetProxy) Reset() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	for _, conn := range p.conns {
+		conn.Close()
+	}
+	p.conns = nil
+}
+
+func (p *resetProxy) Close() error {
+	p.Reset()
+	return p.Listener.Close()
+}
@@ -17,10 +17,12 @@
 package zerodb
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -36,15 +38,107 @@ type Cluster struct {
 	unlistedMux   sync.Mutex
 	passwd        string
 	spreadingType datastor.SpreadingType
+	ring          *datastor.HashRing
+	weighted      bool // true if all listed shards define a weight
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// DefaultStatsInterval is the stats interval used,
+// in case no stats interval is defined in the ClusterConfig.
+const DefaultStatsInterval = time.Minute
+
+// ClusterConfig defines the configuration of a cluster.
+type ClusterConfig struct {
+	// Shards lists the shards of the cluster.
+	Shards []datastor.ShardConfig
+	// Password and Namespace are used for shards which don't define their own.
+	Password  string
+	Namespace string
+	// TLSConfig is optional, when given it is used for the connections to all shards.
+	TLSConfig *tls.Config
+	// Spreading defines the algorithm used to walk the shards.
+	Spreading datastor.SpreadingType
+
+	// Health defines how the health of the shards is tracked.
+	// A shard which fails too many consecutive calls is considered unhealthy,
+	// in which case it is no longer returned by GetShard, GetRandomShard and GetShardIterator,
+	// until a background probe, which checks all shards periodically, readmits it.
+	Health datastor.HealthConfig
+
+	// Connection defines the connection pool, timeouts and retry policy used for all shards,
+	// each shard can override it using the connection config of its ShardConfig.
+	Connection datastor.ConnectionConfig
+
+	// KeyMode defines how the keys of the objects created on the shards are defined,
+	// datastor.KeyModeUser requiring the namespaces of all shards to be in user-key mode.
+	// See Client.SetKeyMode for more information.
+	KeyMode datastor.KeyMode
+
+	// Provision defines, when enabled, that the namespaces of the listed shards
+	// are created (or updated), using the admin commands of their servers,
+	// prior to connecting to them. See ProvisionNamespaces for more information.
+	Provision datastor.ProvisionConfig
+
+	// StatsInterval defines the interval at which the namespace information
+	// of all shards is refreshed, as cached by their clients,
+	// and used by spreading algorithms such as least_used and most_free.
+	// DefaultStatsInterval is used in case it is zero,
+	// use a negative interval to disable refreshing.
+	StatsInterval time.Duration
 }
 
 // NewCluster creates a new cluster,
 // and pre-loading it with a client for each of the listed (and thus known) shards.
 // Unlisted shards's clients are also stored, bu those are loaded on the fly, only when needed.
+//
+// tlsConfig is optional, when given it is used for the connections to all shards.
+//
+// The health and namespace information of the shards are tracked using the default configuration,
+// see NewClusterFromConfig for more information.
 func NewCluster(addresses []datastor.ShardConfig, passwd, namespace string, tlsConfig *tls.Config, spreadingType datastor.SpreadingType) (*Cluster, error) {
+	return NewClusterFromConfig(ClusterConfig{
+		Shards:    addresses,
+		Password:  passwd,
+		Namespace: namespace,
+		TLSConfig: tlsConfig,
+		Spreading: spreadingType,
+	})
+}
+
+// NewClusterFromConfig creates a new cluster, just like NewCluster,
+// tracking the health and namespace information of its listed shards
+// as defined by the given config.
+func NewClusterFromConfig(cfg ClusterConfig) (*Cluster, error) {
+	var (
+		addresses     = cfg.Shards
+		passwd        = cfg.Password
+		namespace     = cfg.Namespace
+		tlsConfig     = cfg.TLSConfig
+		spreadingType = cfg.Spreading
+		healthCfg     = sanitizeHealthConfig(cfg.Health)
+		statsInterval = cfg.StatsInterval
+		connCfg       = cfg.Connection
+		keyMode       = cfg.KeyMode
+	)
+	if statsInterval == 0 {
+		statsInterval = DefaultStatsInterval
+	}
+
+	if cfg.Provision.Enabled {
+		err := ProvisionNamespaces(context.Background(), cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var (
 		listedShards = make(map[string]*Shard, len(addresses))
 		listedSlice  []*Shard
+		ringShards   []datastor.Shard
+		ringWeights  []float64
+		weighted     = len(addresses) > 0
 	)
 
 	or := func(a, b string) string {
@@ -57,42 +151,127 @@ func NewCluster(addresses []datastor.ShardConfig, passwd, namespace string, tlsC
 
 	for _, cfg := range addresses {
 
-		client, err := NewClient(cfg.Address, or(cfg.Password, passwd), or(cfg.Namespace, namespace))
+		client, err := NewClientWithConfig(cfg.Address, or(cfg.Password, passwd), or(cfg.Namespace, namespace),
+			tlsConfig, connCfg.Merge(cfg.Connection))
 		if err != nil {
 			return nil, err
 		}
+		client.SetKeyMode(keyMode)
 		shard := &Shard{
 			Client:    client,
 			address:   cfg.Address,
 			namespace: or(cfg.Namespace, namespace),
 			password:  or(cfg.Password, passwd),
+			topology:  cfg.Topology(),
+			weight:    cfg.Weight,
+		}
+		if cfg.Weight <= 0 {
+			weighted = false
 		}
+		shard.health = newShardHealth(shard.Identifier(), healthCfg)
+		client.observe = shard.health.record
 		listedShards[shard.Identifier()] = shard
 		listedSlice = append(listedSlice, shard)
+		ringShards = append(ringShards, shard)
+		ringWeights = append(ringWeights, cfg.Weight)
 	}
-	return &Cluster{
+	cluster := &Cluster{
 		namespace:     namespace,
 		listedShards:  listedShards,
 		listedSlice:   listedSlice,
 		passwd:        passwd,
 		spreadingType: spreadingType,
-	}, nil
+		ring:          datastor.NewHashRing(ringShards, ringWeights, 0),
+		weighted:      weighted,
+		done:          make(chan struct{}),
+	}
+	if healthCfg.ProbeInterval > 0 {
+		go cluster.probeShards(healthCfg.ProbeInterval)
+	}
+	if statsInterval > 0 {
+		go cluster.refreshStats(statsInterval)
+	}
+	return cluster, nil
+}
+
+// ProvisionNamespaces provisions the namespaces of all listed shards of the given config,
+// using the admin commands of their servers, whether or not provisioning is enabled in that config.
+// Namespaces are created in case they don't exist yet,
+// and the password, size limit and mode defined by the config are applied to all of them.
+func ProvisionNamespaces(ctx context.Context, cfg ClusterConfig) error {
+	for _, shardCfg := range cfg.Shards {
+		nsCfg := ShardNamespaceConfig(cfg, shardCfg)
+		admin, err := NewAdminClient(shardCfg.Address, cfg.Provision.AdminPassword,
+			cfg.TLSConfig, cfg.Connection.Merge(shardCfg.Connection))
+		if err != nil {
+			return fmt.Errorf("failed to provision namespace %s of shard %s: %v",
+				nsCfg.Name, shardCfg.Address, err)
+		}
+		created, err := admin.ProvisionNamespace(ctx, nsCfg)
+		admin.Close()
+		if err != nil {
+			return fmt.Errorf("failed to provision namespace %s of shard %s: %v",
+				nsCfg.Name, shardCfg.Address, err)
+		}
+		if created {
+			log.Infof("created namespace %s on shard %s", nsCfg.Name, shardCfg.Address)
+		}
+	}
+	return nil
+}
+
+// ShardNamespaceConfig returns the config of the namespace of the given shard,
+// as defined by the given cluster config, and the shard config overriding it.
+func ShardNamespaceConfig(cfg ClusterConfig, shardCfg datastor.ShardConfig) NamespaceConfig {
+	nsCfg := NamespaceConfig{
+		Name:     shardCfg.Namespace,
+		Password: shardCfg.Password,
+		MaxSize:  shardCfg.MaxSize,
+		Public:   cfg.Provision.Public,
+		KeyMode:  cfg.KeyMode,
+	}
+	if nsCfg.Name == "" {
+		nsCfg.Name = cfg.Namespace
+	}
+	if nsCfg.Password == "" {
+		nsCfg.Password = cfg.Password
+	}
+	if nsCfg.MaxSize == 0 {
+		nsCfg.MaxSize = cfg.Provision.MaxSize
+	}
+	return nsCfg
 }
 
 // GetShard implements datastor.Cluster.GetShard
 func (c *Cluster) GetShard(address string) (datastor.Shard, error) {
 	shard, ok := c.listedShards[address]
 	if ok {
+		if !shard.health.available() {
+			return nil, datastor.ErrShardUnhealthy
+		}
 		return shard, nil
 	}
 
 	return nil, fmt.Errorf("shard %s not found", address)
 }
 
+// GetListedShard implements datastor.ListedCluster.GetListedShard
+func (c *Cluster) GetListedShard(id string) (datastor.Shard, error) {
+	shard, ok := c.listedShards[id]
+	if !ok {
+		return nil, fmt.Errorf("shard %s not found", id)
+	}
+	return shard, nil
+}
+
 // GetRandomShard implements datastor.Cluster.GetRandomShard
 func (c *Cluster) GetRandomShard() (datastor.Shard, error) {
-	index := datastor.RandShardIndex(int64(len(c.listedSlice)))
-	return c.listedSlice[index], nil
+	available := c.filteredSlice(nil)
+	if len(available) == 0 {
+		return nil, datastor.ErrNoShardsAvailable
+	}
+	index := datastor.RandShardIndex(int64(len(available)))
+	return available[index], nil
 }
 
 // GetShardIterator implements datastor.Cluster.GetShardIterator
@@ -104,18 +283,181 @@ func (c *Cluster) GetShardIterator(exceptShards []string) datastor.ShardIterator
 		return datastor.NewRandomShardIterator(filtered)
 	case datastor.SpreadingTypeLeastUsed:
 		return datastor.NewLeastUsedShardIterator(filtered)
+	case datastor.SpreadingTypeMostFree:
+		return datastor.NewMostFreeShardIterator(filtered)
+	case datastor.SpreadingTypeConsistentHash:
+		return c.ringIterator(nil, filtered)
+	case datastor.SpreadingTypeWeightedRandom:
+		return datastor.NewWeightedRandomShardIterator(filtered, c.shardWeights(filtered))
 	default:
 		panic("unsupported spreading algorithm")
 	}
 }
 
+// GetSeededShardIterator implements datastor.SeededCluster.GetSeededShardIterator
+//
+// Only the consistent hash spreading algorithm makes use of the seed,
+// the iterator of any other algorithm is returned as is.
+func (c *Cluster) GetSeededShardIterator(seed []byte, exceptShards []string) datastor.ShardIterator {
+	if c.spreadingType != datastor.SpreadingTypeConsistentHash {
+		return c.GetShardIterator(exceptShards)
+	}
+	return c.ringIterator(seed, c.filteredSlice(exceptShards))
+}
+
+// shardWeights returns the weights of the given shards,
+// as used by the weighted random spreading algorithm.
+// The configured weights are used in case all listed shards define one,
+// otherwise the free space of their namespaces is used,
+// such that shards with more free space left receive more data, and full shards are used last.
+// All shards have an equal weight in case none of them reports its free space.
+func (c *Cluster) shardWeights(shards []datastor.Shard) []float64 {
+	weights := make([]float64, len(shards))
+	if c.weighted {
+		for index, shard := range shards {
+			weights[index] = shard.(*Shard).weight
+		}
+		return weights
+	}
+	var known bool
+	for index, shard := range shards {
+		if free := shard.CachedNamespace().Free; free > 0 {
+			weights[index] = float64(free)
+			known = true
+		}
+	}
+	if !known {
+		for index := range weights {
+			weights[index] = 1
+		}
+	}
+	return weights
+}
+
+// ringIterator walks the hash ring, starting from the given seed,
+// only returning the given shards.
+func (c *Cluster) ringIterator(seed []byte, shards []datastor.Shard) datastor.ShardIterator {
+	allowed := make(map[datastor.Shard]struct{}, len(shards))
+	for _, shard := range shards {
+		allowed[shard] = struct{}{}
+	}
+	return c.ring.ShardIterator(seed, func(shard datastor.Shard) bool {
+		_, ok := allowed[shard]
+		return ok
+	})
+}
+
 // ListedShardCount implements datastor.Cluster.ListedShardCount
 func (c *Cluster) ListedShardCount() int {
 	return len(c.listedSlice)
 }
 
+// GetShardHealth implements datastor.Cluster.GetShardHealth
+func (c *Cluster) GetShardHealth() []datastor.ShardHealth {
+	health := make([]datastor.ShardHealth, len(c.listedSlice))
+	for i, shard := range c.listedSlice {
+		health[i] = shard.health.status()
+	}
+	return health
+}
+
+// probeShards probes all listed shards at the given interval,
+// until the cluster is closed.
+func (c *Cluster) probeShards(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+		}
+
+		var wg sync.WaitGroup
+		for _, shard := range c.listedSlice {
+			wg.Add(1)
+			go func(shard *Shard) {
+				defer wg.Done()
+				c.probeShard(shard, interval)
+			}(shard)
+		}
+		wg.Wait()
+	}
+}
+
+// refreshStats refreshes the namespace information cached by the clients of all listed shards,
+// at the given interval, until the cluster is closed.
+// Unhealthy shards are skipped, as those are probed already.
+func (c *Cluster) refreshStats(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+		}
+
+		var wg sync.WaitGroup
+		for _, shard := range c.listedSlice {
+			if !shard.health.available() {
+				continue
+			}
+			wg.Add(1)
+			go func(shard *Shard) {
+				defer wg.Done()
+				ctx, cancel := c.closeContext(interval)
+				defer cancel()
+				_, err := shard.GetNamespaceContext(ctx)
+				if err != nil && ctx.Err() == nil {
+					log.Errorf("zerodb: failed to refresh the namespace of shard %s: %v",
+						shard.Identifier(), err)
+				}
+			}(shard)
+		}
+		wg.Wait()
+	}
+}
+
+// closeContext returns a context with the given timeout,
+// which is cancelled as well when the cluster is closed.
+func (c *Cluster) closeContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	go func() {
+		select {
+		case <-c.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// probeShard collects the namespace information of the given shard,
+// the result of which is recorded in the health of the shard,
+// readmitting the shard in case it responds again.
+func (c *Cluster) probeShard(shard *Shard, timeout time.Duration) {
+	ctx, cancel := c.closeContext(timeout)
+	defer cancel()
+
+	ns, err := shard.GetNamespaceContext(ctx)
+	if err == context.DeadlineExceeded {
+		// the client doesn't record deadlines as failures,
+		// as those are usually defined by the caller
+		shard.health.record(timeout, errProbeTimeout)
+		return
+	}
+	if err == nil {
+		shard.health.recordCounters(ns.Health)
+	}
+}
+
 // Close implements datastor.Cluster.Close
 func (c *Cluster) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+
 	c.unlistedMux.Lock()
 	defer c.unlistedMux.Unlock()
 
@@ -154,13 +496,19 @@ func (c *Cluster) filteredSlice(exceptShards []string) []datastor.Shard {
 	}
 
 	for _, shard := range c.listedSlice {
-		if _, ok := exceptMap[shard.Identifier()]; !ok {
-			filtered = append(filtered, shard)
+		if _, ok := exceptMap[shard.Identifier()]; ok {
+			continue
+		}
+		if !shard.health.available() {
+			continue
 		}
+		filtered = append(filtered, shard)
 	}
 	return filtered
 }
 
 var (
-	_ datastor.Cluster = (*Cluster)(nil)
+	_ datastor.Cluster       = (*Cluster)(nil)
+	_ datastor.SeededCluster = (*Cluster)(nil)
+	_ datastor.ListedCluster = (*Cluster)(nil)
 )
@@ -23,6 +23,7 @@ import (
 	mathRand "math/rand"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
 	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
@@ -52,6 +53,65 @@ func TestNewClusterImplicitErrors(t *testing.T) {
 	require.Nil(cluster)
 }
 
+func TestNewClusterProvision(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		servers []*zdbtest.InMem0DBServer
+		shards  []datastor.ShardConfig
+	)
+	for i := 0; i < 2; i++ {
+		server, addr, cleanup, err := zdbtest.NewInMem0DBServer("default")
+		require.NoError(err)
+		defer cleanup()
+		server.SetAdminPassword("admin")
+		servers = append(servers, server)
+		shards = append(shards, datastor.ShardConfig{Address: addr})
+	}
+	// the second shard overrides the global size limit
+	shards[1].MaxSize = 4096
+
+	cfg := ClusterConfig{
+		Shards:    shards,
+		Password:  "passwd",
+		Namespace: "ns",
+		Provision: datastor.ProvisionConfig{
+			Enabled:       true,
+			AdminPassword: "wrong",
+			MaxSize:       2048,
+		},
+	}
+	_, err := NewClusterFromConfig(cfg)
+	require.Error(err, "invalid admin password")
+
+	cfg.Provision.AdminPassword = "admin"
+	cluster, err := NewClusterFromConfig(cfg)
+	require.NoError(err)
+	defer cluster.Close()
+
+	limits := map[string]int64{
+		shards[0].Address: 2048,
+		shards[1].Address: 4096,
+	}
+	it := cluster.GetShardIterator(nil)
+	for it.Next() {
+		ns, err := it.Shard().GetNamespace()
+		require.NoError(err)
+		require.Equal("ns", ns.Label)
+		require.Equal(limits[it.Shard().Address()], ns.Free)
+	}
+
+	// provisioning the cluster again only updates the existing namespaces
+	cfg.Provision.MaxSize = 1024
+	require.NoError(ProvisionNamespaces(context.Background(), cfg))
+	admin, err := NewAdminClient(shards[0].Address, "admin", nil, datastor.ConnectionConfig{})
+	require.NoError(err)
+	defer admin.Close()
+	ns, err := admin.GetNamespace(context.Background(), "ns")
+	require.NoError(err)
+	require.Equal(int64(1024), ns.Free)
+}
+
 func TestGetShard(t *testing.T) {
 	require := require.New(t)
 	const (
@@ -158,6 +218,33 @@ func TestGetRandomShards(t *testing.T) {
 
 		test(t, cluster)
 	})
+
+	t.Run("most free", func(t *testing.T) {
+		cluster, clusterCleanup, err := newServerCluster(3, datastor.SpreadingTypeMostFree)
+		require.NoError(err)
+		defer clusterCleanup()
+		require.Equal(3, cluster.ListedShardCount())
+
+		test(t, cluster)
+	})
+
+	t.Run("consistent hash", func(t *testing.T) {
+		cluster, clusterCleanup, err := newServerCluster(3, datastor.SpreadingTypeConsistentHash)
+		require.NoError(err)
+		defer clusterCleanup()
+		require.Equal(3, cluster.ListedShardCount())
+
+		test(t, cluster)
+	})
+
+	t.Run("weighted random", func(t *testing.T) {
+		cluster, clusterCleanup, err := newServerCluster(3, datastor.SpreadingTypeWeightedRandom)
+		require.NoError(err)
+		defer clusterCleanup()
+		require.Equal(3, cluster.ListedShardCount())
+
+		test(t, cluster)
+	})
 }
 
 func TestGetRandomShardAsync(t *testing.T) {
@@ -438,6 +525,178 @@ func TestClusterSpread(t *testing.T) {
 		require.Equal(t, len(cluster.listedSlice), nrShards)
 	})
 
+	t.Run("most free", func(t *testing.T) {
+		servers, cluster, cleanup := spreadTestSetup(t)
+		defer cleanup()
+		// all namespaces are unlimited,
+		// and are thus spread by their utilization
+		cluster.spreadingType = datastor.SpreadingTypeMostFree
+		spreadTest(t, 4096, cluster.GetShardIterator)
+
+		used := make([]int, len(servers))
+		for i, server := range servers {
+			used[i] = server.ItemsSize()
+		}
+		sort.Ints(used)
+		difference := used[len(used)-1] - used[0]
+		fmt.Printf("biggest utilization difference: %d\n", difference)
+		require.True(t, difference <= 4096)
+	})
+
+}
+
+func TestClusterConsistentHash(t *testing.T) {
+	require := require.New(t)
+
+	var addresses []datastor.ShardConfig
+	for i := 0; i < 4; i++ {
+		_, addr, cleanup, err := zdbtest.NewInMem0DBServer("ns")
+		require.NoError(err)
+		defer cleanup()
+		addresses = append(addresses, datastor.ShardConfig{Address: addr, Weight: float64(i + 1)})
+	}
+	cluster, err := NewCluster(addresses, "", "ns", nil, datastor.SpreadingTypeConsistentHash)
+	require.NoError(err)
+	defer cluster.Close()
+
+	// the same shards, listed in another order
+	reversed := make([]datastor.ShardConfig, len(addresses))
+	for i, address := range addresses {
+		reversed[len(addresses)-1-i] = address
+	}
+	other, err := NewCluster(reversed, "", "ns", nil, datastor.SpreadingTypeConsistentHash)
+	require.NoError(err)
+	defer other.Close()
+
+	ids := func(it datastor.ShardIterator) []string {
+		var ids []string
+		for it.Next() {
+			ids = append(ids, it.Shard().Identifier())
+		}
+		return ids
+	}
+
+	counts := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		seed := []byte(fmt.Sprint("chunk", i))
+		walked := ids(cluster.GetSeededShardIterator(seed, nil))
+		require.Len(walked, 4)
+		require.Equal(walked, ids(cluster.GetSeededShardIterator(seed, nil)))
+		require.Equal(walked, ids(other.GetSeededShardIterator(seed, nil)))
+		counts[walked[0]]++
+
+		// excepted shards are skipped, without changing the order of the others
+		require.Equal(walked[1:], ids(cluster.GetSeededShardIterator(seed, walked[:1])))
+	}
+	// shards receive data relative to their weight
+	light, heavy := cluster.listedSlice[0], cluster.listedSlice[3]
+	require.True(counts[heavy.Identifier()] > 2*counts[light.Identifier()])
+
+	// without seed, all shards are still returned
+	require.Len(ids(cluster.GetShardIterator(nil)), 4)
+}
+
+func TestClusterWeightedRandom(t *testing.T) {
+	test := func(t *testing.T, weights []float64, limits []int64, expected []int) {
+		require := require.New(t)
+
+		var addresses []datastor.ShardConfig
+		for i := range weights {
+			server, addr, cleanup, err := zdbtest.NewInMem0DBServer("ns")
+			require.NoError(err)
+			defer cleanup()
+			server.SetLimit(limits[i])
+			addresses = append(addresses, datastor.ShardConfig{Address: addr, Weight: weights[i]})
+		}
+		cluster, err := NewCluster(addresses, "", "ns", nil, datastor.SpreadingTypeWeightedRandom)
+		require.NoError(err)
+		defer cluster.Close()
+
+		// count how often each shard is returned first
+		counts := make(map[string]int)
+		for i := 0; i < 1000; i++ {
+			it := cluster.GetShardIterator(nil)
+			require.True(it.Next())
+			counts[it.Shard().Identifier()]++
+		}
+		for i, shard := range cluster.listedSlice {
+			require.InDelta(expected[i], counts[shard.Identifier()], 100)
+		}
+	}
+
+	t.Run("weight", func(t *testing.T) {
+		test(t, []float64{1, 3}, []int64{0, 0}, []int{250, 750})
+	})
+	t.Run("free space", func(t *testing.T) {
+		test(t, []float64{1, 0}, []int64{3000, 1000}, []int{750, 250})
+	})
+	t.Run("equal", func(t *testing.T) {
+		test(t, []float64{0, 0}, []int64{0, 0}, []int{500, 500})
+	})
+}
+
+func TestClusterStats(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		addresses []datastor.ShardConfig
+		servers   []*zdbtest.InMem0DBServer
+	)
+	for _, limit := range []int64{1000, 2000} {
+		server, addr, cleanup, err := zdbtest.NewInMem0DBServer("ns")
+		require.NoError(err)
+		defer cleanup()
+		server.SetLimit(limit)
+		addresses = append(addresses, datastor.ShardConfig{Address: addr})
+		servers = append(servers, server)
+	}
+	cluster, err := NewClusterFromConfig(ClusterConfig{
+		Shards:        addresses,
+		Namespace:     "ns",
+		Spreading:     datastor.SpreadingTypeMostFree,
+		Health:        datastor.HealthConfig{ProbeInterval: -1},
+		StatsInterval: 50 * time.Millisecond,
+	})
+	require.NoError(err)
+	defer cluster.Close()
+
+	first := func() string {
+		it := cluster.GetShardIterator(nil)
+		require.True(it.Next())
+		return it.Shard().Identifier()
+	}
+	small, big := cluster.listedSlice[0], cluster.listedSlice[1]
+	require.Equal(int64(1000), small.CachedNamespace().Free)
+	require.Equal(int64(2000), big.CachedNamespace().Free)
+	require.Equal(big.Identifier(), first())
+
+	// objects created through the shard are accounted for locally
+	key, err := big.CreateObject(make([]byte, 600))
+	require.NoError(err)
+	require.Equal(int64(600), big.Utilization())
+	require.Equal(int64(1400), big.CachedNamespace().Free)
+	require.Equal(big.Identifier(), first())
+
+	// objects created by other writers are seen once refreshed
+	other, err := NewClient(addresses[1].Address, "", "ns", nil)
+	require.NoError(err)
+	defer other.Close()
+	_, err = other.CreateObject(make([]byte, 900))
+	require.NoError(err)
+	require.Eventually(func() bool {
+		return big.Utilization() == 1500
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(int64(500), big.CachedNamespace().Free)
+	require.Equal(small.Identifier(), first())
+
+	// as are deleted objects
+	require.NoError(big.DeleteObject(key))
+	require.Eventually(func() bool {
+		return big.Utilization() == 900
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(int64(1100), big.CachedNamespace().Free)
+	require.Equal(big.Identifier(), first())
+	require.Equal(int64(servers[1].ItemsSize()), big.Utilization())
 }
 
 // create cluster with `count` number of server
@@ -484,7 +743,7 @@ func newServerClient(passwd, namespace string) (cli *Client, addr string, cleanu
 	if err != nil {
 		return
 	}
-	cli, err = NewClient(addr, passwd, namespace)
+	cli, err = NewClient(addr, passwd, namespace, nil)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zerodb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+)
+
+// errProbeTimeout is recorded in case a shard doesn't respond to a probe in time
+var errProbeTimeout = errors.New("zerodb: shard didn't respond to probe in time")
+
+// latencyWeight is the weight of a new latency sample,
+// in the moving average latency of a shard.
+const latencyWeight = 0.2
+
+// sanitizeHealthConfig returns a copy of the given config,
+// with defaults applied, and disabled options set to zero.
+func sanitizeHealthConfig(cfg datastor.HealthConfig) datastor.HealthConfig {
+	if cfg.FailureThreshold == 0 {
+		cfg.FailureThreshold = datastor.DefaultFailureThreshold
+	} else if cfg.FailureThreshold < 0 {
+		cfg.FailureThreshold = 0
+	}
+	if cfg.ProbeInterval == 0 {
+		cfg.ProbeInterval = datastor.DefaultProbeInterval
+	} else if cfg.ProbeInterval < 0 {
+		cfg.ProbeInterval = 0
+	}
+	if cfg.LatencyThreshold == 0 {
+		cfg.LatencyThreshold = datastor.DefaultLatencyThreshold
+	} else if cfg.LatencyThreshold < 0 {
+		cfg.LatencyThreshold = 0
+	}
+	return cfg
+}
+
+// shardHealth tracks the health of a single shard,
+// based on the result of its calls, and the health counters of its namespace.
+type shardHealth struct {
+	id  string
+	cfg datastor.HealthConfig
+
+	mux            sync.Mutex
+	state          datastor.ShardState
+	since          time.Time
+	failures       int
+	lastErr        error
+	lastErrTime    time.Time
+	latency        time.Duration
+	counters       *datastor.Health
+	countersRaised bool
+}
+
+func newShardHealth(id string, cfg datastor.HealthConfig) *shardHealth {
+	return &shardHealth{
+		id:    id,
+		cfg:   cfg,
+		state: datastor.ShardStateHealthy,
+		since: time.Now(),
+	}
+}
+
+// record records the result of a single call to the shard.
+func (h *shardHealth) record(latency time.Duration, err error) {
+	if isCallerError(err) {
+		// says nothing about the health of the shard
+		return
+	}
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if err != nil {
+		h.failures++
+		h.lastErr = err
+		h.lastErrTime = time.Now()
+	} else {
+		h.failures = 0
+		if h.latency == 0 {
+			h.latency = latency
+		} else {
+			h.latency = time.Duration(
+				(1-latencyWeight)*float64(h.latency) + latencyWeight*float64(latency))
+		}
+	}
+	h.update()
+}
+
+// recordCounters records the health counters of the namespace,
+// as collected by a successful probe.
+func (h *shardHealth) recordCounters(counters *datastor.Health) {
+	if counters == nil {
+		return
+	}
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	prev := h.counters
+	h.countersRaised = prev != nil &&
+		(counters.DataIOErrors > prev.DataIOErrors ||
+			counters.IndexIOErrors > prev.IndexIOErrors ||
+			counters.DataFaults > prev.DataFaults ||
+			counters.IndexFaults > prev.IndexFaults)
+	h.counters = counters
+	h.update()
+}
+
+// update computes the state of the shard,
+// the lock has to be held by the caller.
+func (h *shardHealth) update() {
+	state := datastor.ShardStateHealthy
+	switch {
+	case h.cfg.FailureThreshold > 0 && h.failures >= h.cfg.FailureThreshold:
+		state = datastor.ShardStateUnhealthy
+	case h.failures > 0 || h.countersRaised ||
+		(h.cfg.LatencyThreshold > 0 && h.latency > h.cfg.LatencyThreshold):
+		state = datastor.ShardStateDegraded
+	}
+	if state == h.state {
+		return
+	}
+
+	switch state {
+	case datastor.ShardStateUnhealthy:
+		log.Errorf("zerodb: shard %s is unhealthy after %d failed calls: %v",
+			h.id, h.failures, h.lastErr)
+	case datastor.ShardStateDegraded:
+		log.Warningf("zerodb: shard %s is degraded", h.id)
+	default:
+		log.Infof("zerodb: shard %s is healthy again", h.id)
+	}
+	h.state = state
+	h.since = time.Now()
+}
+
+// available returns false in case the shard is unhealthy.
+func (h *shardHealth) available() bool {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	return h.state != datastor.ShardStateUnhealthy
+}
+
+// status returns the current health of the shard.
+func (h *shardHealth) status() datastor.ShardHealth {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	status := datastor.ShardHealth{
+		Shard:         h.id,
+		State:         h.state,
+		Since:         h.since,
+		Failures:      h.failures,
+		LastError:     h.lastErr,
+		LastErrorTime: h.lastErrTime,
+		Latency:       h.latency,
+	}
+	if h.counters != nil {
+		counters := *h.counters
+		status.Namespace = &counters
+	}
+	return status
+}
+
+// isCallerError returns true in case the given error was caused by the caller,
+// or is a reply of the server, neither of which says anything about its health.
+func isCallerError(err error) bool {
+	switch err {
+	case nil:
+		return false
+	case context.Canceled, context.DeadlineExceeded, errNilContext:
+		return true
+	}
+	_, ok := err.(redis.Error)
+	return ok
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zerodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardHealth(t *testing.T) {
+	require := require.New(t)
+
+	h := newShardHealth("ns@shard", sanitizeHealthConfig(datastor.HealthConfig{
+		FailureThreshold: 2,
+		LatencyThreshold: time.Second,
+	}))
+	require.Equal(datastor.ShardStateHealthy, h.status().State)
+
+	// errors caused by the caller, or replied by the server, are ignored
+	h.record(0, context.Canceled)
+	h.record(0, context.DeadlineExceeded)
+	h.record(0, redis.Error("No space left on this namespace"))
+	require.Equal(datastor.ShardStateHealthy, h.status().State)
+
+	// a failure degrades the shard, too many consecutive failures make it unhealthy
+	err := errors.New("connection refused")
+	h.record(0, err)
+	require.Equal(datastor.ShardStateDegraded, h.status().State)
+	require.True(h.available())
+	h.record(0, err)
+	status := h.status()
+	require.Equal(datastor.ShardStateUnhealthy, status.State)
+	require.Equal(2, status.Failures)
+	require.Equal(err, status.LastError)
+	require.False(h.available())
+
+	// a successful call readmits the shard
+	h.record(time.Millisecond, nil)
+	status = h.status()
+	require.Equal(datastor.ShardStateHealthy, status.State)
+	require.Zero(status.Failures)
+	require.Equal(time.Millisecond, status.Latency)
+
+	// a slow shard is degraded
+	for i := 0; i < 20; i++ {
+		h.record(10*time.Second, nil)
+	}
+	require.Equal(datastor.ShardStateDegraded, h.status().State)
+	for i := 0; i < 20; i++ {
+		h.record(time.Millisecond, nil)
+	}
+	require.Equal(datastor.ShardStateHealthy, h.status().State)
+
+	// new I/O errors reported by the namespace degrade the shard
+	h.recordCounters(&datastor.Health{DataIOErrors: 1})
+	require.Equal(datastor.ShardStateHealthy, h.status().State)
+	h.recordCounters(&datastor.Health{DataIOErrors: 2})
+	status = h.status()
+	require.Equal(datastor.ShardStateDegraded, status.State)
+	require.Equal(int64(2), status.Namespace.DataIOErrors)
+	h.recordCounters(&datastor.Health{DataIOErrors: 2})
+	require.Equal(datastor.ShardStateHealthy, h.status().State)
+}
+
+func TestClusterHealth(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		addresses []datastor.ShardConfig
+		cleanups  []func()
+	)
+	for i := 0; i < 3; i++ {
+		_, addr, cleanup, err := zdbtest.NewInMem0DBServer("ns")
+		require.NoError(err)
+		defer cleanup()
+		cleanups = append(cleanups, cleanup)
+		addresses = append(addresses, datastor.ShardConfig{Address: addr})
+	}
+	cluster, err := NewClusterFromConfig(ClusterConfig{
+		Shards:    addresses,
+		Namespace: "ns",
+		Health: datastor.HealthConfig{
+			FailureThreshold: 2,
+			ProbeInterval:    100 * time.Millisecond,
+		},
+	})
+	require.NoError(err)
+	defer cluster.Close()
+
+	for _, health := range cluster.GetShardHealth() {
+		require.Equal(datastor.ShardStateHealthy, health.State)
+	}
+
+	// a shard which can no longer be reached is skipped
+	down := cluster.listedSlice[0]
+	cleanups[0]()
+	require.Eventually(func() bool {
+		down.CreateObject([]byte("data"))
+		return !down.health.available()
+	}, 2*time.Second, time.Millisecond)
+
+	_, err = cluster.GetShard(down.Identifier())
+	require.Equal(datastor.ErrShardUnhealthy, err)
+	listed, err := cluster.GetListedShard(down.Identifier())
+	require.NoError(err)
+	require.Equal(down.Identifier(), listed.Identifier())
+	_, err = cluster.GetListedShard("ns@unknown:1234")
+	require.Error(err)
+	it := cluster.GetShardIterator(nil)
+	var count int
+	for it.Next() {
+		require.NotEqual(down.Identifier(), it.Shard().Identifier())
+		count++
+	}
+	require.Equal(2, count)
+	for i := 0; i < 10; i++ {
+		shard, err := cluster.GetRandomShard()
+		require.NoError(err)
+		require.NotEqual(down.Identifier(), shard.Identifier())
+	}
+	health := cluster.GetShardHealth()
+	require.Len(health, 3)
+	require.Equal(down.Identifier(), health[0].Shard)
+	require.Equal(datastor.ShardStateUnhealthy, health[0].State)
+	require.Error(health[0].LastError)
+
+	// a shard which responds again is readmitted by the probe
+	up := cluster.listedSlice[1]
+	up.health.record(0, errors.New("connection reset"))
+	up.health.record(0, errors.New("connection reset"))
+	_, err = cluster.GetShard(up.Identifier())
+	require.Equal(datastor.ErrShardUnhealthy, err)
+	require.Eventually(func() bool {
+		return up.health.available()
+	}, 2*time.Second, time.Millisecond)
+	_, err = cluster.GetShard(up.Identifier())
+	require.NoError(err)
+	require.NotNil(cluster.GetShardHealth()[1].Namespace)
+	require.False(down.health.available())
+}
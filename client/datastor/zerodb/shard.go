@@ -29,6 +29,10 @@ type Shard struct {
 	namespace string
 	password  string
 	address   string
+	topology  datastor.Topology
+	weight    float64
+
+	health *shardHealth
 }
 
 // Identifier implements datastor.Shard.Identifier
@@ -51,6 +55,12 @@ func (shard *Shard) Namespace() string {
 	return shard.namespace
 }
 
+// Topology implements datastor.Shard.Topology
+func (shard *Shard) Topology() datastor.Topology {
+	return shard.topology
+}
+
 var (
-	_ datastor.Shard = (*Shard)(nil)
+	_ datastor.Shard       = (*Shard)(nil)
+	_ datastor.KeyedClient = (*Shard)(nil)
 )
@@ -17,29 +17,67 @@
 package test
 
 import (
+	"bytes"
+	"crypto/tls"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/iwanbk/redcon" // using this because we have race condition issue with upstream
 )
 
 type InMem0DBServer struct {
-	mu        sync.RWMutex
-	items     map[string][]byte
-	server    *redcon.Server
+	mu            sync.RWMutex
+	namespaces    map[string]*inMemNamespace
+	server        *redcon.Server
+	namespace     string
+	adminPassword string
+}
+
+// inMemNamespace contains the objects and settings of a single namespace.
+type inMemNamespace struct {
+	items      map[string][]byte
+	timestamps map[string]int64
+	// creation index of each key, used to scan the keys in order of creation
+	indices  map[string]int
+	counter  int
+	limit    int64
+	password string
+	public   bool
+	userKeys bool
+}
+
+func newInMemNamespace() *inMemNamespace {
+	return &inMemNamespace{
+		items:      make(map[string][]byte),
+		timestamps: make(map[string]int64),
+		indices:    make(map[string]int),
+		public:     true,
+	}
+}
+
+// connState is the state of a single connection.
+type connState struct {
 	namespace string
-	counter   int
+	readOnly  bool
+	admin     bool
 }
 
-func NewInMem0DBServer(namespace string) (*InMem0DBServer, string, func(), error) {
-	s := &InMem0DBServer{
-		items:     make(map[string][]byte),
-		namespace: namespace,
+func newInMem0DBServer(namespace string) *InMem0DBServer {
+	return &InMem0DBServer{
+		namespaces: map[string]*inMemNamespace{namespace: newInMemNamespace()},
+		namespace:  namespace,
 	}
+}
+
+func NewInMem0DBServer(namespace string) (*InMem0DBServer, string, func(), error) {
+	s := newInMem0DBServer(namespace)
 	s.server = redcon.NewServer("localhost:0", s.handler, s.accept, s.closeHandler)
 
-	if err := s.start(); err != nil {
+	if err := s.start(s.server.ListenServeAndSignal); err != nil {
 		return nil, "", nil, err
 	}
 	cleanup := func() {
@@ -49,9 +87,35 @@ func NewInMem0DBServer(namespace string) (*InMem0DBServer, string, func(), error
 	return s, s.server.ListenAddress(), cleanup, nil
 }
 
-func (s *InMem0DBServer) start() error {
+// NewInMem0DBServerTLS creates an in-memory 0-db server,
+// which only accepts TLS connections, using the given TLS config.
+func NewInMem0DBServerTLS(namespace string, tlsConfig *tls.Config) (*InMem0DBServer, string, func(), error) {
+	s := newInMem0DBServer(namespace)
+	server := redcon.NewServerTLS("localhost:0", s.handler, s.accept, s.closeHandler, tlsConfig)
+	s.server = server.Server
+
+	if err := s.start(server.ListenServeAndSignal); err != nil {
+		return nil, "", nil, err
+	}
+	cleanup := func() {
+		s.Close()
+	}
+
+	return s, s.server.ListenAddress(), cleanup, nil
+}
+
+// SetAdminPassword sets the admin password of the server,
+// required to be given using AUTH prior to using the admin commands.
+// When no admin password is set, all connections are admin.
+func (s *InMem0DBServer) SetAdminPassword(passwd string) {
+	s.mu.Lock()
+	s.adminPassword = passwd
+	s.mu.Unlock()
+}
+
+func (s *InMem0DBServer) start(listenAndServe func(chan error) error) error {
 	errCh := make(chan error)
-	go s.server.ListenServeAndSignal(errCh)
+	go listenAndServe(errCh)
 	err := <-errCh
 	return err
 }
@@ -61,8 +125,12 @@ func (s *InMem0DBServer) Close() error {
 }
 
 func (s *InMem0DBServer) ItemsSize() int {
+	return s.namespaces[s.namespace].itemsSize()
+}
+
+func (ns *inMemNamespace) itemsSize() int {
 	total := 0
-	for _, b := range s.items {
+	for _, b := range ns.items {
 		total += len(b)
 	}
 	return total
@@ -71,7 +139,17 @@ func (s *InMem0DBServer) ItemsSize() int {
 func (s *InMem0DBServer) handler(conn redcon.Conn, cmd redcon.Command) {
 	switch strings.ToLower(string(cmd.Args[0])) {
 	case "select":
-		conn.WriteString("OK")
+		s.selectNamespace(conn, cmd)
+	case "auth":
+		s.auth(conn, cmd)
+	case "nsnew":
+		s.nsnew(conn, cmd)
+	case "nsdel":
+		s.nsdel(conn, cmd)
+	case "nsset":
+		s.nsset(conn, cmd)
+	case "nslist":
+		s.nslist(conn, cmd)
 	case "set":
 		s.set(conn, cmd)
 	case "get":
@@ -84,6 +162,8 @@ func (s *InMem0DBServer) handler(conn redcon.Conn, cmd redcon.Command) {
 		s.del(conn, cmd)
 	case "nsinfo":
 		s.nsinfo(conn, cmd)
+	case "scan":
+		s.scan(conn, cmd)
 	case "quit":
 		conn.WriteString("OK")
 		conn.Close()
@@ -92,11 +172,44 @@ func (s *InMem0DBServer) handler(conn redcon.Conn, cmd redcon.Command) {
 	}
 }
 
+// state returns the state of the given connection
+func (s *InMem0DBServer) state(conn redcon.Conn) *connState {
+	return conn.Context().(*connState)
+}
+
+// selected returns the namespace selected by the given connection,
+// writing an error in case it doesn't exist (any longer).
+// The caller is expected to hold the lock of the server.
+func (s *InMem0DBServer) selected(conn redcon.Conn) *inMemNamespace {
+	ns, ok := s.namespaces[s.state(conn).namespace]
+	if !ok {
+		conn.WriteError("Namespace not found")
+		return nil
+	}
+	return ns
+}
+
+// writable returns the namespace selected by the given connection,
+// writing an error in case it doesn't exist or is selected in read-only mode.
+// The caller is expected to hold the lock of the server.
+func (s *InMem0DBServer) writable(conn redcon.Conn) *inMemNamespace {
+	ns := s.selected(conn)
+	if ns != nil && s.state(conn).readOnly {
+		conn.WriteError("Namespace is in read-only mode")
+		return nil
+	}
+	return ns
+}
+
 // TODO : also support `corrupted`
 func (s *InMem0DBServer) check(conn redcon.Conn, cmd redcon.Command) {
 	s.mu.Lock()
-
-	_, ok := s.items[string(cmd.Args[1])]
+	ns := s.selected(conn)
+	if ns == nil {
+		s.mu.Unlock()
+		return
+	}
+	_, ok := ns.items[string(cmd.Args[1])]
 	s.mu.Unlock()
 
 	if ok {
@@ -113,9 +226,37 @@ func (s *InMem0DBServer) set(conn redcon.Conn, cmd redcon.Command) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.counter += 1
-	key := fmt.Sprintf("key-%d", s.counter)
-	s.items[key] = cmd.Args[2]
+	ns := s.writable(conn)
+	if ns == nil {
+		return
+	}
+	key, data := string(cmd.Args[1]), cmd.Args[2]
+	if ns.userKeys {
+		if key == "" {
+			conn.WriteError("Invalid argument, key needed")
+			return
+		}
+		if stored, ok := ns.items[key]; ok && bytes.Equal(stored, data) {
+			// 0-db doesn't write an object which is already stored as is
+			conn.WriteNull()
+			return
+		}
+	} else if key != "" {
+		conn.WriteError("Invalid key, use empty key for auto-generated key")
+		return
+	}
+	if ns.limit > 0 && int64(ns.itemsSize()-len(ns.items[key])+len(data)) > ns.limit {
+		conn.WriteError("No space left on this namespace")
+		return
+	}
+
+	ns.counter++
+	if !ns.userKeys {
+		key = fmt.Sprintf("key-%d", ns.counter)
+	}
+	ns.items[key] = data
+	ns.timestamps[key] = time.Now().Unix()
+	ns.indices[key] = ns.counter
 
 	conn.WriteBulk([]byte(key))
 }
@@ -123,7 +264,11 @@ func (s *InMem0DBServer) exist(conn redcon.Conn, cmd redcon.Command) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	_, ok := s.items[string(cmd.Args[1])]
+	ns := s.selected(conn)
+	if ns == nil {
+		return
+	}
+	_, ok := ns.items[string(cmd.Args[1])]
 	if ok {
 		conn.WriteInt(1)
 	} else {
@@ -140,8 +285,13 @@ func (s *InMem0DBServer) del(conn redcon.Conn, cmd redcon.Command) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	_, ok := s.items[key]
-	delete(s.items, key)
+	ns := s.writable(conn)
+	if ns == nil {
+		return
+	}
+	_, ok := ns.items[key]
+	delete(ns.items, key)
+	delete(ns.timestamps, key)
 
 	if !ok {
 		conn.WriteInt(0)
@@ -160,7 +310,11 @@ func (s *InMem0DBServer) get(conn redcon.Conn, cmd redcon.Command) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	val, ok := s.items[string(cmd.Args[1])]
+	ns := s.selected(conn)
+	if ns == nil {
+		return
+	}
+	val, ok := ns.items[string(cmd.Args[1])]
 
 	if !ok {
 		conn.WriteNull()
@@ -169,15 +323,315 @@ func (s *InMem0DBServer) get(conn redcon.Conn, cmd redcon.Command) {
 	}
 }
 
+// SetLimit sets the size limit of the namespace, in bytes,
+// as reported by NSINFO. Zero means the namespace is unlimited.
+func (s *InMem0DBServer) SetLimit(limit int64) {
+	s.mu.Lock()
+	s.namespaces[s.namespace].limit = limit
+	s.mu.Unlock()
+}
+
 func (s *InMem0DBServer) nsinfo(conn redcon.Conn, cmd redcon.Command) {
-	format := "# namespace\nname: %v\nentries: %v\npublic: yes\npassword: no\ndata_size_bytes: %v\ndata_size_mb: 0.00\ndata_limits_bytes: 0\nindex_size_bytes: 324\nindex_size_kb: 0.32\n"
+	if len(cmd.Args) != 2 {
+		conn.WriteError("ERR wrong number of arguments for '" + string(cmd.Args[0]) + "' command")
+		return
+	}
+	format := "# namespace\nname: %v\nentries: %v\npublic: %v\npassword: %v\ndata_size_bytes: %v\ndata_size_mb: 0.00\ndata_limits_bytes: %v\nindex_size_bytes: 324\nindex_size_kb: 0.32\nmode: %v\n"
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	str := fmt.Sprintf(format, s.namespace, len(s.items), s.ItemsSize())
+	ns, name := s.lookup(string(cmd.Args[1]))
+	mode := "sequential"
+	if ns.userKeys {
+		mode = "userkey"
+	}
+	str := fmt.Sprintf(format, name, len(ns.items), yesNo(ns.public), yesNo(ns.password != ""),
+		ns.itemsSize(), ns.limit, mode)
 	conn.WriteBulk([]byte(str))
 }
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// lookup returns the namespace with the given name, and its name.
+// Namespaces which weren't created using NSNEW are served from the
+// namespace the server was created with, such that any namespace can be selected.
+// The caller is expected to hold the lock of the server.
+func (s *InMem0DBServer) lookup(name string) (*inMemNamespace, string) {
+	if ns, ok := s.namespaces[name]; ok {
+		return ns, name
+	}
+	return s.namespaces[s.namespace], s.namespace
+}
+
+// selectNamespace selects the namespace used by the connection,
+// in read-only mode in case the namespace is public and the password doesn't match.
+func (s *InMem0DBServer) selectNamespace(conn redcon.Conn, cmd redcon.Command) {
+	if len(cmd.Args) < 2 || len(cmd.Args) > 3 {
+		conn.WriteError("ERR wrong number of arguments for '" + string(cmd.Args[0]) + "' command")
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ns, name := s.lookup(string(cmd.Args[1]))
+	var readOnly bool
+	if ns.password != "" && (len(cmd.Args) != 3 || string(cmd.Args[2]) != ns.password) {
+		if !ns.public {
+			conn.WriteError("Access denied")
+			return
+		}
+		readOnly = true
+	}
+	state := s.state(conn)
+	state.namespace = name
+	state.readOnly = readOnly
+	conn.WriteString("OK")
+}
+
+func (s *InMem0DBServer) auth(conn redcon.Conn, cmd redcon.Command) {
+	if len(cmd.Args) != 2 {
+		conn.WriteError("ERR wrong number of arguments for '" + string(cmd.Args[0]) + "' command")
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.adminPassword == "" || string(cmd.Args[1]) != s.adminPassword {
+		conn.WriteError("Access denied")
+		return
+	}
+	s.state(conn).admin = true
+	conn.WriteString("OK")
+}
+
+// isAdmin returns true in case the given connection is allowed to use admin commands,
+// writing an error if not.
+// The caller is expected to hold the lock of the server.
+func (s *InMem0DBServer) isAdmin(conn redcon.Conn) bool {
+	if s.adminPassword == "" || s.state(conn).admin {
+		return true
+	}
+	conn.WriteError("Permission denied")
+	return false
+}
+
+func (s *InMem0DBServer) nsnew(conn redcon.Conn, cmd redcon.Command) {
+	if len(cmd.Args) != 2 {
+		conn.WriteError("ERR wrong number of arguments for '" + string(cmd.Args[0]) + "' command")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.isAdmin(conn) {
+		return
+	}
+	name := string(cmd.Args[1])
+	if _, ok := s.namespaces[name]; ok {
+		conn.WriteError("This namespace is not available")
+		return
+	}
+	s.namespaces[name] = newInMemNamespace()
+	conn.WriteString("OK")
+}
+
+func (s *InMem0DBServer) nsdel(conn redcon.Conn, cmd redcon.Command) {
+	if len(cmd.Args) != 2 {
+		conn.WriteError("ERR wrong number of arguments for '" + string(cmd.Args[0]) + "' command")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.isAdmin(conn) {
+		return
+	}
+	name := string(cmd.Args[1])
+	if _, ok := s.namespaces[name]; !ok {
+		conn.WriteError("Namespace not found")
+		return
+	}
+	if name == s.namespace {
+		conn.WriteError("Cannot remove default namespace")
+		return
+	}
+	delete(s.namespaces, name)
+	conn.WriteString("OK")
+}
+
+func (s *InMem0DBServer) nsset(conn redcon.Conn, cmd redcon.Command) {
+	if len(cmd.Args) != 4 {
+		conn.WriteError("ERR wrong number of arguments for '" + string(cmd.Args[0]) + "' command")
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.isAdmin(conn) {
+		return
+	}
+	ns, ok := s.namespaces[string(cmd.Args[1])]
+	if !ok {
+		conn.WriteError("Namespace not found")
+		return
+	}
+	value := string(cmd.Args[3])
+	switch strings.ToLower(string(cmd.Args[2])) {
+	case "maxsize":
+		limit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || limit < 0 {
+			conn.WriteError("Invalid value")
+			return
+		}
+		ns.limit = limit
+	case "password":
+		if value == "*" {
+			value = ""
+		}
+		ns.password = value
+	case "public":
+		switch value {
+		case "1":
+			ns.public = true
+		case "0":
+			ns.public = false
+		default:
+			conn.WriteError("Invalid value")
+			return
+		}
+	case "mode":
+		if len(ns.items) > 0 {
+			conn.WriteError("Cannot change mode, namespace is not empty")
+			return
+		}
+		switch value {
+		case "user":
+			ns.userKeys = true
+		case "seq":
+			ns.userKeys = false
+		default:
+			conn.WriteError("Invalid value")
+			return
+		}
+	default:
+		conn.WriteError("Invalid property")
+		return
+	}
+	conn.WriteString("OK")
+}
+
+func (s *InMem0DBServer) nslist(conn redcon.Conn, cmd redcon.Command) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.namespaces))
+	for name := range s.namespaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	conn.WriteArray(len(names))
+	for _, name := range names {
+		conn.WriteBulkString(name)
+	}
+}
+
+// Keys returns the keys of all stored objects.
+func (s *InMem0DBServer) Keys() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ns := s.namespaces[s.namespace]
+	keys := make([]string, 0, len(ns.items))
+	for key := range ns.items {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// SetTimestamp overwrites the timestamp of a stored object,
+// such that it can appear to be stored at a different moment in time.
+func (s *InMem0DBServer) SetTimestamp(key []byte, timestamp time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ns := s.namespaces[s.namespace]
+	if _, ok := ns.items[string(key)]; ok {
+		ns.timestamps[string(key)] = timestamp.Unix()
+	}
+}
+
+// scan walks over all keys in order of creation,
+// returning at most scanBatchSize entries per call, in the 0-db format:
+// [cursor, [[key, size, timestamp], ...]]
+func (s *InMem0DBServer) scan(conn redcon.Conn, cmd redcon.Command) {
+	if len(cmd.Args) > 2 {
+		conn.WriteError("ERR wrong number of arguments for '" + string(cmd.Args[0]) + "' command")
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ns := s.selected(conn)
+	if ns == nil {
+		return
+	}
+	var after int
+	if len(cmd.Args) == 2 {
+		var ok bool
+		after, ok = ns.keyIndex(string(cmd.Args[1]))
+		if !ok {
+			conn.WriteError("Invalid key format")
+			return
+		}
+	}
+	var keys []string
+	for key := range ns.items {
+		if ns.indices[key] > after {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		conn.WriteError("No more data")
+		return
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return ns.indices[keys[i]] < ns.indices[keys[j]]
+	})
+	if len(keys) > scanBatchSize {
+		keys = keys[:scanBatchSize]
+	}
+
+	conn.WriteArray(2)
+	conn.WriteBulk([]byte(keys[len(keys)-1]))
+	conn.WriteArray(len(keys))
+	for _, key := range keys {
+		conn.WriteArray(3)
+		conn.WriteBulk([]byte(key))
+		conn.WriteInt(len(ns.items[key]))
+		conn.WriteInt64(ns.timestamps[key])
+	}
+}
+
+// keyIndex returns the creation index of a key,
+// which is also known for generated keys of deleted objects,
+// returning false in case the key is unknown.
+func (ns *inMemNamespace) keyIndex(key string) (int, bool) {
+	if index, ok := ns.indices[key]; ok {
+		return index, true
+	}
+	if ns.userKeys {
+		return 0, false
+	}
+	var index int
+	if _, err := fmt.Sscanf(key, "key-%d", &index); err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+const scanBatchSize = 16
+
 func (s *InMem0DBServer) accept(conn redcon.Conn) bool {
+	// connections use the namespace the server was created with, until they select another one
+	conn.SetContext(&connState{namespace: s.namespace})
 	return true
 }
 func (s *InMem0DBServer) closeHandler(conn redcon.Conn, err error) {
@@ -0,0 +1,237 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SetDeduplication enables or disables the deduplication of chunks.
+// Deduplication is disabled by default, and requires a metastor client.
+//
+// When enabled, all written chunks are indexed by their hash in the metastor,
+// such that a chunk which is written again (as part of any key) reuses the chunk already stored,
+// rather than storing the same data once more. Each chunk is reference counted,
+// and is only deleted once no metadata references it any longer.
+// Each chunk is looked up in the index prior to processing and writing it,
+// such that the data of a chunk which is indexed already is never written again.
+// Only the data of a chunk which got indexed while it was being written (e.g. by another client)
+// is written once more, and deleted again once the chunk turns out to be indexed already.
+//
+// Chunks written prior to enabling deduplication aren't indexed, and are deleted as before.
+// Once enabled for a namespace, all clients of that namespace should enable deduplication,
+// using the same data pipeline configuration,
+// as a client which doesn't would delete chunks which might still be referenced.
+//
+// It should be called prior to using the client.
+func (c *Client) SetDeduplication(enabled bool) error {
+	if enabled && c.metastorClient == nil {
+		return ErrNoMetastorClient
+	}
+	c.dedup = enabled
+	return nil
+}
+
+// writeChunks processes and writes the data read from the given reader,
+// using the data pipeline, and returns the written chunks,
+// reusing the indexed chunks in case deduplication is enabled.
+func (c *Client) writeChunks(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error) {
+	if !c.dedup {
+		return c.dataPipeline.WriteContext(ctx, r)
+	}
+
+	// chunks which are indexed already are acquired prior to writing them,
+	// such that their data is neither processed nor written again
+	var (
+		chunks      []metatypes.Chunk
+		err         error
+		acquired    []metatypes.Chunk
+		acquiredMux sync.Mutex
+	)
+	if lp, ok := c.dataPipeline.(pipeline.LookupPipeline); ok {
+		chunks, err = lp.WriteLookup(ctx, r, func(hash []byte) (*metatypes.Chunk, error) {
+			indexed, err := c.metastorClient.AcquireIndexedChunk(hash)
+			if err != nil {
+				if err == metastor.ErrNotFound {
+					return nil, nil
+				}
+				return nil, err
+			}
+			acquiredMux.Lock()
+			acquired = append(acquired, *indexed)
+			acquiredMux.Unlock()
+			return indexed, nil
+		})
+	} else {
+		chunks, err = c.dataPipeline.WriteContext(ctx, r)
+	}
+	if err != nil {
+		// the written chunks were rolled back by the pipeline,
+		// while the acquired chunks still have to be released
+		c.deleteUnusedChunks(acquired)
+		return nil, err
+	}
+	acquiredObjects := make(map[string]struct{}, len(acquired))
+	for _, chunk := range acquired {
+		for _, object := range chunk.Objects {
+			acquiredObjects[object.ShardID+"/"+string(object.Key)] = struct{}{}
+		}
+	}
+	isAcquired := func(chunk metatypes.Chunk) bool {
+		if len(chunk.Objects) == 0 {
+			return false
+		}
+		object := chunk.Objects[0]
+		_, ok := acquiredObjects[object.ShardID+"/"+string(object.Key)]
+		return ok
+	}
+
+	// the written chunks are indexed, unless the same data got indexed in the meantime
+	var duplicates []metatypes.Chunk
+	for index, chunk := range chunks {
+		if len(chunk.Hash) == 0 || isAcquired(chunk) {
+			// can't be indexed, or is referenced already
+			continue
+		}
+		indexed, duplicate, err := c.metastorClient.AcquireChunk(chunk)
+		if err != nil {
+			// release the chunks referenced so far,
+			// and delete the written chunks which aren't referenced
+			unused := chunks[:index:index]
+			for _, chunk := range chunks[index:] {
+				if isAcquired(chunk) {
+					unused = append(unused, chunk)
+				} else {
+					duplicates = append(duplicates, chunk)
+				}
+			}
+			c.deleteUnusedChunks(unused)
+			c.deleteDuplicateChunks(duplicates)
+			return nil, err
+		}
+		// a chunk which shares objects with the indexed chunk (e.g. stored under the same user keys)
+		// isn't a duplicate, such that shared objects are only deleted once no longer referenced
+		if duplicate {
+			duplicates = append(duplicates, chunk)
+			// the offset is defined by the referencing data, not by the index
+			indexed.Offset = chunk.Offset
+			chunks[index] = *indexed
+		}
+	}
+	c.deleteDuplicateChunks(duplicates)
+	return chunks, nil
+}
+
+// freeChunks deletes the data of the given chunks,
+// which are no longer referenced by the caller.
+// In case deduplication is enabled, a reference to each chunk is released instead,
+// only deleting the data of the chunks which are no longer referenced at all.
+//
+// As a released reference can't be restored, the caller should remove its own reference
+// to the chunks prior to freeing them when deduplication is enabled,
+// such that no chunk is ever released twice.
+func (c *Client) freeChunks(ctx context.Context, chunks []metatypes.Chunk) error {
+	if !c.dedup {
+		return c.dataPipeline.DeleteContext(ctx, chunks)
+	}
+	var (
+		err          error
+		unreferenced = make([]metatypes.Chunk, 0, len(chunks))
+	)
+	for _, chunk := range chunks {
+		var released *metatypes.Chunk
+		released, err = c.metastorClient.ReleaseChunk(chunk)
+		if err != nil {
+			break
+		}
+		if released != nil {
+			unreferenced = append(unreferenced, *released)
+		}
+	}
+	if len(unreferenced) > 0 {
+		deleteErr := c.dataPipeline.DeleteContext(ctx, unreferenced)
+		if err == nil {
+			err = deleteErr
+		}
+	}
+	return err
+}
+
+// deleteDuplicateChunks deletes the given chunks,
+// which are duplicates of indexed chunks, and thus never referenced.
+//
+// Errors are only logged, chunks that couldn't be deleted
+// are left to be collected by the garbage collector.
+func (c *Client) deleteDuplicateChunks(chunks []metatypes.Chunk) {
+	if len(chunks) == 0 {
+		return
+	}
+	err := c.dataPipeline.DeleteContext(context.Background(), chunks)
+	if err != nil {
+		log.Errorf("failed to delete duplicate chunks: %v", err)
+	}
+}
+
+// repairChunks repairs the given chunks, using the data pipeline.
+// In case deduplication is enabled, the indexed chunks are repaired instead
+// of the (possibly outdated) given chunks, and replaced in the index,
+// such that all keys which reference a repaired chunk can use the repaired chunk.
+func (c *Client) repairChunks(ctx context.Context, chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
+	if !c.dedup {
+		return c.dataPipeline.RepairContext(ctx, chunks)
+	}
+
+	indexed := make([]metatypes.Chunk, len(chunks))
+	copy(indexed, chunks)
+	for index, chunk := range chunks {
+		if len(chunk.Hash) == 0 {
+			continue
+		}
+		current, err := c.metastorClient.LookupChunk(chunk)
+		if err != nil {
+			if err == metastor.ErrNotFound {
+				continue
+			}
+			return nil, err
+		}
+		current.Offset = chunk.Offset
+		indexed[index] = *current
+	}
+
+	repaired, err := c.dataPipeline.RepairContext(ctx, indexed)
+	if err != nil {
+		return nil, err
+	}
+	for index, chunk := range chunks {
+		if len(chunk.Hash) == 0 {
+			continue
+		}
+		err = c.metastorClient.ReplaceChunk(chunk, repaired[index])
+		if err != nil && err != metastor.ErrNotFound {
+			return nil, err
+		}
+	}
+	return repaired, nil
+}
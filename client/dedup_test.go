@@ -0,0 +1,283 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	mrand "math/rand"
+	"sync/atomic"
+	"testing"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientDedup(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(t, err)
+	defer c.Close()
+	require.NoError(t, c.SetDeduplication(true))
+
+	block := func() []byte {
+		data := make([]byte, 64)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		return data
+	}
+	a, b, d := block(), block(), block()
+	// every chunk is stored as 3 objects, a repeated block is only stored once
+	data := bytes.Join([][]byte{a, b, a, []byte("tail")}, nil)
+
+	mdA, err := c.Write([]byte("a"), bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, mdA.Chunks, 4)
+	require.Equal(t, mdA.Chunks[0], mdA.Chunks[2])
+	require.Equal(t, 3*3, objectCount(t, cluster))
+
+	// writing the same data as another key reuses all chunks
+	mdB, err := c.Write([]byte("b"), bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, mdA.Chunks, mdB.Chunks)
+	require.Equal(t, 3*3, objectCount(t, cluster))
+	_, refs, err := c.metastorClient.GetChunk(mdA.Chunks[0].Hash)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), refs)
+
+	// appending reuses chunks as well
+	mdB, err = c.Append([]byte("b"), bytes.NewReader(d))
+	require.NoError(t, err)
+	require.Equal(t, 5*3, objectCount(t, cluster))
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, c.Read(*mdB, buf))
+	require.Equal(t, append(data, d...), buf.Bytes())
+
+	// overwriting a key only deletes the chunks no other key references
+	mdA, err = c.Write([]byte("a"), bytes.NewReader(d))
+	require.NoError(t, err)
+	require.Equal(t, 5*3, objectCount(t, cluster))
+
+	// deleting a key only deletes the chunks no other key references
+	require.NoError(t, c.Delete(*mdB))
+	_, err = c.metastorClient.GetMetadata([]byte("b"))
+	require.Equal(t, metastor.ErrNotFound, err)
+	require.Equal(t, 1*3, objectCount(t, cluster))
+	buf.Reset()
+	require.NoError(t, c.Read(*mdA, buf))
+	require.Equal(t, d, buf.Bytes())
+	_, _, err = c.metastorClient.GetChunk(mdB.Chunks[0].Hash)
+	require.Equal(t, metastor.ErrNotFound, err)
+
+	require.NoError(t, c.Delete(*mdA))
+	require.Zero(t, objectCount(t, cluster))
+
+	// chunks which were written prior to enabling deduplication are deleted as before
+	require.NoError(t, c.SetDeduplication(false))
+	md, err := c.Write([]byte("c"), bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, 4*3, objectCount(t, cluster))
+	require.NoError(t, c.SetDeduplication(true))
+	require.NoError(t, c.Delete(*md))
+	require.Zero(t, objectCount(t, cluster))
+
+	require.Equal(t, ErrNoMetastorClient, NewClient(nil, c.dataPipeline).SetDeduplication(true))
+}
+
+func TestClientDedupLookup(t *testing.T) {
+	require := require.New(t)
+
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+	cluster, err := NewDataCluster(newDefaultConfig(shards, 64))
+	require.NoError(err)
+	defer cluster.Close()
+	cs, err := storage.NewRandomChunkStorage(cluster)
+	require.NoError(err)
+	counter := &writeCountingStorage{ChunkStorage: cs}
+	metastorClient, err := getTestMetastorClient("ns")
+	require.NoError(err)
+	c := NewClient(metastorClient, pipeline.NewAsyncSplitterPipeline(counter, 64, nil, nil, -1))
+	defer c.Close()
+	require.NoError(c.SetDeduplication(true))
+
+	data := make([]byte, 64*2)
+	_, err = rand.Read(data)
+	require.NoError(err)
+	mdA, err := c.Write([]byte("a"), bytes.NewReader(data))
+	require.NoError(err)
+	require.Equal(int64(2), atomic.LoadInt64(&counter.writes))
+
+	// indexed chunks are never written again
+	mdB, err := c.Write([]byte("b"), bytes.NewReader(data))
+	require.NoError(err)
+	require.Equal(mdA.Chunks, mdB.Chunks)
+	require.Equal(int64(2), atomic.LoadInt64(&counter.writes))
+	_, refs, err := c.metastorClient.GetChunk(mdA.Chunks[0].Hash)
+	require.NoError(err)
+	require.Equal(int64(2), refs)
+
+	// the chunks acquired by a failed write are released
+	counter.fail = true
+	block := make([]byte, 64)
+	_, err = rand.Read(block)
+	require.NoError(err)
+	_, err = c.Write([]byte("c"), bytes.NewReader(append(data[:64:64], block...)))
+	require.Equal(errWriteFailed, err)
+	_, refs, err = c.metastorClient.GetChunk(mdA.Chunks[0].Hash)
+	require.NoError(err)
+	require.Equal(int64(2), refs)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(c.Read(*mdB, buf))
+	require.Equal(data, buf.Bytes())
+}
+
+// writeCountingStorage counts the chunks written to the chunk storage it wraps,
+// failing all writes once fail is set
+type writeCountingStorage struct {
+	storage.ChunkStorage
+	writes int64
+	fail   bool
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (cs *writeCountingStorage) WriteChunkContext(ctx context.Context, data []byte) (*storage.ChunkConfig, error) {
+	if cs.fail {
+		return nil, errWriteFailed
+	}
+	atomic.AddInt64(&cs.writes, 1)
+	return cs.ChunkStorage.WriteChunkContext(ctx, data)
+}
+
+func TestClientDedupRepair(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(t, err)
+	defer c.Close()
+	require.NoError(t, c.SetDeduplication(true))
+
+	data := make([]byte, 64*2)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	mdA, err := c.Write([]byte("a"), bytes.NewReader(data))
+	require.NoError(t, err)
+	mdB, err := c.Write([]byte("b"), bytes.NewReader(data))
+	require.NoError(t, err)
+
+	// corrupt the shared data, and repair it as one of the keys
+	object := mdA.Chunks[0].Objects[0]
+	shard, err := cluster.GetShard(object.ShardID)
+	require.NoError(t, err)
+	require.NoError(t, shard.DeleteObject(object.Key))
+	repaired, err := c.Repair(*mdA)
+	require.NoError(t, err)
+
+	// the repaired chunk is indexed, and repaired once more by the other key
+	indexed, _, err := c.metastorClient.GetChunk(mdA.Chunks[0].Hash)
+	require.NoError(t, err)
+	require.Equal(t, repaired.Chunks[0], *indexed)
+	repairedB, err := c.Repair(*mdB)
+	require.NoError(t, err)
+	indexed, _, err = c.metastorClient.GetChunk(mdA.Chunks[0].Hash)
+	require.NoError(t, err)
+	require.Equal(t, repairedB.Chunks[0], *indexed)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, c.Read(*repairedB, buf))
+	require.Equal(t, data, buf.Bytes())
+
+	// references to the chunk prior to its repair can still be released
+	require.NoError(t, c.Delete(*mdA))
+	_, refs, err := c.metastorClient.GetChunk(mdA.Chunks[0].Hash)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), refs)
+	require.NoError(t, c.Delete(*repairedB))
+	_, _, err = c.metastorClient.GetChunk(mdA.Chunks[0].Hash)
+	require.Equal(t, metastor.ErrNotFound, err)
+}
+
+func TestClientDedupVariableChunked(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	// chunks have to be larger than the window of the rolling hash,
+	// for their boundaries to be independent of the preceding chunks
+	cfg := newDefaultConfig(shards, metatypes.VariableChunkSize)
+	cfg.DataStor.Pipeline.Chunking.AverageSize = 1024
+	c, cluster, err := getTestClient(cfg)
+	require.NoError(t, err)
+	defer c.Close()
+	require.NoError(t, c.SetDeduplication(true))
+
+	data := make([]byte, 64*1024)
+	_, err = mrand.New(mrand.NewSource(42)).Read(data)
+	require.NoError(t, err)
+	mdA, err := c.Write([]byte("a"), bytes.NewReader(data))
+	require.NoError(t, err)
+	before := objectCount(t, cluster)
+
+	// inserting data only stores the chunks around that data once more
+	modified := append(append(append([]byte(nil), data[:20000]...), "inserted"...), data[20000:]...)
+	mdB, err := c.Write([]byte("b"), bytes.NewReader(modified))
+	require.NoError(t, err)
+	require.True(t, objectCount(t, cluster)-before <= 3*3,
+		"%d objects stored for %d chunks", objectCount(t, cluster)-before, len(mdB.Chunks))
+
+	// the reused chunks are referenced at their own offset
+	require.NoError(t, checkChunkOffsets(*mdB))
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, c.ReadRange(*mdB, buf, 19990, 100))
+	require.Equal(t, modified[19990:20090], buf.Bytes())
+	buf.Reset()
+	require.NoError(t, c.Read(*mdB, buf))
+	require.Equal(t, modified, buf.Bytes())
+
+	require.NoError(t, c.Delete(*mdA))
+	require.NoError(t, c.Delete(*mdB))
+	require.Zero(t, objectCount(t, cluster))
+}
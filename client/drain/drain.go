@@ -0,0 +1,241 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package drain provides the draining of a shard,
+// which moves all objects stored on a shard of a 0-stor cluster to its other shards,
+// such that the shard can be decommissioned,
+// as well as the rebalancing of a cluster,
+// which moves objects from over-full shards to under-used ones,
+// such as shards which were just added to the cluster.
+//
+// Each moved object referenced by the metadata of the namespace,
+// its older versions, its upload sessions or its deduplication index,
+// is copied as is to another shard, which isn't used yet by the chunk the object belongs to,
+// such that the replication or distribution layout of that chunk is respected.
+// The objects of a chunk are kept spread over the failure domains (zones, racks and hosts)
+// of the shards, never exceeding the max amount of objects per domain given by the config.
+// The metadata is updated once all its objects have been moved,
+// hence a drain or rebalance which got interrupted can be resumed by simply running it again.
+package drain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrNilContext is returned in case no context is given.
+	ErrNilContext = errors.New("drain: nil context")
+	// ErrNoMetastorClient is returned in case no metastor client is given.
+	ErrNoMetastorClient = errors.New("drain: no metastor client given")
+	// ErrNoCluster is returned in case no datastor cluster is given.
+	ErrNoCluster = errors.New("drain: no datastor cluster given")
+	// ErrUnknownShard is returned in case the shard to drain isn't listed in the cluster.
+	ErrUnknownShard = errors.New("drain: shard isn't listed in the cluster")
+	// ErrNoShardAvailable is returned in case an object can't be moved,
+	// as no other shard is available which isn't used by its chunk yet.
+	ErrNoShardAvailable = errors.New("drain: no shard available which respects the layout of the chunk")
+)
+
+// Config defines the configuration of a drain.
+type Config struct {
+	// ProgressInterval defines the interval at which the progress
+	// of the drain is logged, no progress is logged in case it is zero or negative.
+	ProgressInterval time.Duration
+
+	// BytesPerSecond limits the amount of object data moved per second,
+	// unlimited in case it is zero or negative.
+	BytesPerSecond int
+
+	// DryRun can be enabled in order to collect the objects which have to be moved,
+	// without moving any of them, or updating any metadata.
+	DryRun bool
+
+	// MaxPerDomain limits the amount of objects of a single chunk,
+	// stored within a single zone, rack or host, as defined by the topology of the shards.
+	// It should be the limit used by the chunk storage which stored the chunks,
+	// see `pipeline.ObjectDistributionConfig.MaxShardsPerDomain`.
+	// The objects aren't limited in case it is zero or negative.
+	MaxPerDomain int
+}
+
+// Report is the (intermediate) result of a drain.
+type Report struct {
+	// DryRun is true in case no objects were moved, and no metadata was updated.
+	DryRun bool
+	// Shard is the identifier of the drained shard,
+	// empty in case of a rebalance.
+	Shard string
+	// Scanned is the amount of metadata scanned,
+	// including older versions, the parts of upload sessions and indexed chunks.
+	Scanned int
+	// Drained is the amount of metadata which referenced objects that had to be moved,
+	// and which was updated to reference the moved objects instead,
+	// or which would have been updated in case of a dry run.
+	Drained int
+	// MovedObjects is the amount of objects moved to another shard,
+	// or which would have been moved in case of a dry run.
+	MovedObjects int
+	// MovedBytes is the size of the data of the moved objects, in bytes.
+	MovedBytes int64
+	// Failures contains all metadata which couldn't be drained.
+	Failures []Failure
+}
+
+// Failure defines metadata which couldn't be drained.
+type Failure struct {
+	// Metadata describes the metadata which couldn't be drained.
+	Metadata string
+	Error    error
+}
+
+// String implements Stringer.String
+func (report *Report) String() string {
+	return fmt.Sprintf(
+		"shard %s: %d scanned, %d drained, %d failed, %d objects moved (%d bytes)",
+		report.Shard, report.Scanned, report.Drained, len(report.Failures),
+		report.MovedObjects, report.MovedBytes)
+}
+
+// Drain moves all objects stored on the shard with the given identifier,
+// and referenced by any metadata stored in the namespace of the given metastor client,
+// to other shards of the given cluster, updating the metadata accordingly.
+//
+// The shard has to be listed in the given cluster, such that its objects can be read,
+// which is still possible once the cluster considers it unhealthy, and no longer hands it out.
+// It is recommended to remove the shard from the configuration of all other clients
+// prior to draining it, such that no new objects are written to it.
+// Once a drain finished without failures, the shard no longer stores any referenced object,
+// and can be decommissioned. Objects moved for metadata which got deleted or overwritten
+// while draining, are left behind as orphans, and are deleted by the garbage collector.
+//
+// An error is only returned in case the metadata couldn't be listed,
+// or the context got cancelled, in which case the returned report is incomplete.
+// Errors which occur while draining metadata are part of the report,
+// and do not abort the drain.
+func Drain(ctx context.Context, metaClient *metastor.Client, cluster datastor.Cluster, shardID string, cfg Config) (*Report, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if metaClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+	if cluster == nil {
+		return nil, ErrNoCluster
+	}
+
+	shard, err := listedShard(cluster, shardID)
+	if err != nil {
+		return nil, ErrUnknownShard
+	}
+
+	m := newMover(ctx, metaClient, cluster, &drainPlanner{
+		cluster:      cluster,
+		shard:        shard.Identifier(),
+		maxPerDomain: cfg.MaxPerDomain,
+	}, cfg, &Report{
+		DryRun: cfg.DryRun,
+		Shard:  shardID,
+	})
+	if cfg.ProgressInterval > 0 {
+		done := make(chan struct{})
+		defer close(done)
+		go m.logProgress(done, cfg.ProgressInterval)
+	}
+
+	err = m.moveAll()
+	return m.progress(), err
+}
+
+// placeObject returns the shards of the given iterator the given object can be moved to,
+// without storing more than maxPerDomain objects of its chunk within a single failure domain,
+// in order of the diversity of their domains, preferring the order of the iterator in case of a tie.
+func placeObject(cluster datastor.Cluster, it datastor.ShardIterator, object metatypes.Object, chunkObjects []metatypes.Object, maxPerDomain int) []datastor.Shard {
+	var placed []datastor.Topology
+	for _, other := range chunkObjects {
+		if other.ShardID == object.ShardID && bytes.Equal(other.Key, object.Key) {
+			continue // the object itself is moved away
+		}
+		shard, err := listedShard(cluster, other.ShardID)
+		if err != nil {
+			log.Errorf("drain: failed to get the topology of shard %q: %v", other.ShardID, err)
+			continue
+		}
+		placed = append(placed, shard.Topology())
+	}
+
+	// only one of the returned shards is used,
+	// hence each of them is placed on its own
+	var shards []datastor.Shard
+	pit := datastor.NewPlacementShardIterator(it, placed, maxPerDomain)
+	for pit.Next() {
+		shards = append(shards, pit.Shard())
+		pit.Release(pit.Shard())
+	}
+	return shards
+}
+
+// listedShard returns the listed shard of the given cluster with the given identifier,
+// regardless of its health in case the cluster supports it,
+// such that an unhealthy shard can still be drained.
+func listedShard(cluster datastor.Cluster, id string) (datastor.Shard, error) {
+	if listed, ok := cluster.(datastor.ListedCluster); ok {
+		return listed.GetListedShard(id)
+	}
+	for _, health := range cluster.GetShardHealth() {
+		if health.Shard == id {
+			return cluster.GetShard(id)
+		}
+	}
+	return nil, ErrUnknownShard
+}
+
+// drainPlanner moves all objects stored on the drained shard,
+// to any other shard of the cluster which respects the failure domains of the chunk.
+type drainPlanner struct {
+	cluster      datastor.Cluster
+	shard        string
+	maxPerDomain int
+}
+
+// plan implements planner.plan
+func (p *drainPlanner) plan(object metatypes.Object, chunkObjects []metatypes.Object) ([]datastor.Shard, error) {
+	if object.ShardID != p.shard {
+		return nil, nil
+	}
+	exceptShards := []string{p.shard}
+	for _, other := range chunkObjects {
+		exceptShards = append(exceptShards, other.ShardID)
+	}
+	shards := placeObject(p.cluster, p.cluster.GetShardIterator(exceptShards),
+		object, chunkObjects, p.maxPerDomain)
+	if len(shards) == 0 {
+		return nil, ErrNoShardAvailable
+	}
+	return shards, nil
+}
+
+// moved implements planner.moved
+func (p *drainPlanner) moved(metatypes.Object, datastor.Shard) {}
@@ -0,0 +1,405 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/threefoldtech/0-stor/client"
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
+	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/db/test"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrain(t *testing.T) {
+	require := require.New(t)
+
+	cluster, servers, cleanup := newTestCluster(t, 5)
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	dataPipeline, err := pipeline.NewPipeline(pipeline.Config{
+		BlockSize: 64,
+		Distribution: pipeline.ObjectDistributionConfig{
+			DataShardCount:   2,
+			ParityShardCount: 1,
+		},
+	}, cluster, -1)
+	require.NoError(err)
+	c := client.NewClient(metaClient, dataPipeline)
+	require.NoError(c.SetOverwriteConfig(client.OverwriteConfig{Mode: client.OverwriteModeKeep}))
+	require.NoError(c.SetDeduplication(true))
+
+	// current metadata, sharing its chunks with another key,
+	// an older version and an upload session
+	data := bytes.Repeat([]byte("0-stor"), 100)
+	_, err = c.Write([]byte("foo"), bytes.NewReader([]byte("old data")))
+	require.NoError(err)
+	_, err = c.Write([]byte("foo"), bytes.NewReader(data))
+	require.NoError(err)
+	_, err = c.Write([]byte("bar"), bytes.NewReader(data))
+	require.NoError(err)
+	uploadID, err := c.CreateUpload([]byte("baz"), client.CreateOptions{})
+	require.NoError(err)
+	_, err = c.UploadPart(uploadID, 0, bytes.NewReader(data))
+	require.NoError(err)
+
+	// as only a few chunks are stored, drain the shard which stores most of them
+	shard := mostUsedServer(servers)
+	shardID := "ns@" + shard.addr
+	onShard := len(shard.Keys())
+	require.True(onShard > 0)
+	totalObjects := countObjects(servers)
+
+	// nothing is moved during a dry run
+	report, err := Drain(context.Background(), metaClient, cluster, shardID, Config{DryRun: true})
+	require.NoError(err)
+	require.True(report.DryRun)
+	require.Equal(shardID, report.Shard)
+	require.Empty(report.Failures)
+	require.True(report.Drained > 0)
+	require.Equal(onShard, report.MovedObjects)
+	require.Zero(report.MovedBytes)
+	require.Equal(totalObjects, countObjects(servers))
+
+	// all referenced objects are moved, and all metadata updated
+	report, err = Drain(context.Background(), metaClient, cluster, shardID, Config{})
+	require.NoError(err)
+	require.Empty(report.Failures)
+	require.Equal(onShard, report.MovedObjects)
+	require.True(report.MovedBytes > 0)
+	require.Equal(totalObjects+onShard, countObjects(servers))
+
+	// the shard is no longer used, and can be decommissioned
+	drained, err := cluster.GetShard(shardID)
+	require.NoError(err)
+	for _, key := range shard.Keys() {
+		require.NoError(drained.DeleteObject([]byte(key)))
+	}
+	for _, md := range allMetadata(t, metaClient, uploadID) {
+		for _, chunk := range md.Chunks {
+			shards := make(map[string]struct{})
+			for _, object := range chunk.Objects {
+				require.NotEqual(shardID, object.ShardID)
+				shards[object.ShardID] = struct{}{}
+			}
+			// the distribution layout is respected
+			require.Len(shards, len(chunk.Objects))
+		}
+		if len(md.Key) == 0 {
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		require.NoError(c.Read(*md, buf))
+		require.NotEmpty(buf.Bytes())
+	}
+
+	// the drain can be resumed, only draining what is left
+	report, err = Drain(context.Background(), metaClient, cluster, shardID, Config{})
+	require.NoError(err)
+	require.Zero(report.Drained)
+	require.Zero(report.MovedObjects)
+}
+
+func TestDrainFailureDomains(t *testing.T) {
+	require := require.New(t)
+
+	cluster, servers, cleanup := newZoneTestCluster(t, "a", "a", "b", "b", "c", "c")
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	distribution := pipeline.ObjectDistributionConfig{
+		DataShardCount:   2,
+		ParityShardCount: 1,
+	}
+	dataPipeline, err := pipeline.NewPipeline(pipeline.Config{
+		BlockSize:    64,
+		Distribution: distribution,
+	}, cluster, -1)
+	require.NoError(err)
+	c := client.NewClient(metaClient, dataPipeline)
+	for i := 0; i < 10; i++ {
+		_, err = c.Write([]byte(fmt.Sprintf("key%d", i)), bytes.NewReader(bytes.Repeat([]byte("0-stor"), 100)))
+		require.NoError(err)
+	}
+
+	shard := servers[0]
+	shardID := "ns@" + shard.addr
+	onShard := len(shard.Keys())
+	require.True(onShard > 0)
+	onOtherShard := len(servers[1].Keys())
+
+	// the objects can only be moved to the other shard of the same zone,
+	// as a zone can't hold more than a single part of a chunk
+	require.Equal(1, distribution.MaxShardsPerDomain())
+	report, err := Drain(context.Background(), metaClient, cluster, shardID, Config{
+		MaxPerDomain: distribution.MaxShardsPerDomain(),
+	})
+	require.NoError(err)
+	require.Empty(report.Failures)
+	require.Equal(onShard, report.MovedObjects)
+	require.Len(servers[1].Keys(), onOtherShard+onShard)
+
+	zones := make(map[string]string)
+	for _, server := range servers {
+		shard, err := cluster.GetShard("ns@" + server.addr)
+		require.NoError(err)
+		zones[shard.Identifier()] = shard.Topology().Zone
+	}
+	for _, md := range allMetadata(t, metaClient, "") {
+		for _, chunk := range md.Chunks {
+			used := make(map[string]struct{})
+			for _, object := range chunk.Objects {
+				require.NotEqual(shardID, object.ShardID)
+				used[zones[object.ShardID]] = struct{}{}
+			}
+			require.Len(used, len(chunk.Objects))
+		}
+	}
+}
+
+func TestDrainUnhealthyShard(t *testing.T) {
+	require := require.New(t)
+
+	listed, servers, cleanup := newTestCluster(t, 4)
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	dataPipeline, err := pipeline.NewPipeline(pipeline.Config{
+		BlockSize: 64,
+		Distribution: pipeline.ObjectDistributionConfig{
+			DataShardCount:   2,
+			ParityShardCount: 1,
+		},
+	}, listed, -1)
+	require.NoError(err)
+	c := client.NewClient(metaClient, dataPipeline)
+	_, err = c.Write([]byte("foo"), bytes.NewReader(bytes.Repeat([]byte("0-stor"), 100)))
+	require.NoError(err)
+
+	shard := servers[0]
+	shardID := "ns@" + shard.addr
+	onShard := len(shard.Keys())
+	require.True(onShard > 0)
+
+	// the failing shard is no longer handed out by its cluster, but can still be drained
+	cluster := &unhealthyCluster{Cluster: listed, shard: shardID}
+	_, err = cluster.GetShard(shardID)
+	require.Equal(datastor.ErrShardUnhealthy, err)
+	report, err := Drain(context.Background(), metaClient, cluster, shardID, Config{})
+	require.NoError(err)
+	require.Empty(report.Failures)
+	require.Equal(onShard, report.MovedObjects)
+
+	md, err := metaClient.GetMetadata([]byte("foo"))
+	require.NoError(err)
+	for _, chunk := range md.Chunks {
+		for _, object := range chunk.Objects {
+			require.NotEqual(shardID, object.ShardID)
+		}
+	}
+}
+
+func TestDrainNoShardAvailable(t *testing.T) {
+	require := require.New(t)
+
+	cluster, servers, cleanup := newTestCluster(t, 3)
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	dataPipeline, err := pipeline.NewPipeline(pipeline.Config{
+		BlockSize: 64,
+		Distribution: pipeline.ObjectDistributionConfig{
+			DataShardCount:   2,
+			ParityShardCount: 1,
+		},
+	}, cluster, -1)
+	require.NoError(err)
+	c := client.NewClient(metaClient, dataPipeline)
+	md, err := c.Write([]byte("foo"), bytes.NewReader([]byte("data")))
+	require.NoError(err)
+
+	// all shards are used by the chunk already
+	report, err := Drain(context.Background(), metaClient, cluster, "ns@"+servers[0].addr, Config{})
+	require.NoError(err)
+	require.Len(report.Failures, 1)
+	require.Equal(`key "foo"`, report.Failures[0].Metadata)
+	require.Equal(ErrNoShardAvailable, report.Failures[0].Error)
+	stored, err := metaClient.GetMetadata([]byte("foo"))
+	require.NoError(err)
+	require.Equal(md.Chunks, stored.Chunks)
+}
+
+func TestDrainErrors(t *testing.T) {
+	require := require.New(t)
+
+	cluster, _, cleanup := newTestCluster(t, 1)
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	_, err = Drain(nil, metaClient, cluster, "", Config{})
+	require.Equal(ErrNilContext, err)
+	_, err = Drain(context.Background(), nil, cluster, "", Config{})
+	require.Equal(ErrNoMetastorClient, err)
+	_, err = Drain(context.Background(), metaClient, nil, "", Config{})
+	require.Equal(ErrNoCluster, err)
+	_, err = Drain(context.Background(), metaClient, cluster, "ns@unknown:1234", Config{})
+	require.Equal(ErrUnknownShard, err)
+}
+
+// unhealthyCluster is a cluster which considers one of its listed shards unhealthy,
+// such that it is no longer handed out, just like a zerodb cluster would.
+type unhealthyCluster struct {
+	*zerodb.Cluster
+	shard string
+}
+
+func (c *unhealthyCluster) GetShard(id string) (datastor.Shard, error) {
+	if id == c.shard {
+		return nil, datastor.ErrShardUnhealthy
+	}
+	return c.Cluster.GetShard(id)
+}
+
+func (c *unhealthyCluster) GetShardIterator(exceptShards []string) datastor.ShardIterator {
+	return c.Cluster.GetShardIterator(append(exceptShards, c.shard))
+}
+
+type testServer struct {
+	*zdbtest.InMem0DBServer
+	addr string
+}
+
+func newTestCluster(t *testing.T, n int) (*zerodb.Cluster, []*testServer, func()) {
+	return newZoneTestCluster(t, make([]string, n)...)
+}
+
+// newZoneTestCluster creates a cluster with a shard for each of the given zones,
+// leaving the shards for empty zones unlabeled.
+func newZoneTestCluster(t *testing.T, zones ...string) (*zerodb.Cluster, []*testServer, func()) {
+	require := require.New(t)
+
+	var (
+		servers  []*testServer
+		shards   []datastor.ShardConfig
+		cleanups []func()
+	)
+	for _, zone := range zones {
+		server, addr, cleanup, err := zdbtest.NewInMem0DBServer("ns")
+		require.NoError(err)
+		cleanups = append(cleanups, cleanup)
+		servers = append(servers, &testServer{InMem0DBServer: server, addr: addr})
+		shards = append(shards, datastor.ShardConfig{Address: addr, Zone: zone})
+	}
+	cluster, err := zerodb.NewCluster(shards, "", "ns", nil, datastor.SpreadingTypeRandom)
+	require.NoError(err)
+
+	return cluster, servers, func() {
+		cluster.Close()
+		for _, cleanup := range cleanups {
+			cleanup()
+		}
+	}
+}
+
+// mostUsedServer returns the server storing the most objects
+func mostUsedServer(servers []*testServer) *testServer {
+	most := servers[0]
+	for _, server := range servers[1:] {
+		if len(server.Keys()) > len(most.Keys()) {
+			most = server
+		}
+	}
+	return most
+}
+
+func countObjects(servers []*testServer) int {
+	var count int
+	for _, server := range servers {
+		count += len(server.Keys())
+	}
+	return count
+}
+
+// allMetadata returns all current metadata, older versions,
+// the first part of the given upload session (if any) and indexed chunks,
+// the latter stored as metadata without a key.
+func allMetadata(t *testing.T, metaClient *metastor.Client, uploadID string) []*metatypes.Metadata {
+	require := require.New(t)
+
+	var all []*metatypes.Metadata
+	var keys [][]byte
+	require.NoError(metaClient.ListKeys(func(key []byte) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	for _, key := range keys {
+		md, err := metaClient.GetMetadata(key)
+		require.NoError(err)
+		all = append(all, md)
+		var versions []int64
+		require.NoError(metaClient.ListVersions(key, func(_ []byte, version int64) error {
+			versions = append(versions, version)
+			return nil
+		}))
+		for _, version := range versions {
+			md, err := metaClient.GetVersion(key, version)
+			require.NoError(err)
+			all = append(all, md)
+		}
+	}
+	if uploadID != "" {
+		md, err := metaClient.GetUploadPart(uploadID, 0)
+		require.NoError(err)
+		all = append(all, md)
+	}
+	var hashes [][]byte
+	require.NoError(metaClient.ListChunks(func(hash []byte) error {
+		hashes = append(hashes, hash)
+		return nil
+	}))
+	for _, hash := range hashes {
+		chunk, _, err := metaClient.GetChunk(hash)
+		require.NoError(err)
+		all = append(all, &metatypes.Metadata{Chunks: []metatypes.Chunk{*chunk}})
+	}
+	return all
+}
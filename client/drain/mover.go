@@ -0,0 +1,447 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+// planner decides which objects are moved, and to which shards.
+type planner interface {
+	// plan returns the shards the given object can be moved to, in order of preference,
+	// none of which is used by the given objects of the chunk the object belongs to,
+	// or nil in case the object doesn't have to be moved.
+	plan(object metatypes.Object, chunkObjects []metatypes.Object) ([]datastor.Shard, error)
+	// moved is called for each object moved to the given shard,
+	// or which would have been moved in case of a dry run.
+	moved(object metatypes.Object, shard datastor.Shard)
+}
+
+// mover moves the objects selected by its planner,
+// updating all metadata which references them,
+// while keeping track of the objects it moved,
+// such that objects shared by multiple chunks (e.g. due to deduplication),
+// are only moved once.
+type mover struct {
+	ctx        context.Context
+	metaClient *metastor.Client
+	cluster    datastor.Cluster
+	planner    planner
+	limiter    *rate.Limiter
+	dryRun     bool
+
+	// maps the moved objects to the objects they were moved to
+	moved map[objectID]metatypes.Object
+
+	mux    sync.Mutex
+	report *Report
+}
+
+// objectID identifies an object within a cluster
+type objectID struct {
+	shard string
+	key   string
+}
+
+func newMover(ctx context.Context, metaClient *metastor.Client, cluster datastor.Cluster, planner planner, cfg Config, report *Report) *mover {
+	m := &mover{
+		ctx:        ctx,
+		metaClient: metaClient,
+		cluster:    cluster,
+		planner:    planner,
+		dryRun:     cfg.DryRun,
+		moved:      make(map[objectID]metatypes.Object),
+		report:     report,
+	}
+	if cfg.BytesPerSecond > 0 {
+		m.limiter = rate.NewLimiter(rate.Limit(cfg.BytesPerSecond), cfg.BytesPerSecond)
+	}
+	return m
+}
+
+var (
+	// errNotReferenced is used to interrupt the update of metadata,
+	// which doesn't reference any object that has to be moved
+	errNotReferenced = errors.New("metadata doesn't reference any object to move")
+	// errDryRun is used to interrupt the update of metadata,
+	// which references objects that have to be moved, during a dry run
+	errDryRun = errors.New("metadata isn't updated during a dry run")
+)
+
+// item is any metadata which references objects,
+// be it current metadata, an older version, a part of an upload session or an indexed chunk.
+type item struct {
+	description string
+	// get returns the metadata
+	get func() (*metatypes.Metadata, error)
+	// update updates the metadata using the given callback,
+	// which can return errNotReferenced or errDryRun to leave the metadata as is.
+	update func(cb metastor.UpdateMetadataFunc) error
+}
+
+// items lists all metadata stored in the namespace of the metastor client,
+// including older versions, the parts of upload sessions and the indexed chunks.
+func (m *mover) items() ([]item, error) {
+	var items []item
+
+	// collect the keys first, as the metadata database
+	// might not support nested operations from within the list callback
+	err := m.metaClient.ListKeys(func(key []byte) error {
+		if err := m.ctx.Err(); err != nil {
+			return err
+		}
+		items = append(items, item{
+			description: fmt.Sprintf("key %q", key),
+			get: func() (*metatypes.Metadata, error) {
+				return m.metaClient.GetMetadata(key)
+			},
+			update: func(cb metastor.UpdateMetadataFunc) error {
+				// current metadata is updated atomically
+				_, err := m.metaClient.UpdateMetadata(key, cb)
+				return err
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list metadata keys: %v", err)
+	}
+
+	err = m.metaClient.ListAllVersions(func(key []byte, version int64) error {
+		if err := m.ctx.Err(); err != nil {
+			return err
+		}
+		items = append(items, item{
+			description: fmt.Sprintf("version %d of key %q", version, key),
+			get: func() (*metatypes.Metadata, error) {
+				return m.metaClient.GetVersion(key, version)
+			},
+			update: func(cb metastor.UpdateMetadataFunc) error {
+				// a version which got deleted in the meantime is never stored again
+				_, err := m.metaClient.UpdateVersion(key, version, cb)
+				return err
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list metadata versions: %v", err)
+	}
+
+	var uploadIDs []string
+	err = m.metaClient.ListUploads(func(uploadID string) error {
+		if err := m.ctx.Err(); err != nil {
+			return err
+		}
+		uploadIDs = append(uploadIDs, uploadID)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upload sessions: %v", err)
+	}
+	for _, uploadID := range uploadIDs {
+		uploadID := uploadID
+		err = m.metaClient.ListUploadParts(uploadID, func(part int64) error {
+			items = append(items, item{
+				description: fmt.Sprintf("part %d of upload session %s", part, uploadID),
+				get: func() (*metatypes.Metadata, error) {
+					return m.metaClient.GetUploadPart(uploadID, part)
+				},
+				update: func(cb metastor.UpdateMetadataFunc) error {
+					// the part of a session which got completed or aborted
+					// in the meantime is never stored again
+					_, err := m.metaClient.UpdateUploadPart(uploadID, part, cb)
+					return err
+				},
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list parts of upload session %s: %v", uploadID, err)
+		}
+	}
+
+	err = m.metaClient.ListChunks(func(hash []byte) error {
+		if err := m.ctx.Err(); err != nil {
+			return err
+		}
+		// indexed chunks are handled as metadata with a single chunk
+		get := func() (*metatypes.Metadata, error) {
+			chunk, _, err := m.metaClient.GetChunk(hash)
+			if err != nil {
+				return nil, err
+			}
+			return &metatypes.Metadata{Chunks: []metatypes.Chunk{*chunk}}, nil
+		}
+		items = append(items, item{
+			description: fmt.Sprintf("indexed chunk %x", hash),
+			get:         get,
+			update: func(cb metastor.UpdateMetadataFunc) error {
+				md, err := get()
+				if err != nil {
+					return err
+				}
+				chunk := md.Chunks[0]
+				md, err = cb(*md)
+				if err != nil {
+					return err
+				}
+				// the indexed chunk keeps its references,
+				// and is only replaced in case it wasn't replaced in the meantime
+				return m.metaClient.ReplaceChunk(chunk, md.Chunks[0])
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexed chunks: %v", err)
+	}
+
+	return items, nil
+}
+
+// moveAll moves the objects of all metadata, as planned,
+// updating each metadata once all its objects have been moved.
+func (m *mover) moveAll() error {
+	items, err := m.items()
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := m.ctx.Err(); err != nil {
+			return err
+		}
+		err := item.update(func(md metatypes.Metadata) (*metatypes.Metadata, error) {
+			chunks, err := m.moveChunks(md.Chunks)
+			if err != nil {
+				return nil, err
+			}
+			if chunks == nil {
+				return nil, errNotReferenced
+			}
+			if m.dryRun {
+				return nil, errDryRun
+			}
+			md.Chunks = chunks
+			return &md, nil
+		})
+		m.finish(item.description, err)
+	}
+	return nil
+}
+
+// references returns all objects referenced by any metadata.
+func (m *mover) references() (map[objectID]struct{}, error) {
+	items, err := m.items()
+	if err != nil {
+		return nil, err
+	}
+	refs := make(map[objectID]struct{})
+	for _, item := range items {
+		if err := m.ctx.Err(); err != nil {
+			return nil, err
+		}
+		md, err := item.get()
+		if err != nil {
+			if err == metastor.ErrNotFound {
+				// metadata was deleted in the meantime
+				continue
+			}
+			return nil, fmt.Errorf("failed to get %s: %v", item.description, err)
+		}
+		for _, chunk := range md.Chunks {
+			for _, object := range chunk.Objects {
+				refs[objectID{shard: object.ShardID, key: string(object.Key)}] = struct{}{}
+			}
+		}
+	}
+	return refs, nil
+}
+
+// finish adds the result of updating the described metadata to the report.
+func (m *mover) finish(description string, err error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	switch err {
+	case nil, errDryRun:
+		m.report.Scanned++
+		m.report.Drained++
+	case errNotReferenced:
+		m.report.Scanned++
+	case metastor.ErrNotFound:
+		// metadata was deleted in the meantime
+	default:
+		m.report.Scanned++
+		m.fail(description, err)
+	}
+}
+
+// fail adds a failure to the report, the lock has to be held by the caller
+func (m *mover) fail(description string, err error) {
+	log.Errorf("drain: failed to move objects of %s: %v", description, err)
+	m.report.Failures = append(m.report.Failures, Failure{
+		Metadata: description,
+		Error:    err,
+	})
+}
+
+// moveChunks returns copies of the given chunks,
+// of which all objects that have to be moved have been moved,
+// or nil in case none of the objects of the chunks has to be moved.
+func (m *mover) moveChunks(chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
+	var moved []metatypes.Chunk
+	for index, chunk := range chunks {
+		var objects []metatypes.Object
+		for i, object := range chunk.Objects {
+			chunkObjects := chunk.Objects
+			if objects != nil {
+				chunkObjects = objects
+			}
+			target, ok, err := m.moveObject(object, chunkObjects)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			if objects == nil {
+				objects = make([]metatypes.Object, len(chunk.Objects))
+				copy(objects, chunk.Objects)
+			}
+			objects[i] = target
+		}
+		if objects == nil {
+			continue
+		}
+		if moved == nil {
+			moved = make([]metatypes.Chunk, len(chunks))
+			copy(moved, chunks)
+		}
+		moved[index].Objects = objects
+	}
+	return moved, nil
+}
+
+// moveObject moves the given object to one of the shards planned for it,
+// returning the moved object, and true in case it was moved.
+// Objects which were moved already are not moved again.
+func (m *mover) moveObject(object metatypes.Object, chunkObjects []metatypes.Object) (metatypes.Object, bool, error) {
+	id := objectID{shard: object.ShardID, key: string(object.Key)}
+	if moved, ok := m.moved[id]; ok {
+		return moved, true, nil
+	}
+	shards, err := m.planner.plan(object, chunkObjects)
+	if err != nil || len(shards) == 0 {
+		return object, false, err
+	}
+	if m.dryRun {
+		m.moved[id] = object
+		m.planner.moved(object, shards[0])
+		m.mux.Lock()
+		m.report.MovedObjects++
+		m.mux.Unlock()
+		return object, true, nil
+	}
+
+	source, err := listedShard(m.cluster, object.ShardID)
+	if err != nil {
+		return object, false, err
+	}
+	stored, err := source.GetObjectContext(m.ctx, object.Key)
+	if err != nil {
+		return object, false, fmt.Errorf("failed to get object %q: %v", object.Key, err)
+	}
+	if m.limiter != nil {
+		err = waitN(m.ctx, m.limiter, len(stored.Data))
+		if err != nil {
+			return object, false, err
+		}
+	}
+
+	for _, shard := range shards {
+		key, err := shard.CreateObjectContext(m.ctx, stored.Data)
+		if err != nil {
+			if err := m.ctx.Err(); err != nil {
+				return object, false, err
+			}
+			log.Errorf("drain: failed to move object %q to shard %s: %v",
+				object.Key, shard.Identifier(), err)
+			continue
+		}
+		moved := metatypes.Object{Key: key, ShardID: shard.Identifier()}
+		m.moved[id] = moved
+		m.planner.moved(object, shard)
+		m.mux.Lock()
+		m.report.MovedObjects++
+		m.report.MovedBytes += int64(len(stored.Data))
+		m.mux.Unlock()
+		return moved, true, nil
+	}
+	return object, false, ErrNoShardAvailable
+}
+
+// waitN waits until n bytes can be processed according to the given limiter,
+// which can't wait for more bytes than its burst at once.
+func waitN(ctx context.Context, limiter *rate.Limiter, n int) error {
+	for n > 0 {
+		size := n
+		if size > limiter.Burst() {
+			size = limiter.Burst()
+		}
+		err := limiter.WaitN(ctx, size)
+		if err != nil {
+			return err
+		}
+		n -= size
+	}
+	return nil
+}
+
+// progress returns a copy of the current report
+func (m *mover) progress() *Report {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	report := *m.report
+	report.Failures = append([]Failure(nil), m.report.Failures...)
+	return &report
+}
+
+// logProgress logs the progress of the mover,
+// at the given interval, until the given channel is closed.
+func (m *mover) logProgress(done <-chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			log.Infof("drain: %s", m.progress())
+		}
+	}
+}
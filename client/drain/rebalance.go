@@ -0,0 +1,333 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultTolerance is the tolerance used,
+// in case no tolerance is defined in the RebalanceConfig.
+const DefaultTolerance = 0.05
+
+// RebalanceConfig defines the configuration of a rebalance.
+type RebalanceConfig struct {
+	Config
+
+	// Tolerance defines the fraction by which the utilization of a shard
+	// can exceed its target utilization, before objects are moved away from it.
+	// DefaultTolerance is used in case the tolerance is zero,
+	// use a negative tolerance to move objects from any shard above its target.
+	Tolerance float64
+}
+
+// RebalanceReport is the (intermediate) result of a rebalance.
+type RebalanceReport struct {
+	Report
+
+	// Shards contains the utilization of all shards of the cluster,
+	// sorted by identifier.
+	Shards []ShardUsage
+
+	// DeletedObjects is the amount of moved objects,
+	// which were deleted from their original shard,
+	// as they were no longer referenced once the metadata was updated.
+	DeletedObjects int
+}
+
+// ShardUsage defines the utilization of a shard.
+type ShardUsage struct {
+	// Shard is the identifier of the shard.
+	Shard string
+	// Used is the amount of bytes used by the shard, prior to the rebalance.
+	Used int64
+	// Target is the amount of bytes the shard should use, once balanced.
+	Target int64
+	// Balanced is the amount of bytes used by the shard, once all planned objects are moved,
+	// not taking into account objects moved for metadata which couldn't be updated.
+	Balanced int64
+}
+
+// String implements Stringer.String
+func (report *RebalanceReport) String() string {
+	return fmt.Sprintf(
+		"rebalance: %d scanned, %d updated, %d failed, %d objects moved (%d bytes), %d deleted",
+		report.Scanned, report.Drained, len(report.Failures),
+		report.MovedObjects, report.MovedBytes, report.DeletedObjects)
+}
+
+// Rebalance moves objects from the over-full shards of the given cluster to its under-used shards,
+// such that all shards are utilized according to their target utilization,
+// updating all metadata stored in the namespace of the given metastor client accordingly.
+//
+// The target utilization of each shard is computed from the namespace stats of all shards,
+// proportional to their capacity in case all shards define a limit,
+// and equal for all shards otherwise.
+// A shard is over-full when it uses more than its target (plus the tolerance),
+// in which case objects are moved away from it until it reaches its target.
+// Objects are moved to shards which have room left below their target,
+// and which aren't used yet by the chunk the object belongs to,
+// preferring the shards which spread the objects of that chunk over the most failure domains,
+// and the least utilized shards in case of a tie.
+//
+// Moved objects which are no longer referenced once all metadata has been updated,
+// are deleted from their original shard. Objects referenced by metadata
+// which couldn't be updated are kept, and the copies made for it are left behind as orphans,
+// which are deleted by the garbage collector.
+//
+// An error is only returned in case the shards or metadata couldn't be listed,
+// or the context got cancelled, in which case the returned report is incomplete.
+// Errors which occur while updating metadata are part of the report,
+// and do not abort the rebalance.
+func Rebalance(ctx context.Context, metaClient *metastor.Client, cluster datastor.Cluster, cfg RebalanceConfig) (*RebalanceReport, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if metaClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+	if cluster == nil {
+		return nil, ErrNoCluster
+	}
+	tolerance := cfg.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	} else if tolerance < 0 {
+		tolerance = 0
+	}
+
+	p, err := newRebalancePlanner(ctx, cluster, tolerance, cfg.MaxPerDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	m := newMover(ctx, metaClient, cluster, p, cfg.Config, &Report{DryRun: cfg.DryRun})
+	if cfg.ProgressInterval > 0 {
+		done := make(chan struct{})
+		defer close(done)
+		go m.logProgress(done, cfg.ProgressInterval)
+	}
+
+	report := &RebalanceReport{}
+	if p.overFull() {
+		err = m.moveAll()
+		if err == nil && !cfg.DryRun {
+			report.DeletedObjects, err = deleteMoved(m)
+		}
+	}
+	report.Report = *m.progress()
+	report.Shards = p.usage()
+	return report, err
+}
+
+// deleteMoved deletes all objects moved by the given mover,
+// which are no longer referenced by any metadata,
+// returning the amount of deleted objects.
+func deleteMoved(m *mover) (int, error) {
+	refs, err := m.references()
+	if err != nil {
+		return 0, err
+	}
+	var deleted int
+	for id := range m.moved {
+		if _, ok := refs[id]; ok {
+			continue
+		}
+		shard, err := m.cluster.GetShard(id.shard)
+		if err == nil {
+			err = shard.DeleteObjectContext(m.ctx, []byte(id.key))
+		}
+		if err != nil {
+			if err := m.ctx.Err(); err != nil {
+				return deleted, err
+			}
+			log.Errorf("drain: failed to delete moved object %q from shard %s: %v", id.key, id.shard, err)
+			continue
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// rebalancePlanner moves objects from over-full shards,
+// to the least utilized shards which are below their target,
+// and which respect the failure domains of the chunk.
+type rebalancePlanner struct {
+	cluster      datastor.Cluster
+	maxPerDomain int
+	shards       map[string]*shardUsage
+	// sizes of the objects stored on over-full shards
+	sizes map[objectID]int64
+}
+
+// shardUsage tracks the utilization of a shard,
+// updated as objects are moved from and to it.
+type shardUsage struct {
+	shard    datastor.Shard
+	initial  int64
+	capacity int64
+	used     int64
+	target   int64
+	overFull bool
+}
+
+func newRebalancePlanner(ctx context.Context, cluster datastor.Cluster, tolerance float64, maxPerDomain int) (*rebalancePlanner, error) {
+	p := &rebalancePlanner{
+		cluster:      cluster,
+		maxPerDomain: maxPerDomain,
+		shards:       make(map[string]*shardUsage),
+		sizes:        make(map[objectID]int64),
+	}
+
+	var (
+		totalUsed, totalCapacity int64
+		limited                  = true
+	)
+	it := cluster.GetShardIterator(nil)
+	for it.Next() {
+		shard := it.Shard()
+		ns, err := shard.GetNamespaceContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get namespace of shard %s: %v", shard.Identifier(), err)
+		}
+		p.shards[shard.Identifier()] = &shardUsage{
+			shard:    shard,
+			initial:  ns.Used,
+			capacity: ns.Used + ns.Free,
+			used:     ns.Used,
+		}
+		totalUsed += ns.Used
+		totalCapacity += ns.Used + ns.Free
+		if ns.Free <= 0 {
+			limited = false
+		}
+	}
+	if len(p.shards) == 0 {
+		return p, nil
+	}
+
+	for _, usage := range p.shards {
+		if limited {
+			usage.target = int64(float64(totalUsed) * float64(usage.capacity) / float64(totalCapacity))
+		} else {
+			usage.target = totalUsed / int64(len(p.shards))
+		}
+		usage.overFull = float64(usage.used) > float64(usage.target)*(1+tolerance)
+		if !usage.overFull {
+			continue
+		}
+		ch, err := usage.shard.ListObjectKeyIterator(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects of shard %s: %v", usage.shard.Identifier(), err)
+		}
+		for result := range ch {
+			if result.Error != nil {
+				return nil, fmt.Errorf("failed to list objects of shard %s: %v",
+					usage.shard.Identifier(), result.Error)
+			}
+			p.sizes[objectID{shard: usage.shard.Identifier(), key: string(result.Key)}] = result.Size
+		}
+	}
+	return p, nil
+}
+
+// overFull returns true in case any shard is over-full
+func (p *rebalancePlanner) overFull() bool {
+	for _, usage := range p.shards {
+		if usage.overFull {
+			return true
+		}
+	}
+	return false
+}
+
+// plan implements planner.plan
+func (p *rebalancePlanner) plan(object metatypes.Object, chunkObjects []metatypes.Object) ([]datastor.Shard, error) {
+	source, ok := p.shards[object.ShardID]
+	if !ok || !source.overFull || source.used <= source.target {
+		return nil, nil
+	}
+	size, ok := p.sizes[objectID{shard: object.ShardID, key: string(object.Key)}]
+	if !ok {
+		return nil, nil
+	}
+
+	used := make(map[string]struct{}, len(chunkObjects))
+	for _, other := range chunkObjects {
+		used[other.ShardID] = struct{}{}
+	}
+	var candidates []*shardUsage
+	for id, usage := range p.shards {
+		if _, ok := used[id]; ok {
+			continue
+		}
+		if usage.used+size > usage.target {
+			continue
+		}
+		candidates = append(candidates, usage)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].ratio() < candidates[j].ratio()
+	})
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, len(candidates))
+	for i, usage := range candidates {
+		ids[i] = usage.shard.Identifier()
+	}
+	return placeObject(p.cluster, datastor.NewLazyShardIterator(p.cluster, ids),
+		object, chunkObjects, p.maxPerDomain), nil
+}
+
+// moved implements planner.moved
+func (p *rebalancePlanner) moved(object metatypes.Object, shard datastor.Shard) {
+	size := p.sizes[objectID{shard: object.ShardID, key: string(object.Key)}]
+	p.shards[object.ShardID].used -= size
+	p.shards[shard.Identifier()].used += size
+}
+
+// usage returns the utilization of all shards, sorted by identifier
+func (p *rebalancePlanner) usage() []ShardUsage {
+	shards := make([]ShardUsage, 0, len(p.shards))
+	for id, usage := range p.shards {
+		shards = append(shards, ShardUsage{
+			Shard:    id,
+			Used:     usage.initial,
+			Target:   usage.target,
+			Balanced: usage.used,
+		})
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].Shard < shards[j].Shard
+	})
+	return shards
+}
+
+// ratio returns the utilization of the shard relative to its target
+func (usage *shardUsage) ratio() float64 {
+	return float64(usage.used) / float64(usage.target)
+}
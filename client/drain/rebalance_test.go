@@ -0,0 +1,176 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package drain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/threefoldtech/0-stor/client"
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/db/test"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebalance(t *testing.T) {
+	require := require.New(t)
+
+	cluster, servers, cleanup := newTestCluster(t, 5)
+	defer cleanup()
+
+	// only the first 3 shards are used at first
+	var shards []datastor.ShardConfig
+	for _, server := range servers[:3] {
+		shards = append(shards, datastor.ShardConfig{Address: server.addr})
+	}
+	oldCluster, err := zerodb.NewCluster(shards, "", "ns", nil, datastor.SpreadingTypeRandom)
+	require.NoError(err)
+	defer oldCluster.Close()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	newClient := func(cluster datastor.Cluster) *client.Client {
+		dataPipeline, err := pipeline.NewPipeline(pipeline.Config{
+			BlockSize: 64,
+			Distribution: pipeline.ObjectDistributionConfig{
+				DataShardCount:   2,
+				ParityShardCount: 1,
+			},
+		}, cluster, -1)
+		require.NoError(err)
+		c := client.NewClient(metaClient, dataPipeline)
+		require.NoError(c.SetOverwriteConfig(client.OverwriteConfig{Mode: client.OverwriteModeKeep}))
+		require.NoError(c.SetDeduplication(true))
+		return c
+	}
+
+	// current metadata, sharing its chunks with another key,
+	// and an older version
+	c := newClient(oldCluster)
+	data := make(map[string][]byte)
+	for i := 0; i < 10; i++ {
+		key := fmt.Sprintf("key%d", i)
+		data[key] = bytes.Repeat([]byte(key), 50)
+		_, err = c.Write([]byte(key), bytes.NewReader([]byte("old data")))
+		require.NoError(err)
+		_, err = c.Write([]byte(key), bytes.NewReader(data[key]))
+		require.NoError(err)
+	}
+	data["copy"] = data["key0"]
+	_, err = c.Write([]byte("copy"), bytes.NewReader(data["copy"]))
+	require.NoError(err)
+
+	totalObjects := countObjects(servers)
+	require.Empty(servers[3].Keys())
+	require.Empty(servers[4].Keys())
+
+	// nothing is moved during a dry run
+	report, err := Rebalance(context.Background(), metaClient, cluster, RebalanceConfig{
+		Config: Config{DryRun: true},
+	})
+	require.NoError(err)
+	require.True(report.DryRun)
+	require.Empty(report.Failures)
+	require.True(report.MovedObjects > 0)
+	require.Zero(report.DeletedObjects)
+	require.Equal(totalObjects, countObjects(servers))
+	require.Len(report.Shards, 5)
+	planned := report.MovedObjects
+
+	// objects are moved to the new shards, and deleted from the old ones
+	report, err = Rebalance(context.Background(), metaClient, cluster, RebalanceConfig{
+		Config: Config{BytesPerSecond: 1 << 20},
+	})
+	require.NoError(err)
+	require.Empty(report.Failures)
+	require.Equal(planned, report.MovedObjects)
+	require.True(report.MovedBytes > 0)
+	require.Equal(report.MovedObjects, report.DeletedObjects)
+	require.Equal(totalObjects, countObjects(servers))
+	require.NotEmpty(servers[3].Keys())
+	require.NotEmpty(servers[4].Keys())
+	for _, usage := range report.Shards {
+		if usage.Used > usage.Target {
+			// over-full shards moved objects away
+			require.True(usage.Balanced < usage.Used)
+		} else {
+			// under-used shards are filled up to their target at most
+			require.True(usage.Balanced > usage.Used)
+			require.True(usage.Balanced <= usage.Target)
+		}
+	}
+	for _, server := range servers {
+		for _, usage := range report.Shards {
+			if usage.Shard == "ns@"+server.addr {
+				require.Equal(usage.Balanced, int64(server.ItemsSize()))
+			}
+		}
+	}
+
+	// the distribution layout is respected, and all data can still be read
+	for _, md := range allMetadata(t, metaClient, "") {
+		for _, chunk := range md.Chunks {
+			shards := make(map[string]struct{})
+			for _, object := range chunk.Objects {
+				shards[object.ShardID] = struct{}{}
+			}
+			require.Len(shards, len(chunk.Objects))
+		}
+	}
+	c = newClient(cluster)
+	for key, expected := range data {
+		md, err := metaClient.GetMetadata([]byte(key))
+		require.NoError(err)
+		buf := bytes.NewBuffer(nil)
+		require.NoError(c.Read(*md, buf))
+		require.Equal(expected, buf.Bytes())
+	}
+
+	// a balanced cluster is left as is
+	report, err = Rebalance(context.Background(), metaClient, cluster, RebalanceConfig{
+		Tolerance: 0.5,
+	})
+	require.NoError(err)
+	require.Zero(report.Scanned)
+	require.Zero(report.MovedObjects)
+}
+
+func TestRebalanceErrors(t *testing.T) {
+	require := require.New(t)
+
+	cluster, _, cleanup := newTestCluster(t, 1)
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	_, err = Rebalance(nil, metaClient, cluster, RebalanceConfig{})
+	require.Equal(ErrNilContext, err)
+	_, err = Rebalance(context.Background(), nil, cluster, RebalanceConfig{})
+	require.Equal(ErrNoMetastorClient, err)
+	_, err = Rebalance(context.Background(), metaClient, nil, RebalanceConfig{})
+	require.Equal(ErrNoCluster, err)
+}
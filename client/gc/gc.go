@@ -0,0 +1,560 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package gc provides a garbage collector,
+// which removes orphaned objects from the shards of a 0-stor cluster.
+//
+// An object is orphaned when it is stored on a shard,
+// while no metadata, stored in the metastor namespace, references it.
+// This can happen for example when a write failed partway,
+// or when the metadata of a key got overwritten by a new write.
+//
+// Objects uploaded as part of an upload session are referenced by that session,
+// until it expires, at which point the session is deleted as well.
+//
+// Objects of chunks indexed for deduplication are referenced by that index,
+// for as long as any metadata references the indexed chunk.
+package gc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultGracePeriod is the grace period used,
+// in case no grace period is defined in the Config.
+const DefaultGracePeriod = 24 * time.Hour
+
+// DefaultUploadExpiration is the upload expiration used,
+// in case no upload expiration is defined in the Config.
+const DefaultUploadExpiration = 7 * 24 * time.Hour
+
+var (
+	// ErrNilContext is returned in case no context is given.
+	ErrNilContext = errors.New("gc: nil context")
+	// ErrNoMetastorClient is returned in case no metastor client is given.
+	ErrNoMetastorClient = errors.New("gc: no metastor client given")
+	// ErrNoCluster is returned in case no datastor cluster is given.
+	ErrNoCluster = errors.New("gc: no datastor cluster given")
+)
+
+// Config defines the configuration of a garbage collection.
+type Config struct {
+	// GracePeriod defines the minimum age of an orphaned object,
+	// before it is considered for deletion.
+	// Objects of a write which is still in progress do not have metadata yet,
+	// and would be considered orphaned without this grace period,
+	// hence it should be longer than the longest write you expect.
+	// DefaultGracePeriod is used in case the grace period is zero,
+	// use a negative grace period to disable it.
+	GracePeriod time.Duration
+
+	// UploadExpiration defines how long an upload session can remain inactive,
+	// before it is considered abandoned, in which case the session is deleted,
+	// and the objects of its parts are no longer referenced.
+	// DefaultUploadExpiration is used in case the upload expiration is zero,
+	// use a negative upload expiration to never expire upload sessions.
+	UploadExpiration time.Duration
+
+	// DryRun can be enabled in order to collect the orphaned objects,
+	// and expired upload sessions, without deleting any of them.
+	DryRun bool
+}
+
+// Report is the result of a garbage collection.
+type Report struct {
+	// DryRun is true in case no objects or upload sessions were deleted.
+	DryRun bool
+	// GracePeriod is the grace period that was applied.
+	GracePeriod time.Duration
+	// MetadataCount is the amount of metadata found in the metastor namespace,
+	// including older versions of metadata.
+	MetadataCount int
+	// UploadCount is the amount of active upload sessions found in the metastor namespace.
+	UploadCount int
+	// ExpiredUploads is the amount of expired upload sessions,
+	// which were deleted unless this is a dry run.
+	ExpiredUploads int
+	// IndexedChunks is the amount of referenced chunks found in the deduplication index.
+	IndexedChunks int
+	// ReferencedObjects is the amount of objects referenced by all metadata,
+	// all active upload sessions and all indexed chunks.
+	ReferencedObjects int
+	// Shards contains the report of each shard in the cluster.
+	Shards []ShardReport
+}
+
+// ShardReport is the garbage collection result of a single shard.
+type ShardReport struct {
+	// Shard is the identifier of the shard.
+	Shard string
+	// ScannedObjects is the amount of objects found on the shard.
+	ScannedObjects int
+	// Orphans contains all orphaned objects found on the shard.
+	Orphans []Orphan
+	// Error is set in case the collection of the shard was aborted,
+	// in which case the report of this shard is incomplete.
+	Error error
+}
+
+// Orphan defines an object which isn't referenced by any metadata.
+type Orphan struct {
+	Key []byte
+	// Size of the object data, in bytes.
+	Size int64
+	// Timestamp (unix epoch, in seconds) of the moment the object was stored.
+	Timestamp int64
+	// Recent is true in case the object is younger than the grace period,
+	// in which case it was not deleted.
+	Recent bool
+	// Deleted is true in case the object was deleted.
+	Deleted bool
+	// Error is set in case the object couldn't be deleted.
+	Error error
+}
+
+// Totals returns the total amount of orphaned objects,
+// as well as the amount of deleted objects and bytes, summed over all shards.
+func (report *Report) Totals() (orphans, deletedObjects int, deletedBytes int64) {
+	for _, shard := range report.Shards {
+		orphans += len(shard.Orphans)
+		for _, orphan := range shard.Orphans {
+			if orphan.Deleted {
+				deletedObjects++
+				deletedBytes += orphan.Size
+			}
+		}
+	}
+	return
+}
+
+// Collect walks over the keys of all listed shards within the given cluster, unhealthy or not,
+// and deletes all objects which aren't referenced by any of the metadata
+// (or older versions of it) stored in the namespace of the given metastor client,
+// and which are older than the configured grace period.
+//
+// The referenced objects are collected prior to walking the shards,
+// such that objects of writes which finish during the collection,
+// are protected by the grace period.
+//
+// An error is only returned in case the referenced objects couldn't be collected,
+// as in that case no object can be safely deleted.
+// Errors which occur while collecting a shard (e.g. because it can't be reached)
+// are part of the report, and do not abort the collection of the other shards.
+func Collect(ctx context.Context, metaClient *metastor.Client, cluster datastor.Cluster, cfg Config) (*Report, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if metaClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+	if cluster == nil {
+		return nil, ErrNoCluster
+	}
+
+	gracePeriod := cfg.GracePeriod
+	if gracePeriod == 0 {
+		gracePeriod = DefaultGracePeriod
+	} else if gracePeriod < 0 {
+		gracePeriod = 0
+	}
+
+	uploadExpiration := cfg.UploadExpiration
+	if uploadExpiration == 0 {
+		uploadExpiration = DefaultUploadExpiration
+	}
+
+	report := &Report{
+		DryRun:      cfg.DryRun,
+		GracePeriod: gracePeriod,
+	}
+	refs, err := collectReferences(ctx, metaClient, uploadExpiration, cfg.DryRun, report)
+	if err != nil {
+		return nil, err
+	}
+	for _, objects := range refs {
+		report.ReferencedObjects += len(objects)
+	}
+
+	// walk all listed shards, including the unhealthy ones,
+	// such that a shard which can't be collected is reported as such, rather than skipped
+	threshold := time.Now().Add(-gracePeriod).Unix()
+	for _, health := range cluster.GetShardHealth() {
+		shard, err := listedShard(cluster, health.Shard)
+		if err != nil {
+			report.Shards = append(report.Shards, ShardReport{Shard: health.Shard, Error: err})
+			continue
+		}
+		shardReport := collectShard(ctx, shard, refs[shard.Identifier()], threshold, cfg.DryRun)
+		report.Shards = append(report.Shards, shardReport)
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// listedShard returns the listed shard with the given identifier,
+// even when it is unhealthy, in case the cluster supports it.
+func listedShard(cluster datastor.Cluster, id string) (datastor.Shard, error) {
+	if listed, ok := cluster.(datastor.ListedCluster); ok {
+		return listed.GetListedShard(id)
+	}
+	return cluster.GetShard(id)
+}
+
+// references maps shard identifiers to the set of object keys
+// referenced on that shard
+type references map[string]map[string]struct{}
+
+// collectReferences collects all objects referenced by the metadata,
+// its older versions, the active upload sessions and the indexed chunks,
+// stored in the namespace of the given metastor client.
+// The counts of the collected metadata, sessions and chunks are stored in the given report.
+//
+// Metadata can move between the current and older versions while collecting,
+// when a key is overwritten (keeping its old data) or when a version is restored,
+// while the parts of an upload session become current metadata when the upload is completed.
+// All of these store the new location prior to removing the old one,
+// hence the current metadata is collected prior to, as well as after,
+// the older versions and upload sessions, such that no moved metadata can be missed.
+func collectReferences(ctx context.Context, metaClient *metastor.Client, uploadExpiration time.Duration, dryRun bool, report *Report) (references, error) {
+	refs := make(references)
+	count, err := collectCurrentReferences(ctx, metaClient, refs)
+	if err != nil {
+		return nil, err
+	}
+	n, err := collectVersionReferences(ctx, metaClient, refs)
+	if err != nil {
+		return nil, err
+	}
+	count += n
+	report.UploadCount, report.ExpiredUploads, err = collectUploadReferences(
+		ctx, metaClient, refs, uploadExpiration, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	report.IndexedChunks, err = collectChunkReferences(ctx, metaClient, refs)
+	if err != nil {
+		return nil, err
+	}
+	_, err = collectCurrentReferences(ctx, metaClient, refs)
+	if err != nil {
+		return nil, err
+	}
+	report.MetadataCount = count
+	return refs, nil
+}
+
+// collectCurrentReferences collects all objects referenced by the current metadata,
+// returning the amount of metadata collected.
+func collectCurrentReferences(ctx context.Context, metaClient *metastor.Client, refs references) (int, error) {
+	// collect the keys first, as the metadata database
+	// might not support nested operations from within the list callback
+	var keys [][]byte
+	err := metaClient.ListKeys(func(key []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list metadata keys: %v", err)
+	}
+
+	var count int
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		md, err := metaClient.GetMetadata(key)
+		if err != nil {
+			if err == metastor.ErrNotFound {
+				// metadata was deleted in the meantime
+				continue
+			}
+			return 0, fmt.Errorf("failed to get metadata for key %q: %v", key, err)
+		}
+		count++
+		refs.add(md)
+	}
+	return count, nil
+}
+
+// collectVersionReferences collects all objects referenced by the older versions of metadata,
+// returning the amount of versions collected.
+func collectVersionReferences(ctx context.Context, metaClient *metastor.Client, refs references) (int, error) {
+	type version struct {
+		key     []byte
+		version int64
+	}
+	var versions []version
+	err := metaClient.ListAllVersions(func(key []byte, v int64) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		versions = append(versions, version{key, v})
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list metadata versions: %v", err)
+	}
+
+	var count int
+	for _, v := range versions {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		md, err := metaClient.GetVersion(v.key, v.version)
+		if err != nil {
+			if err == metastor.ErrNotFound {
+				// version was deleted in the meantime
+				continue
+			}
+			return 0, fmt.Errorf("failed to get version %d of key %q: %v", v.version, v.key, err)
+		}
+		count++
+		refs.add(md)
+	}
+	return count, nil
+}
+
+// collectUploadReferences collects all objects referenced by the parts of active upload sessions,
+// returning the amount of active sessions, as well as the amount of expired sessions.
+//
+// A session expires when it wasn't used for longer than the given expiration,
+// or when only parts of it remain, in which case it was deleted (partially) already.
+// Expired sessions are deleted, unless this is a dry run,
+// and the objects of their parts are not referenced, such that they can be collected as orphans.
+// The chunks of their parts are released as well, in case they are indexed for deduplication.
+func collectUploadReferences(ctx context.Context, metaClient *metastor.Client, refs references, expiration time.Duration, dryRun bool) (active, expired int, err error) {
+	var uploadIDs []string
+	err = metaClient.ListUploads(func(uploadID string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		uploadIDs = append(uploadIDs, uploadID)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list upload sessions: %v", err)
+	}
+
+	threshold := time.Now().Add(-expiration).UnixNano()
+	for _, uploadID := range uploadIDs {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+		session, err := metaClient.GetUpload(uploadID)
+		if err != nil && err != metastor.ErrNotFound {
+			return 0, 0, fmt.Errorf("failed to get upload session %s: %v", uploadID, err)
+		}
+		if session == nil || (expiration > 0 && session.LastWriteEpoch < threshold) {
+			expired++
+			if dryRun {
+				continue
+			}
+			parts, err := getUploadParts(metaClient, uploadID)
+			if err != nil {
+				return 0, 0, err
+			}
+			err = metaClient.DeleteUpload(uploadID)
+			if err != nil {
+				return 0, 0, fmt.Errorf("failed to delete expired upload session %s: %v", uploadID, err)
+			}
+			log.Infof("gc: deleted expired upload session %s", uploadID)
+			if session != nil && !metastor.UploadCompleted(*session) {
+				releaseUploadChunks(metaClient, uploadID, parts)
+			}
+			continue
+		}
+
+		active++
+		parts, err := getUploadParts(metaClient, uploadID)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, md := range parts {
+			refs.add(md)
+		}
+	}
+	return active, expired, nil
+}
+
+// getUploadParts returns the metadata of all parts of the given upload session
+func getUploadParts(metaClient *metastor.Client, uploadID string) ([]*metatypes.Metadata, error) {
+	var numbers []int64
+	err := metaClient.ListUploadParts(uploadID, func(part int64) error {
+		numbers = append(numbers, part)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list parts of upload session %s: %v", uploadID, err)
+	}
+	parts := make([]*metatypes.Metadata, 0, len(numbers))
+	for _, part := range numbers {
+		md, err := metaClient.GetUploadPart(uploadID, part)
+		if err != nil {
+			if err == metastor.ErrNotFound {
+				// session was completed or aborted in the meantime
+				continue
+			}
+			return nil, fmt.Errorf("failed to get part %d of upload session %s: %v", part, uploadID, err)
+		}
+		parts = append(parts, md)
+	}
+	return parts, nil
+}
+
+// releaseUploadChunks releases the chunks of the given parts of a deleted upload session,
+// which never got completed, in case they are indexed for deduplication,
+// such that they can be collected as orphans.
+// The chunks of a completed session are referenced by the metadata of its key instead,
+// while the session of parts without a session got deleted by completing or aborting it,
+// which took over or released the references to their chunks already.
+//
+// Errors are only logged, as the session has been deleted already,
+// chunks that couldn't be released remain referenced by the index.
+func releaseUploadChunks(metaClient *metastor.Client, uploadID string, parts []*metatypes.Metadata) {
+	for _, part := range parts {
+		for _, chunk := range part.Chunks {
+			if len(chunk.Hash) == 0 {
+				continue
+			}
+			_, err := metaClient.ReleaseChunk(chunk)
+			if err != nil {
+				log.Errorf("gc: failed to release chunk of expired upload session %s: %v", uploadID, err)
+			}
+		}
+	}
+}
+
+// collectChunkReferences collects all objects referenced by the chunks
+// indexed for deduplication, returning the amount of referenced chunks collected.
+func collectChunkReferences(ctx context.Context, metaClient *metastor.Client, refs references) (int, error) {
+	var hashes [][]byte
+	err := metaClient.ListChunks(func(hash []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		hashes = append(hashes, hash)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list indexed chunks: %v", err)
+	}
+
+	var count int
+	for _, hash := range hashes {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		chunk, _, err := metaClient.GetChunk(hash)
+		if err != nil {
+			if err == metastor.ErrNotFound {
+				// no longer referenced
+				continue
+			}
+			return 0, fmt.Errorf("failed to get indexed chunk %x: %v", hash, err)
+		}
+		count++
+		refs.add(&metatypes.Metadata{Chunks: []metatypes.Chunk{*chunk}})
+	}
+	return count, nil
+}
+
+// add all objects referenced by the given metadata
+func (refs references) add(md *metatypes.Metadata) {
+	for _, chunk := range md.Chunks {
+		for _, object := range chunk.Objects {
+			objects, ok := refs[object.ShardID]
+			if !ok {
+				objects = make(map[string]struct{})
+				refs[object.ShardID] = objects
+			}
+			objects[string(object.Key)] = struct{}{}
+		}
+	}
+}
+
+// collectShard walks over all keys of a single shard,
+// deleting the orphaned objects older than the given threshold.
+func collectShard(ctx context.Context, shard datastor.Shard, refs map[string]struct{}, threshold int64, dryRun bool) ShardReport {
+	report := ShardReport{Shard: shard.Identifier()}
+
+	ch, err := shard.ListObjectKeyIterator(ctx)
+	if err != nil {
+		report.Error = err
+		return report
+	}
+	var orphans []Orphan
+	for result := range ch {
+		if result.Error != nil {
+			report.Error = result.Error
+			break
+		}
+		report.ScannedObjects++
+		if _, ok := refs[string(result.Key)]; ok {
+			continue
+		}
+		orphans = append(orphans, Orphan{
+			Key:       result.Key,
+			Size:      result.Size,
+			Timestamp: result.Timestamp,
+			Recent:    result.Timestamp > threshold,
+		})
+	}
+	if report.Error == nil {
+		report.Error = ctx.Err()
+	}
+	report.Orphans = orphans
+
+	// only delete objects once the iteration is finished,
+	// as to not modify the shard while its keys are being walked
+	if dryRun {
+		return report
+	}
+	for i := range orphans {
+		orphan := &orphans[i]
+		if orphan.Recent {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			if report.Error == nil {
+				report.Error = err
+			}
+			break
+		}
+		err := shard.DeleteObjectContext(ctx, orphan.Key)
+		if err != nil {
+			log.Errorf("gc: failed to delete orphaned object %q from shard %s: %v",
+				orphan.Key, report.Shard, err)
+			orphan.Error = err
+			continue
+		}
+		orphan.Deleted = true
+	}
+	return report
+}
@@ -0,0 +1,418 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client"
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
+	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/db/test"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollect(t *testing.T) {
+	require := require.New(t)
+
+	cluster, servers, cleanup := newTestCluster(t, 4)
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	dataPipeline, err := pipeline.NewPipeline(pipeline.Config{
+		BlockSize: 64,
+		Distribution: pipeline.ObjectDistributionConfig{
+			DataShardCount:   2,
+			ParityShardCount: 1,
+		},
+	}, cluster, -1)
+	require.NoError(err)
+	c := client.NewClient(metaClient, dataPipeline)
+	require.NoError(c.SetOverwriteConfig(client.OverwriteConfig{Mode: client.OverwriteModeKeep}))
+
+	// write a file and overwrite it,
+	// such that the first write is kept as an older version
+	data := bytes.Repeat([]byte("0-stor"), 100)
+	oldMD, err := c.Write([]byte("foo"), bytes.NewReader([]byte("old data")))
+	require.NoError(err)
+	md, err := c.Write([]byte("foo"), bytes.NewReader(data))
+	require.NoError(err)
+
+	// write data without storing its metadata,
+	// such that its objects are orphaned
+	_, err = client.NewClient(nil, dataPipeline).Write([]byte("bar"), bytes.NewReader(data))
+	require.NoError(err)
+
+	// also add an object directly onto a shard
+	shard, err := cluster.GetShard("ns@" + servers[0].addr)
+	require.NoError(err)
+	_, err = shard.CreateObject([]byte("orphan"))
+	require.NoError(err)
+
+	totalObjects := countObjects(servers)
+	var referenced int
+	for _, chunk := range append(md.Chunks, oldMD.Chunks...) {
+		referenced += len(chunk.Objects)
+	}
+	expectedOrphans := totalObjects - referenced
+	require.True(expectedOrphans > 1)
+
+	// nothing is deleted during a dry run
+	report, err := Collect(context.Background(), metaClient, cluster, Config{
+		GracePeriod: -1,
+		DryRun:      true,
+	})
+	require.NoError(err)
+	require.True(report.DryRun)
+	require.Equal(2, report.MetadataCount)
+	require.Equal(referenced, report.ReferencedObjects)
+	require.Len(report.Shards, len(servers))
+	orphans, deleted, _ := report.Totals()
+	require.Equal(expectedOrphans, orphans)
+	require.Zero(deleted)
+	require.Equal(totalObjects, countObjects(servers))
+
+	// orphans within the grace period aren't deleted
+	report, err = Collect(context.Background(), metaClient, cluster, Config{})
+	require.NoError(err)
+	require.Equal(DefaultGracePeriod, report.GracePeriod)
+	orphans, deleted, _ = report.Totals()
+	require.Equal(expectedOrphans, orphans)
+	require.Zero(deleted)
+	for _, shard := range report.Shards {
+		require.NoError(shard.Error)
+		for _, orphan := range shard.Orphans {
+			require.True(orphan.Recent)
+		}
+	}
+	require.Equal(totalObjects, countObjects(servers))
+
+	// orphans older than the grace period are deleted
+	for _, server := range servers {
+		for _, key := range server.Keys() {
+			server.SetTimestamp([]byte(key), time.Now().Add(-2*time.Hour))
+		}
+	}
+	report, err = Collect(context.Background(), metaClient, cluster, Config{
+		GracePeriod: time.Hour,
+	})
+	require.NoError(err)
+	orphans, deleted, deletedBytes := report.Totals()
+	require.Equal(expectedOrphans, orphans)
+	require.Equal(expectedOrphans, deleted)
+	require.True(deletedBytes > 0)
+	require.Equal(referenced, countObjects(servers))
+
+	// the referenced data, including the older version, is still intact
+	buf := bytes.NewBuffer(nil)
+	require.NoError(c.Read(*md, buf))
+	require.Equal(data, buf.Bytes())
+	version, err := metaClient.GetVersion([]byte("foo"), oldMD.LastWriteEpoch)
+	require.NoError(err)
+	buf.Reset()
+	require.NoError(c.Read(*version, buf))
+	require.Equal([]byte("old data"), buf.Bytes())
+
+	// nothing is left to collect
+	report, err = Collect(context.Background(), metaClient, cluster, Config{GracePeriod: -1})
+	require.NoError(err)
+	orphans, _, _ = report.Totals()
+	require.Zero(orphans)
+}
+
+func TestCollectUploads(t *testing.T) {
+	require := require.New(t)
+
+	cluster, servers, cleanup := newTestCluster(t, 4)
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	dataPipeline, err := pipeline.NewPipeline(pipeline.Config{
+		BlockSize: 64,
+		Distribution: pipeline.ObjectDistributionConfig{
+			DataShardCount:   2,
+			ParityShardCount: 1,
+		},
+	}, cluster, -1)
+	require.NoError(err)
+	c := client.NewClient(metaClient, dataPipeline)
+
+	data := bytes.Repeat([]byte("0-stor"), 100)
+	upload := func(key string) (string, int) {
+		uploadID, err := c.CreateUpload([]byte(key), client.CreateOptions{})
+		require.NoError(err)
+		_, err = c.UploadPart(uploadID, 0, bytes.NewReader(data))
+		require.NoError(err)
+		md, err := metaClient.GetUploadPart(uploadID, 0)
+		require.NoError(err)
+		var objects int
+		for _, chunk := range md.Chunks {
+			objects += len(chunk.Objects)
+		}
+		return uploadID, objects
+	}
+
+	// an active upload session, an abandoned one,
+	// and one of which only its parts remain
+	activeID, referenced := upload("active")
+	expiredID, _ := upload("expired")
+	session, err := metaClient.GetUpload(expiredID)
+	require.NoError(err)
+	session.LastWriteEpoch = time.Now().Add(-2 * time.Hour).UnixNano()
+	require.NoError(metaClient.SetUpload(expiredID, *session))
+	partialID, _ := upload("partial")
+	part, err := metaClient.GetUploadPart(partialID, 0)
+	require.NoError(err)
+	require.NoError(metaClient.DeleteUpload(partialID))
+	require.NoError(metaClient.SetUploadPart(partialID, 0, *part))
+	totalObjects := countObjects(servers)
+
+	// nothing is deleted during a dry run
+	report, err := Collect(context.Background(), metaClient, cluster, Config{
+		GracePeriod:      -1,
+		UploadExpiration: time.Hour,
+		DryRun:           true,
+	})
+	require.NoError(err)
+	require.Equal(1, report.UploadCount)
+	require.Equal(2, report.ExpiredUploads)
+	require.Equal(referenced, report.ReferencedObjects)
+	require.Equal(totalObjects, countObjects(servers))
+	_, err = metaClient.GetUpload(expiredID)
+	require.NoError(err)
+
+	// sessions never expire when disabled
+	report, err = Collect(context.Background(), metaClient, cluster, Config{
+		GracePeriod:      -1,
+		UploadExpiration: -1,
+		DryRun:           true,
+	})
+	require.NoError(err)
+	require.Equal(2, report.UploadCount)
+	require.Equal(1, report.ExpiredUploads)
+
+	// expired sessions are deleted, as well as the data of their parts
+	report, err = Collect(context.Background(), metaClient, cluster, Config{
+		GracePeriod:      -1,
+		UploadExpiration: time.Hour,
+	})
+	require.NoError(err)
+	require.Equal(1, report.UploadCount)
+	require.Equal(2, report.ExpiredUploads)
+	require.Equal(referenced, countObjects(servers))
+	for _, uploadID := range []string{expiredID, partialID} {
+		_, err = metaClient.GetUploadPart(uploadID, 0)
+		require.Equal(metastor.ErrNotFound, err)
+	}
+
+	// the active session can still be completed
+	md, err := c.CompleteUpload(activeID)
+	require.NoError(err)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(c.Read(*md, buf))
+	require.Equal(data, buf.Bytes())
+
+	report, err = Collect(context.Background(), metaClient, cluster, Config{GracePeriod: -1})
+	require.NoError(err)
+	require.Zero(report.UploadCount)
+	require.Zero(report.ExpiredUploads)
+	require.Equal(1, report.MetadataCount)
+	orphans, _, _ := report.Totals()
+	require.Zero(orphans)
+}
+
+func TestCollectChunks(t *testing.T) {
+	require := require.New(t)
+
+	cluster, servers, cleanup := newTestCluster(t, 4)
+	defer cleanup()
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	dataPipeline, err := pipeline.NewPipeline(pipeline.Config{
+		BlockSize: 64,
+		Distribution: pipeline.ObjectDistributionConfig{
+			DataShardCount:   2,
+			ParityShardCount: 1,
+		},
+	}, cluster, -1)
+	require.NoError(err)
+	c := client.NewClient(metaClient, dataPipeline)
+	require.NoError(c.SetDeduplication(true))
+
+	data := bytes.Repeat([]byte("0-stor"), 100)
+	_, err = c.Write([]byte("a"), bytes.NewReader(data))
+	require.NoError(err)
+	mdB, err := c.Write([]byte("b"), bytes.NewReader(data))
+	require.NoError(err)
+	// the metadata is gone, while its chunks are still referenced by the index
+	require.NoError(metaClient.DeleteMetadata([]byte("a")))
+	referenced := countObjects(servers)
+	_, refs, err := metaClient.GetChunk(mdB.Chunks[0].Hash)
+	require.NoError(err)
+
+	// an abandoned upload session of an existing key, of which the data is partly shared
+	uploadID, err := c.CreateUpload([]byte("b"), client.CreateOptions{})
+	require.NoError(err)
+	_, err = c.UploadPart(uploadID, 0, bytes.NewReader(append(data, bytes.Repeat([]byte("gc"), 100)...)))
+	require.NoError(err)
+	require.True(countObjects(servers) > referenced)
+	session, err := metaClient.GetUpload(uploadID)
+	require.NoError(err)
+	session.LastWriteEpoch = time.Now().Add(-2 * time.Hour).UnixNano()
+	require.NoError(metaClient.SetUpload(uploadID, *session))
+
+	// the chunks of the expired session are released,
+	// while all indexed chunks remain referenced
+	report, err := Collect(context.Background(), metaClient, cluster, Config{
+		GracePeriod:      -1,
+		UploadExpiration: time.Hour,
+	})
+	require.NoError(err)
+	require.Equal(1, report.ExpiredUploads)
+	require.Equal(1, report.MetadataCount)
+	require.NotZero(report.IndexedChunks)
+	require.Equal(referenced, report.ReferencedObjects)
+	require.Equal(referenced, countObjects(servers))
+	_, released, err := metaClient.GetChunk(mdB.Chunks[0].Hash)
+	require.NoError(err)
+	require.Equal(refs, released)
+
+	report, err = Collect(context.Background(), metaClient, cluster, Config{GracePeriod: -1})
+	require.NoError(err)
+	orphans, _, _ := report.Totals()
+	require.Zero(orphans)
+}
+
+func TestCollectErrors(t *testing.T) {
+	require := require.New(t)
+
+	cluster, _, cleanup := newTestCluster(t, 1)
+	defer cleanup()
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	_, err = Collect(nil, metaClient, cluster, Config{})
+	require.Equal(ErrNilContext, err)
+	_, err = Collect(context.Background(), nil, cluster, Config{})
+	require.Equal(ErrNoMetastorClient, err)
+	_, err = Collect(context.Background(), metaClient, nil, Config{})
+	require.Equal(ErrNoCluster, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Collect(ctx, metaClient, cluster, Config{})
+	require.Equal(context.Canceled, err)
+}
+
+func TestCollectUnhealthyShard(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		shards   []datastor.ShardConfig
+		cleanups []func()
+	)
+	for i := 0; i < 2; i++ {
+		_, addr, cleanup, err := zdbtest.NewInMem0DBServer("ns")
+		require.NoError(err)
+		defer cleanup()
+		cleanups = append(cleanups, cleanup)
+		shards = append(shards, datastor.ShardConfig{Address: addr})
+	}
+	cluster, err := zerodb.NewClusterFromConfig(zerodb.ClusterConfig{
+		Shards:    shards,
+		Namespace: "ns",
+		Health:    datastor.HealthConfig{FailureThreshold: 1, ProbeInterval: -1},
+	})
+	require.NoError(err)
+	defer cluster.Close()
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+	defer metaClient.Close()
+
+	// a shard which can no longer be reached turns unhealthy
+	down, err := cluster.GetListedShard(cluster.GetShardHealth()[0].Shard)
+	require.NoError(err)
+	cleanups[0]()
+	_, err = down.CreateObject([]byte("data"))
+	require.Error(err)
+	_, err = cluster.GetShard(down.Identifier())
+	require.Equal(datastor.ErrShardUnhealthy, err)
+
+	// the unhealthy shard is reported as failed, rather than skipped
+	report, err := Collect(context.Background(), metaClient, cluster, Config{})
+	require.NoError(err)
+	require.Len(report.Shards, 2)
+	require.Equal(down.Identifier(), report.Shards[0].Shard)
+	require.Error(report.Shards[0].Error)
+	require.NoError(report.Shards[1].Error)
+}
+
+type testServer struct {
+	*zdbtest.InMem0DBServer
+	addr string
+}
+
+func newTestCluster(t *testing.T, n int) (*zerodb.Cluster, []*testServer, func()) {
+	require := require.New(t)
+
+	var (
+		servers  []*testServer
+		shards   []datastor.ShardConfig
+		cleanups []func()
+	)
+	for i := 0; i < n; i++ {
+		server, addr, cleanup, err := zdbtest.NewInMem0DBServer("ns")
+		require.NoError(err)
+		cleanups = append(cleanups, cleanup)
+		servers = append(servers, &testServer{InMem0DBServer: server, addr: addr})
+		shards = append(shards, datastor.ShardConfig{Address: addr})
+	}
+	cluster, err := zerodb.NewCluster(shards, "", "ns", nil, datastor.SpreadingTypeRandom)
+	require.NoError(err)
+
+	return cluster, servers, func() {
+		cluster.Close()
+		for _, cleanup := range cleanups {
+			cleanup()
+		}
+	}
+}
+
+func countObjects(servers []*testServer) int {
+	var count int
+	for _, server := range servers {
+		count += len(server.Keys())
+	}
+	return count
+}
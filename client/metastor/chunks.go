@@ -0,0 +1,316 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metastor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+
+	dbp "github.com/threefoldtech/0-stor/client/metastor/db"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+// The chunk index, used to deduplicate chunks, is stored in a separate namespace,
+// derived from the namespace of the client, such that it never shows up
+// when listing the keys of the (current) metadata.
+//
+// Within that namespace each chunk is stored using its hex-encoded hash as the key,
+// as metadata which defines the amount of references to the indexed chunk as its size,
+// and the indexed chunk as its first chunk. Once an indexed chunk is repaired,
+// the repaired chunk becomes the first chunk, while the chunks it replaced are kept
+// as the following chunks, such that references to those chunks remain valid.
+//
+// A chunk which is no longer referenced isn't deleted from the index,
+// but remains stored without chunks and a size of 0 instead,
+// such that it can be replaced atomically once the same data is indexed again.
+const chunkNamespaceSuffix = "\x00chunks"
+
+var (
+	// ErrNilHash is an error returned in case a chunk without a hash is indexed,
+	// or in case an empty hash is given.
+	ErrNilHash = errors.New("nil chunk hash given")
+)
+
+// ChunkCallback is the type of callback used to process the hashes of listed chunks.
+type ChunkCallback func(hash []byte) error
+
+// AcquireChunk adds a reference to the chunk indexed with the hash of the given chunk,
+// indexing the given chunk itself in case no referenced chunk is indexed yet for that hash.
+//
+// The indexed chunk is returned, as well as whether or not the given chunk is a duplicate,
+// which is the case when another chunk was indexed already for the same hash.
+// The data of a duplicate chunk isn't referenced by the index, and should be deleted,
+// using the returned chunk in its place.
+func (c *Client) AcquireChunk(chunk metatypes.Chunk) (*metatypes.Chunk, bool, error) {
+	if len(chunk.Hash) == 0 {
+		return nil, false, ErrNilHash
+	}
+	key := chunkKey(chunk.Hash)
+	for {
+		var (
+			indexed   metatypes.Chunk
+			duplicate bool
+		)
+		err := c.updateChunkRecord(key, func(record metatypes.Metadata) (*metatypes.Metadata, error) {
+			if !isReferenced(record) {
+				// no longer referenced, replace it
+				record.Chunks = []metatypes.Chunk{chunk}
+				record.Size = 0
+			}
+			record.Size++
+			indexed = record.Chunks[0]
+			duplicate = !indexesChunk(record, chunk)
+			return &record, nil
+		})
+		if err == nil {
+			return &indexed, duplicate, nil
+		}
+		if err != ErrNotFound {
+			return nil, false, err
+		}
+
+		// not indexed yet, only one of the concurrent acquirers can create it
+		bytes, err := c.encode(metatypes.Metadata{
+			Key:       chunk.Hash,
+			Namespace: c.namespace,
+			Chunks:    []metatypes.Chunk{chunk},
+			Size:      1,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		err = c.db.Create(c.chunkNamespace(), key, bytes)
+		if err == nil {
+			return &chunk, false, nil
+		}
+		if err != dbp.ErrExists {
+			return nil, false, err
+		}
+	}
+}
+
+// AcquireIndexedChunk adds a reference to the chunk indexed with the given hash,
+// and returns that chunk, such that data which is indexed already doesn't have to be stored again.
+//
+// ErrNotFound is returned in case no chunk is indexed for the given hash,
+// or in case the indexed chunk is no longer referenced,
+// in which case the data has to be stored and acquired using AcquireChunk instead.
+func (c *Client) AcquireIndexedChunk(hash []byte) (*metatypes.Chunk, error) {
+	if len(hash) == 0 {
+		return nil, ErrNilHash
+	}
+	var indexed metatypes.Chunk
+	err := c.updateChunkRecord(chunkKey(hash), func(record metatypes.Metadata) (*metatypes.Metadata, error) {
+		if !isReferenced(record) {
+			return nil, ErrNotFound
+		}
+		record.Size++
+		indexed = record.Chunks[0]
+		return &record, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &indexed, nil
+}
+
+// ReleaseChunk removes a reference to the given chunk,
+// returning the chunk which is no longer referenced and has to be deleted, if any.
+//
+// That is the indexed chunk in case the last reference was released,
+// or the given chunk itself in case it isn't indexed
+// (e.g. because it was stored prior to indexing chunks).
+// No chunk is returned in case the indexed chunk is still referenced.
+//
+// The chunks replaced by repairing the indexed chunk are never returned,
+// their remaining objects are left to be collected by the garbage collector.
+func (c *Client) ReleaseChunk(chunk metatypes.Chunk) (*metatypes.Chunk, error) {
+	if len(chunk.Hash) == 0 {
+		return &chunk, nil
+	}
+	var unreferenced *metatypes.Chunk
+	err := c.updateChunkRecord(chunkKey(chunk.Hash), func(record metatypes.Metadata) (*metatypes.Metadata, error) {
+		if !isReferenced(record) || !indexesChunk(record, chunk) {
+			return nil, errNotIndexed
+		}
+		unreferenced = nil
+		record.Size--
+		if record.Size == 0 {
+			unreferenced = &record.Chunks[0]
+			record.Chunks = nil
+		}
+		return &record, nil
+	})
+	if err == ErrNotFound || err == errNotIndexed {
+		return &chunk, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return unreferenced, nil
+}
+
+// GetChunk returns the chunk indexed with the given hash,
+// as well as the amount of references to that chunk.
+//
+// ErrNotFound is returned in case no chunk is indexed for the given hash,
+// or in case the indexed chunk is no longer referenced.
+func (c *Client) GetChunk(hash []byte) (*metatypes.Chunk, int64, error) {
+	if len(hash) == 0 {
+		return nil, 0, ErrNilHash
+	}
+	record, err := c.getChunkRecord(chunkKey(hash))
+	if err != nil {
+		return nil, 0, err
+	}
+	return &record.Chunks[0], record.Size, nil
+}
+
+// LookupChunk returns the indexed chunk which the given chunk refers to,
+// which is a repaired version of the given chunk, in case it was repaired since.
+//
+// ErrNotFound is returned in case the given chunk isn't indexed,
+// or in case the indexed chunk is no longer referenced.
+func (c *Client) LookupChunk(chunk metatypes.Chunk) (*metatypes.Chunk, error) {
+	if len(chunk.Hash) == 0 {
+		return nil, ErrNilHash
+	}
+	record, err := c.getChunkRecord(chunkKey(chunk.Hash))
+	if err != nil {
+		return nil, err
+	}
+	if !indexesChunk(*record, chunk) {
+		return nil, ErrNotFound
+	}
+	return &record.Chunks[0], nil
+}
+
+// ReplaceChunk replaces the indexed chunk which the given chunk refers to
+// with the given repaired chunk, keeping all references to it.
+// References to the replaced chunk remain valid, and can still be released.
+//
+// ErrNotFound is returned in case the given chunk isn't indexed,
+// or in case the indexed chunk is no longer referenced.
+func (c *Client) ReplaceChunk(chunk, repaired metatypes.Chunk) error {
+	if len(chunk.Hash) == 0 {
+		return ErrNilHash
+	}
+	return c.updateChunkRecord(chunkKey(chunk.Hash), func(record metatypes.Metadata) (*metatypes.Metadata, error) {
+		if !isReferenced(record) || !indexesChunk(record, chunk) {
+			return nil, ErrNotFound
+		}
+		if !sameObjects(record.Chunks[0], repaired) {
+			record.Chunks = append([]metatypes.Chunk{repaired}, record.Chunks...)
+		}
+		return &record, nil
+	})
+}
+
+// ListChunks lists the hashes of all indexed chunks,
+// and executes the given callback for each of them.
+// Chunks which are no longer referenced might be listed as well,
+// even though GetChunk returns ErrNotFound for them.
+func (c *Client) ListChunks(cb ChunkCallback) error {
+	return c.db.ListKeys(c.chunkNamespace(), func(key []byte) error {
+		hash, err := hex.DecodeString(string(key))
+		if err != nil {
+			// not a chunk stored by this client
+			return nil
+		}
+		return cb(hash)
+	})
+}
+
+// errNotIndexed is used to interrupt the update
+// of a chunk record which doesn't index the released chunk
+var errNotIndexed = errors.New("chunk isn't indexed")
+
+func (c *Client) getChunkRecord(key []byte) (*metatypes.Metadata, error) {
+	bytes, err := c.db.Get(c.chunkNamespace(), key)
+	if err != nil {
+		return nil, err
+	}
+	var record metatypes.Metadata
+	err = c.decode(bytes, &record)
+	if err != nil {
+		return nil, err
+	}
+	if !isReferenced(record) {
+		return nil, ErrNotFound
+	}
+	return &record, nil
+}
+
+func (c *Client) updateChunkRecord(key []byte, cb UpdateMetadataFunc) error {
+	return c.db.Update(c.chunkNamespace(), key, func(bytes []byte) ([]byte, error) {
+		var record metatypes.Metadata
+		err := c.decode(bytes, &record)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := cb(record)
+		if err != nil {
+			return nil, err
+		}
+		updated.Namespace = c.namespace
+		return c.encode(*updated)
+	})
+}
+
+func (c *Client) chunkNamespace() []byte {
+	return []byte(string(c.namespace) + chunkNamespaceSuffix)
+}
+
+func chunkKey(hash []byte) []byte {
+	return []byte(hex.EncodeToString(hash))
+}
+
+// isReferenced returns true in case the given chunk record
+// defines a chunk which is still referenced
+func isReferenced(record metatypes.Metadata) bool {
+	return record.Size > 0 && len(record.Chunks) > 0
+}
+
+// indexesChunk returns true in case the given chunk record indexes the given chunk,
+// meaning the given chunk shares an object with the indexed chunk,
+// or with one of the chunks it replaced.
+func indexesChunk(record metatypes.Metadata, chunk metatypes.Chunk) bool {
+	for _, indexed := range record.Chunks {
+		for _, a := range indexed.Objects {
+			for _, b := range chunk.Objects {
+				if a.ShardID == b.ShardID && bytes.Equal(a.Key, b.Key) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// sameObjects returns true in case both chunks consist of the same objects
+func sameObjects(a, b metatypes.Chunk) bool {
+	if len(a.Objects) != len(b.Objects) {
+		return false
+	}
+	for index, object := range a.Objects {
+		if object.ShardID != b.Objects[index].ShardID || !bytes.Equal(object.Key, b.Objects[index].Key) {
+			return false
+		}
+	}
+	return true
+}
@@ -214,8 +214,14 @@ func (c *Client) UpdateMetadata(key []byte, cb UpdateMetadataFunc) (*metatypes.M
 		return nil, ErrNilKey
 	}
 
+	return c.updateMetadata(c.namespace, key, cb)
+}
+
+// updateMetadata updates the metadata stored as the given key within the given namespace,
+// see `UpdateMetadata` for more information.
+func (c *Client) updateMetadata(namespace, key []byte, cb UpdateMetadataFunc) (*metatypes.Metadata, error) {
 	metadata := new(metatypes.Metadata)
-	err := c.db.Update(c.namespace, key, func(bytes []byte) ([]byte, error) {
+	err := c.db.Update(namespace, key, func(bytes []byte) ([]byte, error) {
 		// decode the (fetched) metadata, so we can update it
 		err := c.decode(bytes, metadata)
 		if err != nil {
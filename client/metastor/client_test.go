@@ -78,6 +78,22 @@ func TestClient_ListKeys(t *testing.T) {
 	testClient(t, testClientListKeys)
 }
 
+func TestClient_Versions(t *testing.T) {
+	testClient(t, testClientVersions)
+}
+
+func TestClient_Uploads(t *testing.T) {
+	testClient(t, testClientUploads)
+}
+
+func TestClient_Chunks(t *testing.T) {
+	testClient(t, testClientChunks)
+}
+
+func TestClient_ScrubRecords(t *testing.T) {
+	testClient(t, testClientScrubRecords)
+}
+
 func testClient(t *testing.T, f func(t *testing.T, c *Client)) {
 	namespace := []byte("ns")
 	t.Run("in_mem_db+default_cfg", func(t *testing.T) {
@@ -402,6 +418,354 @@ func testClientListKeys(t *testing.T, c *Client) {
 	require.Equal(keys, listedKeys)
 }
 
+func testClientVersions(t *testing.T, c *Client) {
+	require := require.New(t)
+
+	keys := [][]byte{[]byte("a"), []byte("b"), []byte("a/b")}
+	for _, key := range keys {
+		for epoch := int64(3); epoch > 0; epoch-- {
+			err := c.SetVersion(metatypes.Metadata{
+				Key:            key,
+				Size:           epoch,
+				LastWriteEpoch: epoch,
+			})
+			require.NoError(err)
+		}
+	}
+
+	// versions never show up as (current) metadata
+	err := c.ListKeys(func(key []byte) error {
+		return fmt.Errorf("unexpected key %q", key)
+	})
+	require.NoError(err)
+	_, err = c.GetMetadata(keys[0])
+	require.Equal(ErrNotFound, err)
+
+	// versions are listed per key, from oldest to newest
+	for _, key := range keys {
+		var versions []int64
+		err = c.ListVersions(key, func(listedKey []byte, version int64) error {
+			require.Equal(key, listedKey)
+			versions = append(versions, version)
+			return nil
+		})
+		require.NoError(err)
+		require.Equal([]int64{1, 2, 3}, versions)
+
+		md, err := c.GetVersion(key, 2)
+		require.NoError(err)
+		require.Equal(key, md.Key)
+		require.Equal(int64(2), md.Size)
+	}
+	var count int
+	err = c.ListAllVersions(func(key []byte, version int64) error {
+		count++
+		return nil
+	})
+	require.NoError(err)
+	require.Equal(len(keys)*3, count)
+
+	// delete a single version
+	require.NoError(c.DeleteVersion(keys[0], 2))
+	require.NoError(c.DeleteVersion(keys[0], 2))
+	_, err = c.GetVersion(keys[0], 2)
+	require.Equal(ErrNotFound, err)
+	var versions []int64
+	err = c.ListVersions(keys[0], func(_ []byte, version int64) error {
+		versions = append(versions, version)
+		return nil
+	})
+	require.NoError(err)
+	require.Equal([]int64{1, 3}, versions)
+
+	// versions are updated atomically, while deleted versions aren't stored again
+	md, err := c.UpdateVersion(keys[0], 3, func(md metatypes.Metadata) (*metatypes.Metadata, error) {
+		md.Size = 42
+		return &md, nil
+	})
+	require.NoError(err)
+	require.Equal(int64(42), md.Size)
+	md, err = c.GetVersion(keys[0], 3)
+	require.NoError(err)
+	require.Equal(int64(42), md.Size)
+	_, err = c.UpdateVersion(keys[0], 2, func(md metatypes.Metadata) (*metatypes.Metadata, error) {
+		return &md, nil
+	})
+	require.Equal(ErrNotFound, err)
+	_, err = c.GetVersion(keys[0], 2)
+	require.Equal(ErrNotFound, err)
+
+	// nil keys aren't allowed
+	require.Equal(ErrNilKey, c.SetVersion(metatypes.Metadata{}))
+	_, err = c.GetVersion(nil, 1)
+	require.Equal(ErrNilKey, err)
+	_, err = c.UpdateVersion(nil, 1, nil)
+	require.Equal(ErrNilKey, err)
+	require.Equal(ErrNilKey, c.ListVersions(nil, nil))
+	require.Equal(ErrNilKey, c.DeleteVersion(nil, 1))
+}
+
+func testClientUploads(t *testing.T, c *Client) {
+	require := require.New(t)
+
+	uploadIDs := []string{"a", "ab", "b"}
+	for _, uploadID := range uploadIDs {
+		err := c.SetUpload(uploadID, metatypes.Metadata{
+			Key:           []byte("key_" + uploadID),
+			CreationEpoch: 42,
+		})
+		require.NoError(err)
+		for part := int64(3); part >= 0; part-- {
+			err = c.SetUploadPart(uploadID, part, metatypes.Metadata{
+				Key:  []byte("key_" + uploadID),
+				Size: part,
+			})
+			require.NoError(err)
+		}
+	}
+
+	// sessions never show up as (current) metadata
+	err := c.ListKeys(func(key []byte) error {
+		return fmt.Errorf("unexpected key %q", key)
+	})
+	require.NoError(err)
+
+	var listed []string
+	err = c.ListUploads(func(uploadID string) error {
+		listed = append(listed, uploadID)
+		return nil
+	})
+	require.NoError(err)
+	require.Equal(uploadIDs, listed)
+
+	for _, uploadID := range uploadIDs {
+		md, err := c.GetUpload(uploadID)
+		require.NoError(err)
+		require.Equal([]byte("key_"+uploadID), md.Key)
+		require.Equal(int64(42), md.CreationEpoch)
+
+		var parts []int64
+		err = c.ListUploadParts(uploadID, func(part int64) error {
+			parts = append(parts, part)
+			return nil
+		})
+		require.NoError(err)
+		require.Equal([]int64{0, 1, 2, 3}, parts)
+
+		md, err = c.GetUploadPart(uploadID, 2)
+		require.NoError(err)
+		require.Equal(int64(2), md.Size)
+	}
+	_, err = c.GetUploadPart("a", 4)
+	require.Equal(ErrNotFound, err)
+
+	// delete a session, including its parts
+	require.NoError(c.DeleteUpload("a"))
+	require.NoError(c.DeleteUpload("a"))
+	_, err = c.GetUpload("a")
+	require.Equal(ErrNotFound, err)
+	_, err = c.GetUploadPart("a", 0)
+	require.Equal(ErrNotFound, err)
+	_, err = c.GetUploadPart("ab", 0)
+	require.NoError(err)
+
+	// parts are updated atomically, while the parts of deleted sessions aren't stored again
+	md, err := c.UpdateUploadPart("ab", 1, func(md metatypes.Metadata) (*metatypes.Metadata, error) {
+		md.Size = 42
+		return &md, nil
+	})
+	require.NoError(err)
+	require.Equal(int64(42), md.Size)
+	md, err = c.GetUploadPart("ab", 1)
+	require.NoError(err)
+	require.Equal(int64(42), md.Size)
+	_, err = c.UpdateUploadPart("a", 0, func(md metatypes.Metadata) (*metatypes.Metadata, error) {
+		return &md, nil
+	})
+	require.Equal(ErrNotFound, err)
+	_, err = c.GetUploadPart("a", 0)
+	require.Equal(ErrNotFound, err)
+
+	// sessions are marked as completed, while deleted sessions can no longer be completed
+	md, err = c.GetUpload("ab")
+	require.NoError(err)
+	require.False(UploadCompleted(*md))
+	require.NoError(c.SetUploadCompleted("ab", true))
+	md, err = c.GetUpload("ab")
+	require.NoError(err)
+	require.True(UploadCompleted(*md))
+	require.Equal([]byte("key_ab"), md.Key)
+	require.NoError(c.SetUploadCompleted("ab", false))
+	md, err = c.GetUpload("ab")
+	require.NoError(err)
+	require.False(UploadCompleted(*md))
+	require.Equal(ErrNotFound, c.SetUploadCompleted("a", true))
+
+	// sessions of which only parts remain are still listed
+	require.NoError(c.SetUploadPart("c", 0, metatypes.Metadata{Key: []byte("key_c")}))
+	listed = nil
+	err = c.ListUploads(func(uploadID string) error {
+		listed = append(listed, uploadID)
+		return nil
+	})
+	require.NoError(err)
+	require.Equal([]string{"ab", "b", "c"}, listed)
+	_, err = c.GetUpload("c")
+	require.Equal(ErrNotFound, err)
+
+	// upload IDs can't be empty or contain a slash
+	for _, uploadID := range []string{"", "a/b"} {
+		require.Equal(ErrInvalidUploadID, c.SetUpload(uploadID, metatypes.Metadata{}))
+		_, err = c.GetUpload(uploadID)
+		require.Equal(ErrInvalidUploadID, err)
+		require.Equal(ErrInvalidUploadID, c.SetUploadPart(uploadID, 0, metatypes.Metadata{}))
+		_, err = c.GetUploadPart(uploadID, 0)
+		require.Equal(ErrInvalidUploadID, err)
+		_, err = c.UpdateUploadPart(uploadID, 0, nil)
+		require.Equal(ErrInvalidUploadID, err)
+		require.Equal(ErrInvalidUploadID, c.ListUploadParts(uploadID, nil))
+		require.Equal(ErrInvalidUploadID, c.DeleteUpload(uploadID))
+	}
+}
+
+func testClientChunks(t *testing.T, c *Client) {
+	require := require.New(t)
+
+	newChunk := func(hash, object string) metatypes.Chunk {
+		return metatypes.Chunk{
+			Size:    42,
+			Hash:    []byte(hash),
+			Objects: []metatypes.Object{{Key: []byte(object), ShardID: "shard"}},
+		}
+	}
+
+	// the first chunk gets indexed, while later chunks with the same hash are duplicates
+	a := newChunk("a", "a1")
+	chunk, duplicate, err := c.AcquireChunk(a)
+	require.NoError(err)
+	require.False(duplicate)
+	require.Equal(a, *chunk)
+	for _, object := range []string{"a2", "a3"} {
+		chunk, duplicate, err = c.AcquireChunk(newChunk("a", object))
+		require.NoError(err)
+		require.True(duplicate)
+		require.Equal(a, *chunk)
+	}
+	b := newChunk("b", "b1")
+	_, duplicate, err = c.AcquireChunk(b)
+	require.NoError(err)
+	require.False(duplicate)
+
+	chunk, refs, err := c.GetChunk(a.Hash)
+	require.NoError(err)
+	require.Equal(a, *chunk)
+	require.Equal(int64(3), refs)
+	_, _, err = c.GetChunk([]byte("c"))
+	require.Equal(ErrNotFound, err)
+
+	// an indexed chunk can be acquired by its hash, prior to storing the same data
+	b2, err := c.AcquireIndexedChunk(b.Hash)
+	require.NoError(err)
+	require.Equal(b, *b2)
+	_, refs, err = c.GetChunk(b.Hash)
+	require.NoError(err)
+	require.Equal(int64(2), refs)
+	chunk, err = c.ReleaseChunk(*b2)
+	require.NoError(err)
+	require.Nil(chunk)
+	_, err = c.AcquireIndexedChunk([]byte("c"))
+	require.Equal(ErrNotFound, err)
+	_, err = c.AcquireIndexedChunk(nil)
+	require.Equal(ErrNilHash, err)
+
+	// the index never shows up as (current) metadata
+	err = c.ListKeys(func(key []byte) error {
+		return fmt.Errorf("unexpected key %q", key)
+	})
+	require.NoError(err)
+	var hashes []string
+	err = c.ListChunks(func(hash []byte) error {
+		hashes = append(hashes, string(hash))
+		return nil
+	})
+	require.NoError(err)
+	require.Equal([]string{"a", "b"}, hashes)
+
+	// a repaired chunk keeps its references,
+	// and the chunk it replaced still refers to it
+	repaired := newChunk("a", "a4")
+	require.NoError(c.ReplaceChunk(a, repaired))
+	chunk, err = c.LookupChunk(a)
+	require.NoError(err)
+	require.Equal(repaired, *chunk)
+	chunk, _, err = c.GetChunk(a.Hash)
+	require.NoError(err)
+	require.Equal(repaired, *chunk)
+	_, err = c.LookupChunk(newChunk("a", "a2"))
+	require.Equal(ErrNotFound, err)
+	require.Equal(ErrNotFound, c.ReplaceChunk(newChunk("c", "c1"), repaired))
+
+	// the indexed chunk is only returned once it is no longer referenced
+	for i := 0; i < 2; i++ {
+		chunk, err = c.ReleaseChunk(a)
+		require.NoError(err)
+		require.Nil(chunk)
+	}
+	chunk, err = c.ReleaseChunk(a)
+	require.NoError(err)
+	require.Equal(repaired, *chunk)
+	_, _, err = c.GetChunk(a.Hash)
+	require.Equal(ErrNotFound, err)
+	_, err = c.AcquireIndexedChunk(a.Hash)
+	require.Equal(ErrNotFound, err)
+
+	// chunks which aren't indexed are returned as is
+	for _, unindexed := range []metatypes.Chunk{a, newChunk("b", "b2"), newChunk("c", "c1"), {}} {
+		chunk, err = c.ReleaseChunk(unindexed)
+		require.NoError(err)
+		require.Equal(unindexed, *chunk)
+	}
+	_, refs, err = c.GetChunk(b.Hash)
+	require.NoError(err)
+	require.Equal(int64(1), refs)
+
+	// an unreferenced chunk gets replaced when indexing the same hash again
+	a5 := newChunk("a", "a5")
+	chunk, duplicate, err = c.AcquireChunk(a5)
+	require.NoError(err)
+	require.False(duplicate)
+	require.Equal(a5, *chunk)
+
+	// only one of the concurrent acquirers of a new hash indexes its chunk
+	const jobs = 16
+	var (
+		group   errgroup.Group
+		indexed = make(chan metatypes.Chunk, jobs)
+	)
+	for i := 0; i < jobs; i++ {
+		d := newChunk("d", "d"+strconv.Itoa(i))
+		group.Go(func() error {
+			_, duplicate, err := c.AcquireChunk(d)
+			if err == nil && !duplicate {
+				indexed <- d
+			}
+			return err
+		})
+	}
+	require.NoError(group.Wait())
+	close(indexed)
+	require.Len(indexed, 1)
+	chunk, refs, err = c.GetChunk([]byte("d"))
+	require.NoError(err)
+	require.Equal(<-indexed, *chunk)
+	require.Equal(int64(jobs), refs)
+
+	_, _, err = c.AcquireChunk(metatypes.Chunk{})
+	require.Equal(ErrNilHash, err)
+	_, _, err = c.GetChunk(nil)
+	require.Equal(ErrNilHash, err)
+}
+
 func binaryMetadataMarshal(md metatypes.Metadata) ([]byte, error) {
 	buf := bytes.NewBuffer(nil)
 	encoder := gob.NewEncoder(buf)
@@ -441,3 +805,52 @@ var (
 		return processing.NewProcessorChain([]processing.Processor{cd, ec}), nil
 	}
 )
+
+func testClientScrubRecords(t *testing.T, c *Client) {
+	require := require.New(t)
+
+	_, err := c.GetScrubRecord([]byte("a"))
+	require.Equal(ErrNotFound, err)
+
+	records := map[string]ScrubRecord{
+		"a": {Epoch: 42, Result: "optimal"},
+		"b": {Epoch: 43, Result: "failed", Error: "shard unavailable"},
+	}
+	for key, record := range records {
+		require.NoError(c.SetScrubRecord([]byte(key), record))
+	}
+	for key, record := range records {
+		stored, err := c.GetScrubRecord([]byte(key))
+		require.NoError(err)
+		require.Equal(record, *stored)
+	}
+
+	// records never show up as (current) metadata
+	err = c.ListKeys(func(key []byte) error {
+		return fmt.Errorf("unexpected key %q", key)
+	})
+	require.NoError(err)
+	var keys []string
+	err = c.ListScrubRecords(func(key []byte) error {
+		keys = append(keys, string(key))
+		return nil
+	})
+	require.NoError(err)
+	require.Equal([]string{"a", "b"}, keys)
+
+	// a record is overwritten by the next scrub
+	require.NoError(c.SetScrubRecord([]byte("b"), ScrubRecord{Epoch: 44, Result: "repaired"}))
+	stored, err := c.GetScrubRecord([]byte("b"))
+	require.NoError(err)
+	require.Equal(ScrubRecord{Epoch: 44, Result: "repaired"}, *stored)
+
+	require.NoError(c.DeleteScrubRecord([]byte("a")))
+	require.NoError(c.DeleteScrubRecord([]byte("a")))
+	_, err = c.GetScrubRecord([]byte("a"))
+	require.Equal(ErrNotFound, err)
+
+	require.Equal(ErrNilKey, c.SetScrubRecord(nil, ScrubRecord{}))
+	_, err = c.GetScrubRecord(nil)
+	require.Equal(ErrNilKey, err)
+	require.Equal(ErrNilKey, c.DeleteScrubRecord(nil))
+}
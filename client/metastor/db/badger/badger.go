@@ -114,6 +114,30 @@ func (db *DB) Set(namespace, key, metadata []byte) error {
 	return nil
 }
 
+// Create implements db.Create
+func (db *DB) Create(namespace, key, metadata []byte) error {
+	bgrKey := badgerKey(namespace, key)
+
+	err := badgerdb.ErrConflict
+	for err == badgerdb.ErrConflict {
+		err = db.badger.Update(func(txn *badgerdb.Txn) error {
+			_, err := txn.Get(bgrKey)
+			if err == nil {
+				return dbp.ErrExists
+			}
+			if err != badgerdb.ErrKeyNotFound {
+				return mapBadgerError(err)
+			}
+			err = txn.Set(bgrKey, metadata)
+			if err != nil {
+				return mapBadgerError(err)
+			}
+			return nil
+		})
+	}
+	return err
+}
+
 // Get implements db.Get
 func (db *DB) Get(namespace, key []byte) (metadata []byte, err error) {
 	err = db.badger.View(func(txn *badgerdb.Txn) error {
@@ -47,6 +47,12 @@ func TestBadgerDB_RoundTrip(t *testing.T) {
 	test.RoundTrip(t, db)
 }
 
+func TestBadgerDB_Create(t *testing.T) {
+	db, cleanup := makeTestDB(t)
+	defer cleanup()
+	test.Create(t, db)
+}
+
 func TestBadgerDB_SyncUpdate(t *testing.T) {
 	db, cleanup := makeTestDB(t)
 	defer cleanup()
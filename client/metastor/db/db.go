@@ -26,6 +26,10 @@ var (
 	// in case metadata requested couldn't be found.
 	ErrNotFound = errors.New("metastor: key couldn't be found")
 
+	// ErrExists is the error returned by a metastor KV database,
+	// in case metadata is created for a key which already exists.
+	ErrExists = errors.New("metastor: key already exists")
+
 	// ErrTimeout is the error returned by a metastor KV database,
 	// in case the database timed out.
 	ErrTimeout = errors.New("metastor: database timed out")
@@ -65,6 +69,10 @@ func (ie *InternalError) Error() string {
 type DB interface {
 	// Set given key in the database equal to the processed metadata.
 	Set(namespace, key, metadata []byte) error
+	// Create given key in the database equal to the processed metadata,
+	// only if that key doesn't exist yet, returning ErrExists otherwise.
+	// Checking the existence and storing the metadata happens atomically.
+	Create(namespace, key, metadata []byte) error
 	// Get the stored metadata from the database using the given key.
 	Get(namespace, key []byte) (metadata []byte, err error)
 	// Delete the metadata which is stored as the given key.
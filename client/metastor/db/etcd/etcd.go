@@ -75,6 +75,25 @@ func (db *DB) Set(namespace, key, metadata []byte) error {
 	return nil
 }
 
+// Create implements db.Create
+func (db *DB) Create(namespace, key, metadata []byte) error {
+	ctx, cancel := context.WithTimeout(db.ctx, metaOpTimeout)
+	defer cancel()
+
+	keyStr := toEtcdKey(namespace, key)
+	resp, err := db.etcdClient.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(keyStr), "=", 0)).
+		Then(clientv3.OpPut(keyStr, string(metadata))).
+		Commit()
+	if err != nil {
+		return mapETCDError(err)
+	}
+	if !resp.Succeeded {
+		return dbp.ErrExists
+	}
+	return nil
+}
+
 // Get implements db.Get
 func (db *DB) Get(namespace, key []byte) ([]byte, error) {
 	ctx, cancel := context.WithTimeout(db.ctx, metaOpTimeout)
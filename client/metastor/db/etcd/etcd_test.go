@@ -38,6 +38,18 @@ func TestDB_RoundTrip(t *testing.T) {
 	test.RoundTrip(t, db)
 }
 
+func TestDB_Create(t *testing.T) {
+	etcd, err := NewEmbeddedServer()
+	require.NoError(t, err)
+	defer etcd.Stop()
+
+	db, err := New([]string{etcd.ListenAddr()})
+	require.NoError(t, err)
+	defer db.Close()
+
+	test.Create(t, db)
+}
+
 func TestDB_SyncUpdate(t *testing.T) {
 	etcd, err := NewEmbeddedServer()
 	require.NoError(t, err)
@@ -65,6 +65,61 @@ func RoundTrip(t *testing.T, db dbp.DB) {
 	require.Equal(dbp.ErrNotFound, err)
 }
 
+// Create tests that the given database only creates metadata
+// for keys which don't exist yet, also when creating them concurrently.
+func Create(t *testing.T, db dbp.DB) {
+	require := require.New(t)
+	require.NotNil(db)
+
+	var (
+		namespace = []byte("ns")
+		key       = []byte("foo")
+	)
+
+	const (
+		jobs = 32
+	)
+
+	// only one of the concurrent creates succeeds
+	var (
+		group   errgroup.Group
+		created = make(chan int, jobs)
+	)
+	for i := 0; i < jobs; i++ {
+		i := i
+		group.Go(func() error {
+			err := db.Create(namespace, key, []byte(strconv.Itoa(i)))
+			if err == dbp.ErrExists {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			created <- i
+			return nil
+		})
+	}
+	require.NoError(group.Wait())
+	close(created)
+	require.Len(created, 1)
+
+	// the created metadata is stored, and is never overwritten
+	data, err := db.Get(namespace, key)
+	require.NoError(err)
+	require.Equal([]byte(strconv.Itoa(<-created)), data)
+	err = db.Create(namespace, key, []byte("bar"))
+	require.Equal(dbp.ErrExists, err)
+
+	// a deleted key can be created again
+	err = db.Delete(namespace, key)
+	require.NoError(err)
+	err = db.Create(namespace, key, []byte("bar"))
+	require.NoError(err)
+	data, err = db.Get(namespace, key)
+	require.NoError(err)
+	require.Equal([]byte("bar"), data)
+}
+
 // SyncUpdate tests that the given database
 // can Update existing metadata in a synchronous scenario.
 func SyncUpdate(t *testing.T, db dbp.DB) {
@@ -61,6 +61,19 @@ func (db *DB) Set(namespace, key, metadata []byte) error {
 	return nil
 }
 
+// Create implements db.Create
+func (db *DB) Create(namespace, key, metadata []byte) error {
+	keyStr := inMemKey(namespace, key)
+	db.mux.Lock()
+	defer db.mux.Unlock()
+	if _, ok := db.md[keyStr]; ok {
+		return dbp.ErrExists
+	}
+	db.md[keyStr] = string(metadata)
+	db.versions[keyStr]++
+	return nil
+}
+
 // Get implements db.Get
 func (db *DB) Get(namespace, key []byte) ([]byte, error) {
 	db.mux.RLock()
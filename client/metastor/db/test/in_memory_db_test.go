@@ -24,6 +24,12 @@ func TestInMemoryDB_RoundTrip(t *testing.T) {
 	RoundTrip(t, db)
 }
 
+func TestInMemoryDB_Create(t *testing.T) {
+	db := New()
+	defer db.Close()
+	Create(t, db)
+}
+
 func TestInMemoryDB_SyncUpdate(t *testing.T) {
 	db := New()
 	defer db.Close()
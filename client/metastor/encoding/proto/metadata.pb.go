@@ -106,6 +106,9 @@ type Chunk struct {
 	// hash contains the checksum/signature of the chunk (data),
 	// meaning the data of all objects (of this chunk) combined.
 	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// offset of the chunk's data within the data of its metadata,
+	// only defined for chunks of variable size
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
 func (m *Chunk) Reset()      { *m = Chunk{} }
@@ -189,39 +192,39 @@ func init() {
 func init() { proto.RegisterFile("metadata.proto", fileDescriptor_56d9f74966f40d04) }
 
 var fileDescriptor_56d9f74966f40d04 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x4d, 0x9c, 0xd4, 0xe7, 0xa4, 0x54, 0x27, 0x86, 0x53, 0x85, 0x2e, 0x26, 0x20,
-	0x14, 0x21, 0x35, 0x95, 0x80, 0x01, 0x31, 0x30, 0x98, 0x74, 0xa8, 0x10, 0x42, 0x32, 0x20, 0xe6,
-	0x8b, 0x73, 0x8d, 0x4d, 0x13, 0x5f, 0xe4, 0x3b, 0x57, 0xa4, 0x13, 0x1f, 0x81, 0x8f, 0xd1, 0x8f,
-	0xc0, 0x47, 0xc8, 0x98, 0x8d, 0x4e, 0x11, 0xbe, 0x2c, 0x8c, 0x1d, 0x19, 0x91, 0x9f, 0x1d, 0x25,
-	0x54, 0x4c, 0x79, 0xef, 0xf7, 0xfe, 0x17, 0xfd, 0xdf, 0xfb, 0x1b, 0x1f, 0x4c, 0x85, 0xe6, 0x23,
-	0xae, 0x79, 0x7f, 0x96, 0x4a, 0x2d, 0x89, 0x0d, 0x3f, 0x47, 0xc7, 0xe3, 0x58, 0x47, 0xd9, 0xb0,
-	0x1f, 0xca, 0xe9, 0xc9, 0x58, 0x8e, 0xe5, 0x09, 0xe0, 0x61, 0x76, 0x0e, 0x1d, 0x34, 0x50, 0x95,
-	0xaf, 0xba, 0x3f, 0x6b, 0x78, 0xff, 0x5d, 0xf5, 0x47, 0xe4, 0x01, 0x76, 0x12, 0x3e, 0x15, 0x6a,
-	0xc6, 0x43, 0x41, 0x1d, 0x0f, 0xf5, 0x5a, 0xc1, 0x16, 0x90, 0x43, 0x5c, 0xbb, 0x10, 0x73, 0x8a,
-	0x80, 0x17, 0x25, 0x79, 0x88, 0xeb, 0x2a, 0xbe, 0x12, 0x74, 0xdf, 0x43, 0xbd, 0x9a, 0xdf, 0x36,
-	0xab, 0x8e, 0xf3, 0x51, 0x6a, 0x3e, 0xf9, 0x10, 0x5f, 0x89, 0x00, 0x46, 0xc4, 0xc3, 0xae, 0xd2,
-	0x32, 0xe5, 0x63, 0x51, 0x40, 0xba, 0x57, 0x28, 0x83, 0x5d, 0x44, 0x1e, 0xe3, 0x76, 0x98, 0x0a,
-	0xae, 0x63, 0x99, 0x9c, 0xce, 0x64, 0x18, 0xd1, 0x1a, 0x68, 0xfe, 0x85, 0xe4, 0x09, 0x3e, 0x98,
-	0x70, 0xa5, 0x3f, 0xa7, 0xb1, 0x16, 0xa5, 0xac, 0x0e, 0xb2, 0x3b, 0x94, 0x3c, 0xc5, 0x8d, 0x30,
-	0xca, 0x92, 0x0b, 0x45, 0x6d, 0xaf, 0xd6, 0x73, 0x9f, 0xb5, 0xca, 0x3d, 0xfb, 0x6f, 0x0a, 0xe8,
-	0xd7, 0x17, 0xab, 0x8e, 0x15, 0x54, 0x8a, 0x62, 0x5d, 0xa8, 0xc0, 0x19, 0xf6, 0x50, 0xcf, 0x0e,
-	0xb6, 0xa0, 0x70, 0x3e, 0x4b, 0xc5, 0x65, 0x2c, 0x33, 0xf5, 0x56, 0xcc, 0x69, 0x03, 0xd6, 0xde,
-	0x45, 0x84, 0xe2, 0x66, 0x22, 0xbe, 0xea, 0x62, 0xda, 0x84, 0xe9, 0xa6, 0x25, 0x3e, 0x76, 0x33,
-	0x25, 0xd2, 0x81, 0x38, 0x8f, 0x13, 0x31, 0xa2, 0x2e, 0x58, 0xf1, 0x2a, 0x2b, 0x9b, 0x73, 0xf7,
-	0x3f, 0x6d, 0x25, 0xa7, 0x89, 0x4e, 0xe7, 0xc1, 0xee, 0xa3, 0xa3, 0xd7, 0xf8, 0xf0, 0xae, 0x60,
-	0x37, 0x02, 0xa7, 0x8c, 0xe0, 0x3e, 0xb6, 0x2f, 0xf9, 0x24, 0x2b, 0x2f, 0xeb, 0x04, 0x65, 0xf3,
-	0x6a, 0xef, 0x25, 0xea, 0x4a, 0x6c, 0xc3, 0xd2, 0xe4, 0x51, 0x95, 0x12, 0x82, 0x94, 0xee, 0x99,
-	0x55, 0xc7, 0x2d, 0x16, 0x3c, 0x4b, 0xfc, 0xb9, 0x16, 0xaa, 0xca, 0xe9, 0x18, 0x37, 0xe5, 0xf0,
-	0x8b, 0x08, 0xb5, 0xa2, 0x7b, 0xe0, 0xb6, 0x5d, 0xb9, 0x7d, 0x0f, 0xb4, 0xba, 0xdc, 0x46, 0x43,
-	0x08, 0xae, 0x47, 0x5c, 0x95, 0x59, 0xb5, 0x02, 0xa8, 0xbb, 0x2f, 0x70, 0xa3, 0x14, 0xff, 0xe7,
-	0x4b, 0xa1, 0xb8, 0xa9, 0x22, 0x9e, 0x8e, 0xce, 0x06, 0x95, 0xd1, 0x4d, 0xeb, 0x0f, 0x16, 0x39,
-	0xb3, 0x96, 0x39, 0xb3, 0x6e, 0x72, 0x66, 0xdd, 0xe6, 0x0c, 0xfd, 0xc9, 0x19, 0xfa, 0x66, 0x18,
-	0xba, 0x36, 0x0c, 0xfd, 0x30, 0x0c, 0x2d, 0x0c, 0x43, 0x4b, 0xc3, 0xd0, 0x2f, 0xc3, 0xd0, 0x6f,
-	0xc3, 0xac, 0x5b, 0xc3, 0xd0, 0xf7, 0x35, 0xb3, 0xae, 0xd7, 0x0c, 0x2d, 0xd7, 0xcc, 0xba, 0x59,
-	0x33, 0x6b, 0xd8, 0x00, 0xb3, 0xcf, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x90, 0x1b, 0x44, 0xc3,
-	0x15, 0x03, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0x35, 0xbf, 0x9a, 0x2f, 0x49, 0xa9, 0x4e, 0x08, 0x9d, 0x2a, 0x74, 0x31, 0x01, 0xa1,
+	0x08, 0xa9, 0xa9, 0x04, 0x0c, 0x88, 0x81, 0x21, 0xa4, 0x43, 0x85, 0x10, 0x92, 0x01, 0x31, 0x5f,
+	0x9c, 0x4b, 0x62, 0x9a, 0xf8, 0x22, 0xdf, 0xb9, 0x22, 0x9d, 0xd8, 0x58, 0xf9, 0x33, 0xfa, 0x27,
+	0xf0, 0x27, 0x64, 0xcc, 0x46, 0xa7, 0x08, 0x3b, 0x0b, 0x63, 0x47, 0x46, 0xe4, 0xcf, 0x17, 0x25,
+	0x54, 0x4c, 0xfe, 0xde, 0xbb, 0x77, 0xa7, 0xf7, 0xbe, 0x67, 0x38, 0x98, 0x4a, 0x23, 0x06, 0xc2,
+	0x88, 0xce, 0x2c, 0x52, 0x46, 0xd1, 0x12, 0x7e, 0x8e, 0x8e, 0x47, 0x81, 0x19, 0xc7, 0xfd, 0x8e,
+	0xaf, 0xa6, 0x27, 0x23, 0x35, 0x52, 0x27, 0x48, 0xf7, 0xe3, 0x21, 0x22, 0x04, 0x38, 0xe5, 0xb7,
+	0x5a, 0x3f, 0x0b, 0xb0, 0xff, 0xd6, 0x3e, 0x44, 0xef, 0x43, 0x35, 0x14, 0x53, 0xa9, 0x67, 0xc2,
+	0x97, 0xac, 0xea, 0x92, 0x76, 0xdd, 0xdb, 0x12, 0xf4, 0x10, 0x0a, 0xe7, 0x72, 0xce, 0x08, 0xf2,
+	0xd9, 0x48, 0x1f, 0x40, 0x51, 0x07, 0x97, 0x92, 0xed, 0xbb, 0xa4, 0x5d, 0xe8, 0x36, 0xd2, 0x55,
+	0xb3, 0xfa, 0x41, 0x19, 0x31, 0x79, 0x1f, 0x5c, 0x4a, 0x0f, 0x8f, 0xa8, 0x0b, 0x35, 0x6d, 0x54,
+	0x24, 0x46, 0x32, 0x23, 0xd9, 0x5e, 0xa6, 0xf4, 0x76, 0x29, 0xfa, 0x08, 0x1a, 0x7e, 0x24, 0x85,
+	0x09, 0x54, 0x78, 0x3a, 0x53, 0xfe, 0x98, 0x15, 0x50, 0xf3, 0x2f, 0x49, 0x1f, 0xc3, 0xc1, 0x44,
+	0x68, 0xf3, 0x29, 0x0a, 0x8c, 0xcc, 0x65, 0x45, 0x94, 0xdd, 0x62, 0xe9, 0x13, 0x28, 0xfb, 0xe3,
+	0x38, 0x3c, 0xd7, 0xac, 0xe4, 0x16, 0xda, 0xb5, 0xa7, 0xf5, 0x3c, 0x67, 0xe7, 0x75, 0x46, 0x76,
+	0x8b, 0x8b, 0x55, 0xd3, 0xf1, 0xac, 0x22, 0x8b, 0x8b, 0x13, 0x3a, 0x03, 0x97, 0xb4, 0x4b, 0xde,
+	0x96, 0xc8, 0x9c, 0xcf, 0x22, 0x79, 0x11, 0xa8, 0x58, 0xbf, 0x91, 0x73, 0x56, 0xc6, 0xd8, 0xbb,
+	0x14, 0x65, 0x50, 0x09, 0xe5, 0x17, 0x93, 0x9d, 0x56, 0xf0, 0x74, 0x03, 0x69, 0x17, 0x6a, 0xb1,
+	0x96, 0x51, 0x4f, 0x0e, 0x83, 0x50, 0x0e, 0x58, 0x0d, 0xad, 0xb8, 0xd6, 0xca, 0x66, 0xdd, 0x9d,
+	0x8f, 0x5b, 0xc9, 0x69, 0x68, 0xa2, 0xb9, 0xb7, 0x7b, 0xe9, 0xe8, 0x15, 0x1c, 0xde, 0x16, 0xec,
+	0x56, 0x50, 0xcd, 0x2b, 0xb8, 0x0b, 0xa5, 0x0b, 0x31, 0x89, 0xf3, 0xcd, 0x56, 0xbd, 0x1c, 0xbc,
+	0xdc, 0x7b, 0x41, 0x5a, 0xdf, 0x08, 0x94, 0x30, 0x35, 0x7d, 0x68, 0x6b, 0x22, 0x58, 0xd3, 0x9d,
+	0x74, 0xd5, 0xac, 0x65, 0x09, 0xcf, 0xc2, 0xee, 0xdc, 0x48, 0x6d, 0x8b, 0x3a, 0x86, 0x8a, 0xea,
+	0x7f, 0x96, 0xbe, 0xd1, 0x6c, 0x0f, 0xed, 0x36, 0xac, 0xdd, 0x77, 0xc8, 0xda, 0xd5, 0x6d, 0x34,
+	0x94, 0x42, 0x71, 0x2c, 0x74, 0x5e, 0x56, 0xdd, 0xc3, 0x99, 0xde, 0x83, 0xb2, 0x1a, 0x0e, 0xb5,
+	0x34, 0xb6, 0x1b, 0x8b, 0x5a, 0xcf, 0xa1, 0x9c, 0x3f, 0xf2, 0x9f, 0x5f, 0x88, 0x41, 0x45, 0x8f,
+	0x45, 0x34, 0x38, 0xeb, 0xd9, 0x04, 0x1b, 0xd8, 0xed, 0x2d, 0x12, 0xee, 0x2c, 0x13, 0xee, 0x5c,
+	0x27, 0xdc, 0xb9, 0x49, 0x38, 0xf9, 0x93, 0x70, 0xf2, 0x35, 0xe5, 0xe4, 0x2a, 0xe5, 0xe4, 0x47,
+	0xca, 0xc9, 0x22, 0xe5, 0x64, 0x99, 0x72, 0xf2, 0x2b, 0xe5, 0xe4, 0x77, 0xca, 0x9d, 0x9b, 0x94,
+	0x93, 0xef, 0x6b, 0xee, 0x5c, 0xad, 0x39, 0x59, 0xae, 0xb9, 0x73, 0xbd, 0xe6, 0x4e, 0xbf, 0x8c,
+	0x21, 0x9e, 0xfd, 0x1d, 0x00, 0x8c, 0x83, 0x34, 0x81, 0x2e, 0x03, 0x00, 0x00,
 }
 
 func (this *Metadata) Compare(that interface{}) int {
@@ -363,6 +366,12 @@ func (this *Chunk) Compare(that interface{}) int {
 	if c := bytes.Compare(this.Hash, that1.Hash); c != 0 {
 		return c
 	}
+	if this.Offset != that1.Offset {
+		if this.Offset < that1.Offset {
+			return -1
+		}
+		return 1
+	}
 	return 0
 }
 func (this *Object) Compare(that interface{}) int {
@@ -498,6 +507,9 @@ func (this *Chunk) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Hash, that1.Hash) {
 		return false
 	}
+	if this.Offset != that1.Offset {
+		return false
+	}
 	return true
 }
 func (this *Object) Equal(that interface{}) bool {
@@ -569,7 +581,7 @@ func (this *Chunk) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&proto.Chunk{")
 	s = append(s, "SizeInBytes: "+fmt.Sprintf("%#v", this.SizeInBytes)+",\n")
 	if this.Objects != nil {
@@ -580,6 +592,7 @@ func (this *Chunk) GoString() string {
 		s = append(s, "Objects: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
 	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -731,6 +744,11 @@ func (m *Chunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Offset != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -889,6 +907,10 @@ func NewPopulatedChunk(r randyMetadata, easy bool) *Chunk {
 	for i := 0; i < v10; i++ {
 		this.Hash[i] = byte(r.Intn(256))
 	}
+	this.Offset = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Offset *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1052,6 +1074,9 @@ func (m *Chunk) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	if m.Offset != 0 {
+		n += 1 + sovMetadata(uint64(m.Offset))
+	}
 	return n
 }
 
@@ -1126,6 +1151,7 @@ func (this *Chunk) String() string {
 		`SizeInBytes:` + fmt.Sprintf("%v", this.SizeInBytes) + `,`,
 		`Objects:` + repeatedStringForObjects + `,`,
 		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1710,6 +1736,25 @@ func (m *Chunk) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
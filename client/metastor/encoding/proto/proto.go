@@ -42,6 +42,7 @@ func MarshalMetadata(md metatypes.Metadata) ([]byte, error) {
 			chunk := &s.Chunks[index]
 			chunk.SizeInBytes = input.Size
 			chunk.Hash = input.Hash
+			chunk.Offset = input.Offset
 			if length := len(input.Objects); length > 0 {
 				chunk.Objects = make([]Object, length)
 				for index, input := range input.Objects {
@@ -90,6 +91,7 @@ func UnmarshalMetadata(b []byte, md *metatypes.Metadata) error {
 			chunk := &md.Chunks[index]
 			chunk.Size = input.SizeInBytes
 			chunk.Hash = input.Hash
+			chunk.Offset = input.Offset
 			if length := len(input.Objects); length > 0 {
 				chunk.Objects = make([]metatypes.Object, length)
 				for index, input := range input.Objects {
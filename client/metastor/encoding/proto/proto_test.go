@@ -81,9 +81,11 @@ func TestMarshalUnmarshal(t *testing.T) {
 							ShardID: "bar",
 						},
 					},
-					Hash: []byte("baz"),
+					Hash:   []byte("baz"),
+					Offset: 42,
 				},
 			},
+			ChunkSize:   metatypes.VariableChunkSize,
 			NextKey:     []byte("one"),
 			PreviousKey: []byte("three"),
 		},
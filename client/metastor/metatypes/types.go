@@ -23,6 +23,11 @@ package metatypes
 // for its file data, but that not everybody needs, and thus
 // it might help us define a better format, that allows for additional features like this.
 
+// VariableChunkSize is the chunk size of metadata,
+// which references data that was split into chunks of variable size,
+// as is the case when content-defined chunking is used.
+const VariableChunkSize = -1
+
 type (
 	// Metadata represents the metadata of some data.
 	// It is stored in some metadata server/cluster, and references
@@ -55,6 +60,8 @@ type (
 
 		// ChunkSize is the fixed chunk size, which is size used for all chunks,
 		// except for the last chunk which might be less or equal to that chunk size.
+		// It equals VariableChunkSize in case the data was split into chunks of variable size,
+		// in which case the offset of each chunk is defined by that chunk.
 		ChunkSize int32
 
 		// PreviousKey is an optional key to the previous Metadata (node),
@@ -85,6 +92,12 @@ type (
 		// Hash contains the checksum/signature of the entire chunk,
 		// meaning the data of all objects (of this chunk) combined.
 		Hash []byte
+
+		// Offset defines the offset of the (unprocessed) data of this chunk,
+		// within the data of the metadata which references it.
+		// It is only defined for chunks of variable size (see: VariableChunkSize),
+		// as the offset of a chunk of a fixed size can be computed from its index instead.
+		Offset int64
 	}
 
 	// Object represents the metadata of an object,
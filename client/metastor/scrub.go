@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metastor
+
+import (
+	dbp "github.com/threefoldtech/0-stor/client/metastor/db"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+// Scrub records are stored in a separate namespace,
+// derived from the namespace of the client, such that they never show up
+// when listing the keys of the (current) metadata.
+//
+// Within that namespace the record of a key is stored using that same key,
+// as metadata which defines the moment of the scrub as its last-write epoch,
+// and the result of the scrub as user-defined metadata.
+const scrubNamespaceSuffix = "\x00scrub"
+
+const (
+	scrubResultField = "result"
+	scrubErrorField  = "error"
+)
+
+// ScrubRecord is the record of the last scrub of a key,
+// stored by a scrubber which checks (and repairs) the data of all keys.
+type ScrubRecord struct {
+	// Epoch defines the time the key was scrubbed,
+	// in the Unix epoch format, in nano seconds.
+	Epoch int64
+	// Result defines the result of the scrub,
+	// its possible values are defined by the scrubber.
+	Result string
+	// Error is the error which occurred while scrubbing the key, if any.
+	Error string
+}
+
+// SetScrubRecord stores the given record as the scrub record of the given key,
+// overwriting the previous record of that key, if any.
+func (c *Client) SetScrubRecord(key []byte, record ScrubRecord) error {
+	if len(key) == 0 {
+		return ErrNilKey
+	}
+	md := metatypes.Metadata{
+		Namespace:      c.namespace,
+		Key:            key,
+		LastWriteEpoch: record.Epoch,
+		UserDefined:    map[string]string{scrubResultField: record.Result},
+	}
+	if record.Error != "" {
+		md.UserDefined[scrubErrorField] = record.Error
+	}
+	bytes, err := c.encode(md)
+	if err != nil {
+		return err
+	}
+	return c.db.Set(c.scrubNamespace(), key, bytes)
+}
+
+// GetScrubRecord returns the scrub record of the given key.
+//
+// ErrNotFound is returned in case the key was never scrubbed.
+func (c *Client) GetScrubRecord(key []byte) (*ScrubRecord, error) {
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+	bytes, err := c.db.Get(c.scrubNamespace(), key)
+	if err != nil {
+		return nil, err
+	}
+	var md metatypes.Metadata
+	err = c.decode(bytes, &md)
+	if err != nil {
+		return nil, err
+	}
+	return &ScrubRecord{
+		Epoch:  md.LastWriteEpoch,
+		Result: md.UserDefined[scrubResultField],
+		Error:  md.UserDefined[scrubErrorField],
+	}, nil
+}
+
+// DeleteScrubRecord deletes the scrub record of the given key.
+// It is not considered an error if the record was already deleted.
+func (c *Client) DeleteScrubRecord(key []byte) error {
+	if len(key) == 0 {
+		return ErrNilKey
+	}
+	return c.db.Delete(c.scrubNamespace(), key)
+}
+
+// ListScrubRecords lists the keys of all stored scrub records,
+// and executes the given callback for each of them.
+func (c *Client) ListScrubRecords(cb dbp.ListCallback) error {
+	return c.db.ListKeys(c.scrubNamespace(), cb)
+}
+
+func (c *Client) scrubNamespace() []byte {
+	return []byte(string(c.namespace) + scrubNamespaceSuffix)
+}
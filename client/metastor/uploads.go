@@ -0,0 +1,236 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metastor
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+// Upload sessions are stored in a separate namespace,
+// derived from the namespace of the client, such that they never show up
+// when listing the keys of the (current) metadata.
+//
+// Within that namespace, a session is stored using its upload ID as the key,
+// while each of its parts is stored as the upload ID and the hex-encoded part number
+// (as a fixed-size number), separated by a slash.
+// This way all parts of a session are sorted by their number.
+//
+// Both sessions and their parts are stored as metadata, where a session
+// defines the key, user-defined metadata and timing of the upload,
+// while each part defines the chunks of the data uploaded as that part.
+const uploadNamespaceSuffix = "\x00uploads"
+
+var (
+	// ErrInvalidUploadID is an error returned in case an upload ID is given,
+	// which is empty or contains a slash.
+	ErrInvalidUploadID = errors.New("invalid upload ID given")
+)
+
+type (
+	// UploadCallback is the type of callback used to process listed upload sessions.
+	UploadCallback func(uploadID string) error
+
+	// UploadPartCallback is the type of callback used to process listed upload parts.
+	UploadPartCallback func(part int64) error
+)
+
+// SetUpload stores the given metadata as the upload session with the given ID.
+// Storing a session which already exists overwrites that session, but not its parts.
+func (c *Client) SetUpload(uploadID string, md metatypes.Metadata) error {
+	if !isValidUploadID(uploadID) {
+		return ErrInvalidUploadID
+	}
+	return c.setUploadMetadata([]byte(uploadID), md)
+}
+
+// GetUpload returns the metadata stored as the upload session with the given ID.
+//
+// ErrNotFound is returned in case the session doesn't exist.
+func (c *Client) GetUpload(uploadID string) (*metatypes.Metadata, error) {
+	if !isValidUploadID(uploadID) {
+		return nil, ErrInvalidUploadID
+	}
+	return c.getUploadMetadata([]byte(uploadID))
+}
+
+// SetUploadCompleted marks the upload session with the given ID as completed, or unmarks it,
+// which is stored in the session by linking it to its key, as the NextKey of the session.
+// A session is marked as completed prior to storing its parts as the metadata of its key,
+// such that the chunks of a (being) completed session are never released by aborting it.
+//
+// ErrNotFound is returned in case the session doesn't exist,
+// such that a session which got aborted can no longer be completed.
+func (c *Client) SetUploadCompleted(uploadID string, completed bool) error {
+	if !isValidUploadID(uploadID) {
+		return ErrInvalidUploadID
+	}
+	_, err := c.updateMetadata(c.uploadNamespace(), []byte(uploadID), func(md metatypes.Metadata) (*metatypes.Metadata, error) {
+		md.NextKey = nil
+		if completed {
+			md.NextKey = md.Key
+		}
+		return &md, nil
+	})
+	return err
+}
+
+// UploadCompleted returns true in case the given upload session
+// is marked as completed (see SetUploadCompleted).
+func UploadCompleted(session metatypes.Metadata) bool {
+	return len(session.NextKey) > 0
+}
+
+// ListUploads lists the IDs of all stored upload sessions,
+// and executes the given callback for each of them.
+// Sessions of which only parts remain (e.g. because they got deleted while a part was stored)
+// are listed as well, even though GetUpload returns ErrNotFound for them.
+func (c *Client) ListUploads(cb UploadCallback) error {
+	listed := make(map[string]struct{})
+	return c.db.ListKeys(c.uploadNamespace(), func(dbKey []byte) error {
+		uploadID := strings.SplitN(string(dbKey), "/", 2)[0]
+		if _, ok := listed[uploadID]; ok {
+			return nil
+		}
+		listed[uploadID] = struct{}{}
+		return cb(uploadID)
+	})
+}
+
+// SetUploadPart stores the given metadata as the given part of the upload session with the given ID.
+// Storing a part which already exists overwrites that part.
+func (c *Client) SetUploadPart(uploadID string, part int64, md metatypes.Metadata) error {
+	if !isValidUploadID(uploadID) {
+		return ErrInvalidUploadID
+	}
+	return c.setUploadMetadata(uploadPartKey(uploadID, part), md)
+}
+
+// GetUploadPart returns the metadata stored as the given part of the upload session with the given ID.
+//
+// ErrNotFound is returned in case the part doesn't exist.
+func (c *Client) GetUploadPart(uploadID string, part int64) (*metatypes.Metadata, error) {
+	if !isValidUploadID(uploadID) {
+		return nil, ErrInvalidUploadID
+	}
+	return c.getUploadMetadata(uploadPartKey(uploadID, part))
+}
+
+// UpdateUploadPart updates the given part of the upload session with the given ID,
+// just like UpdateMetadata updates current metadata.
+// ErrNotFound is returned in case the part doesn't exist,
+// such that the part of a session which got completed or aborted is never stored again.
+//
+// UpdateUploadPart panics when no callback is given.
+func (c *Client) UpdateUploadPart(uploadID string, part int64, cb UpdateMetadataFunc) (*metatypes.Metadata, error) {
+	if !isValidUploadID(uploadID) {
+		return nil, ErrInvalidUploadID
+	}
+	return c.updateMetadata(c.uploadNamespace(), uploadPartKey(uploadID, part), cb)
+}
+
+// ListUploadParts lists all stored parts of the upload session with the given ID,
+// and executes the given callback for each of them.
+// The parts are sorted by their number.
+func (c *Client) ListUploadParts(uploadID string, cb UploadPartCallback) error {
+	if !isValidUploadID(uploadID) {
+		return ErrInvalidUploadID
+	}
+	prefix := uploadID + "/"
+	return c.db.ListKeys(c.uploadNamespace(), func(dbKey []byte) error {
+		if !strings.HasPrefix(string(dbKey), prefix) {
+			return nil
+		}
+		part, err := strconv.ParseUint(string(dbKey[len(prefix):]), 16, 64)
+		if err != nil {
+			return fmt.Errorf("invalid upload part key %q: %v", dbKey, err)
+		}
+		return cb(int64(part))
+	})
+}
+
+// DeleteUpload deletes the upload session with the given ID, as well as all its parts.
+// It is not considered an error if the session was already deleted.
+func (c *Client) DeleteUpload(uploadID string) error {
+	if !isValidUploadID(uploadID) {
+		return ErrInvalidUploadID
+	}
+
+	// collect the parts first,
+	// as the database might not allow nested calls while listing
+	var parts []int64
+	err := c.ListUploadParts(uploadID, func(part int64) error {
+		parts = append(parts, part)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// delete the session first, such that it no longer can be completed
+	namespace := c.uploadNamespace()
+	err = c.db.Delete(namespace, []byte(uploadID))
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		err = c.db.Delete(namespace, uploadPartKey(uploadID, part))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) setUploadMetadata(dbKey []byte, md metatypes.Metadata) error {
+	md.Namespace = c.namespace
+	bytes, err := c.encode(md)
+	if err != nil {
+		return err
+	}
+	return c.db.Set(c.uploadNamespace(), dbKey, bytes)
+}
+
+func (c *Client) getUploadMetadata(dbKey []byte) (*metatypes.Metadata, error) {
+	bytes, err := c.db.Get(c.uploadNamespace(), dbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata metatypes.Metadata
+	err = c.decode(bytes, &metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func (c *Client) uploadNamespace() []byte {
+	return []byte(string(c.namespace) + uploadNamespaceSuffix)
+}
+
+func uploadPartKey(uploadID string, part int64) []byte {
+	return []byte(fmt.Sprintf("%s/%016x", uploadID, uint64(part)))
+}
+
+func isValidUploadID(uploadID string) bool {
+	return uploadID != "" && !strings.Contains(uploadID, "/")
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metastor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+// Older versions of metadata are stored in a separate namespace,
+// derived from the namespace of the client, such that they never show up
+// when listing the keys of the (current) metadata.
+//
+// Within that namespace each version is stored as
+// the hex-encoded key of the metadata and the hex-encoded version (as a fixed-size number),
+// separated by a slash. This way all versions of a key are sorted chronologically.
+const versionNamespaceSuffix = "\x00versions"
+
+// VersionCallback is the type of callback used to process listed versions.
+type VersionCallback func(key []byte, version int64) error
+
+// SetVersion stores the given metadata as an older version of the key defined as part of it,
+// identified by the LastWriteEpoch of the metadata.
+// Storing a version which already exists overwrites that version.
+//
+// An error is returned in case the version couldn't be stored.
+func (c *Client) SetVersion(md metatypes.Metadata) error {
+	if len(md.Key) == 0 {
+		return ErrNilKey
+	}
+	md.Namespace = c.namespace
+
+	bytes, err := c.encode(md)
+	if err != nil {
+		return err
+	}
+	return c.db.Set(c.versionNamespace(), versionKey(md.Key, md.LastWriteEpoch), bytes)
+}
+
+// GetVersion returns the metadata stored as the given version of the given key.
+//
+// ErrNotFound is returned in case the version couldn't be found.
+func (c *Client) GetVersion(key []byte, version int64) (*metatypes.Metadata, error) {
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+
+	bytes, err := c.db.Get(c.versionNamespace(), versionKey(key, version))
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata metatypes.Metadata
+	err = c.decode(bytes, &metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// ListVersions lists all stored versions of the given key,
+// and executes the given callback for each of them.
+// The versions are sorted from oldest to newest.
+func (c *Client) ListVersions(key []byte, cb VersionCallback) error {
+	if len(key) == 0 {
+		return ErrNilKey
+	}
+	prefix := versionKeyPrefix(key)
+	return c.db.ListKeys(c.versionNamespace(), func(dbKey []byte) error {
+		if !bytes.HasPrefix(dbKey, prefix) {
+			return nil
+		}
+		key, version, err := parseVersionKey(dbKey)
+		if err != nil {
+			return err
+		}
+		return cb(key, version)
+	})
+}
+
+// UpdateVersion updates the given version of the given key,
+// just like UpdateMetadata updates current metadata.
+// ErrNotFound is returned in case the version doesn't exist,
+// such that a deleted version is never stored again.
+//
+// UpdateVersion panics when no callback is given.
+func (c *Client) UpdateVersion(key []byte, version int64, cb UpdateMetadataFunc) (*metatypes.Metadata, error) {
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+	return c.updateMetadata(c.versionNamespace(), versionKey(key, version), cb)
+}
+
+// DeleteVersion deletes the metadata stored as the given version of the given key.
+// It is not considered an error if the version was already deleted.
+func (c *Client) DeleteVersion(key []byte, version int64) error {
+	if len(key) == 0 {
+		return ErrNilKey
+	}
+	return c.db.Delete(c.versionNamespace(), versionKey(key, version))
+}
+
+// ListAllVersions lists all stored versions, of all keys,
+// and executes the given callback for each of them.
+// The versions are sorted per key, from oldest to newest.
+func (c *Client) ListAllVersions(cb VersionCallback) error {
+	return c.db.ListKeys(c.versionNamespace(), func(dbKey []byte) error {
+		key, version, err := parseVersionKey(dbKey)
+		if err != nil {
+			return err
+		}
+		return cb(key, version)
+	})
+}
+
+func (c *Client) versionNamespace() []byte {
+	return []byte(string(c.namespace) + versionNamespaceSuffix)
+}
+
+func versionKey(key []byte, version int64) []byte {
+	return []byte(fmt.Sprintf("%s%016x", versionKeyPrefix(key), uint64(version)))
+}
+
+func versionKeyPrefix(key []byte) []byte {
+	return []byte(hex.EncodeToString(key) + "/")
+}
+
+func parseVersionKey(dbKey []byte) ([]byte, int64, error) {
+	parts := strings.SplitN(string(dbKey), "/", 2)
+	if len(parts) != 2 {
+		return nil, 0, fmt.Errorf("invalid version key %q", dbKey)
+	}
+	key, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid version key %q: %v", dbKey, err)
+	}
+	version, err := strconv.ParseUint(parts[1], 16, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid version key %q: %v", dbKey, err)
+	}
+	return key, int64(version), nil
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SetOverwriteConfig configures what happens with the chunks of the data
+// previously stored for a key, when that key gets overwritten.
+// By default those chunks are deleted immediately.
+//
+// It should be called prior to using the client,
+// and returns an error in case the given config is invalid.
+func (c *Client) SetOverwriteConfig(cfg OverwriteConfig) error {
+	if cfg.Mode > _MaxOverwriteMode {
+		return fmt.Errorf("invalid overwrite mode %d", uint8(cfg.Mode))
+	}
+	if cfg.Delay < 0 {
+		return fmt.Errorf("invalid (negative) overwrite delay %v", cfg.Delay)
+	}
+	if cfg.Mode == OverwriteModeDelayed && cfg.Delay == 0 {
+		cfg.Delay = DefaultOverwriteDelay
+	}
+	c.overwrite = cfg
+	return nil
+}
+
+// commitMetadata stores the given metadata atomically,
+// returning the metadata it replaced, if any.
+//
+// When two writes of a new key race, both of them might set their metadata,
+// in which case the chunks of the first write are never freed,
+// and will have to be collected by the garbage collector.
+func (c *Client) commitMetadata(md metatypes.Metadata) (*metatypes.Metadata, error) {
+	var previous *metatypes.Metadata
+	_, err := c.metastorClient.UpdateMetadata(md.Key, func(old metatypes.Metadata) (*metatypes.Metadata, error) {
+		// when keeping overwritten data, the old metadata is stored as a version
+		// prior to committing the new metadata, such that its chunks are referenced at all times
+		if c.overwrite.Mode == OverwriteModeKeep && len(old.Chunks) > 0 {
+			err := c.metastorClient.SetVersion(old)
+			if err != nil {
+				return nil, err
+			}
+		}
+		previous = &old
+		md.Namespace = old.Namespace
+		return &md, nil
+	})
+	if err == metastor.ErrNotFound {
+		return nil, c.metastorClient.SetMetadata(md)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+// handleSupersededMetadata frees the chunks of the given (overwritten) metadata,
+// in the way defined by the overwrite mode of this client.
+//
+// Errors are only logged, as the new metadata has been committed already,
+// chunks that couldn't be freed are left to be collected by the garbage collector.
+func (c *Client) handleSupersededMetadata(md metatypes.Metadata) {
+	if len(md.Chunks) == 0 {
+		return
+	}
+	switch c.overwrite.Mode {
+	case OverwriteModeKeep:
+		// already stored as an older version, while committing the new metadata
+	case OverwriteModeDelayed:
+		c.scheduleDelete(md)
+	default:
+		c.deleteSupersededChunks(md)
+	}
+}
+
+// pendingDelete defines the chunks of overwritten metadata,
+// which are scheduled to be deleted
+type pendingDelete struct {
+	timer *time.Timer
+	md    metatypes.Metadata
+}
+
+func (c *Client) scheduleDelete(md metatypes.Metadata) {
+	pd := &pendingDelete{md: md}
+
+	c.pendingMux.Lock()
+	defer c.pendingMux.Unlock()
+	if c.pendingDeletes == nil {
+		c.pendingDeletes = make(map[*pendingDelete]struct{})
+	}
+	c.pendingDeletes[pd] = struct{}{}
+	pd.timer = time.AfterFunc(c.overwrite.Delay, func() {
+		c.pendingMux.Lock()
+		_, ok := c.pendingDeletes[pd]
+		delete(c.pendingDeletes, pd)
+		c.pendingMux.Unlock()
+		if ok {
+			c.deleteSupersededChunks(pd.md)
+		}
+	})
+}
+
+// flushPendingDeletes executes all pending deletes immediately
+func (c *Client) flushPendingDeletes() {
+	c.pendingMux.Lock()
+	pending := c.pendingDeletes
+	c.pendingDeletes = nil
+	c.pendingMux.Unlock()
+
+	for pd := range pending {
+		pd.timer.Stop()
+		c.deleteSupersededChunks(pd.md)
+	}
+}
+
+func (c *Client) deleteSupersededChunks(md metatypes.Metadata) {
+	err := c.freeChunks(context.Background(), md.Chunks)
+	if err != nil {
+		log.WithError(err).Errorf(
+			"failed to delete the chunks of overwritten metadata of %q", md.Key)
+	}
+}
@@ -0,0 +1,338 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+const (
+	// DefaultReaderCacheSize is the amount of decoded chunks
+	// an ObjectReader keeps cached in memory.
+	DefaultReaderCacheSize = 8
+	// DefaultReaderPrefetchSize is the amount of chunks
+	// an ObjectReader fetches ahead, while it is read sequentially.
+	DefaultReaderPrefetchSize = 2
+)
+
+var (
+	// ErrReaderClosed is returned when using an ObjectReader which is already closed.
+	ErrReaderClosed = errors.New("Client: object reader is closed")
+)
+
+var (
+	_ io.ReaderAt   = (*ObjectReader)(nil)
+	_ io.ReadSeeker = (*ObjectReader)(nil)
+	_ io.Closer     = (*ObjectReader)(nil)
+)
+
+// Open returns a file-like handle over the data referenced by the given metadata,
+// allowing it to be read at random offsets.
+// See ObjectReader for more information.
+func (c *Client) Open(meta metatypes.Metadata) (*ObjectReader, error) {
+	return c.OpenContext(context.Background(), meta)
+}
+
+// OpenContext returns a file-like handle over the data referenced by the given metadata,
+// allowing it to be read at random offsets.
+// All reads are aborted as soon as the given context is cancelled or its deadline expires.
+// See ObjectReader for more information.
+func (c *Client) OpenContext(ctx context.Context, meta metatypes.Metadata) (*ObjectReader, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+
+	// without a chunk size all data is expected to be stored as a single chunk
+	chunkSize := int64(meta.ChunkSize)
+	if chunkSize == metatypes.VariableChunkSize {
+		err := checkChunkOffsets(meta)
+		if err != nil {
+			return nil, err
+		}
+	} else if chunkSize <= 0 {
+		if len(meta.Chunks) > 1 {
+			return nil, fmt.Errorf(
+				"metadata of %q has %d chunks, but no chunk size", meta.Key, len(meta.Chunks))
+		}
+		chunkSize = meta.Size
+	}
+	if chunkSize > 0 && int64(len(meta.Chunks)) < (meta.Size+chunkSize-1)/chunkSize {
+		return nil, fmt.Errorf(
+			"metadata of %q has %d chunks, which is not enough to contain %d bytes",
+			meta.Key, len(meta.Chunks), meta.Size)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &ObjectReader{
+		ctx:          ctx,
+		cancel:       cancel,
+		pipeline:     c.dataPipeline,
+		chunks:       meta.Chunks,
+		size:         meta.Size,
+		chunkSize:    chunkSize,
+		cacheSize:    DefaultReaderCacheSize,
+		prefetchSize: DefaultReaderPrefetchSize,
+		cache:        make(map[int64]*list.Element),
+		lru:          list.New(),
+		lastChunk:    -1,
+	}, nil
+}
+
+// ObjectReader is a file-like handle over data stored in a 0-stor cluster,
+// implementing io.ReaderAt, io.ReadSeeker and io.Closer.
+//
+// Only the chunks required to serve a read are fetched,
+// using the chunk size defined in the metadata of the data,
+// or the offsets of the chunks in case they are of variable size.
+// The most recently used chunks are cached in their decoded form,
+// and while the data is read sequentially, the next chunks are fetched ahead of time.
+//
+// ReadAt can be called from multiple goroutines at once,
+// while Read and Seek share a single offset and shouldn't be used concurrently.
+type ObjectReader struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	pipeline pipeline.Pipeline
+
+	chunks    []metatypes.Chunk
+	size      int64
+	chunkSize int64
+
+	cacheSize    int
+	prefetchSize int
+
+	mux       sync.Mutex
+	cache     map[int64]*list.Element
+	lru       *list.List
+	lastChunk int64
+	closed    bool
+
+	offset int64
+}
+
+// cachedChunk is a decoded chunk, or the chunk which is being fetched.
+// The data and err properties can only be used once done is closed.
+type cachedChunk struct {
+	index int64
+	done  chan struct{}
+	data  []byte
+	err   error
+}
+
+// Size returns the total size of the data.
+func (r *ObjectReader) Size() int64 {
+	return r.size
+}
+
+// ReadAt implements io.ReaderAt.ReadAt
+func (r *ObjectReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("ObjectReader.ReadAt: negative offset")
+	}
+	if off >= r.size {
+		if len(p) == 0 {
+			return 0, nil
+		}
+		return 0, io.EOF
+	}
+
+	var n int
+	for n < len(p) && off < r.size {
+		index, chunkOffset := chunkAt(r.chunks, r.chunkSize, off)
+		data, err := r.chunk(index)
+		if err != nil {
+			return n, err
+		}
+		start := off - chunkOffset
+		if start >= int64(len(data)) {
+			// the chunk contains less data than the metadata defines
+			return n, io.ErrUnexpectedEOF
+		}
+		m := copy(p[n:], data[start:])
+		n += m
+		off += int64(m)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Read implements io.Reader.Read
+func (r *ObjectReader) Read(p []byte) (int, error) {
+	n, err := r.ReadAt(p, r.offset)
+	r.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		// report the EOF on the next read
+		err = nil
+	}
+	return n, err
+}
+
+// Seek implements io.Seeker.Seek
+func (r *ObjectReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("ObjectReader.Seek: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("ObjectReader.Seek: negative position")
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+// Close implements io.Closer.Close,
+// aborting all pending fetches and freeing all cached chunks.
+func (r *ObjectReader) Close() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.closed {
+		return ErrReaderClosed
+	}
+	r.closed = true
+	r.cancel()
+	r.cache = nil
+	r.lru = nil
+	return nil
+}
+
+// chunk returns the decoded data of the chunk with the given index,
+// fetching it (and the chunks that follow it, while reading sequentially),
+// in case it isn't cached yet.
+func (r *ObjectReader) chunk(index int64) ([]byte, error) {
+	r.mux.Lock()
+	if r.closed {
+		r.mux.Unlock()
+		return nil, ErrReaderClosed
+	}
+	cc, fetch := r.cachedChunk(index)
+	if index == r.lastChunk+1 {
+		// reading sequentially, fetch the next chunks ahead of time
+		for i := index + 1; i <= index+int64(r.prefetchSize) && i < int64(len(r.chunks)); i++ {
+			if prefetched, ok := r.cachedChunk(i); ok {
+				go r.fetch(prefetched)
+			}
+		}
+	}
+	r.lastChunk = index
+	r.mux.Unlock()
+
+	if fetch {
+		r.fetch(cc)
+	} else {
+		select {
+		case <-cc.done:
+		case <-r.ctx.Done():
+			return nil, r.ctx.Err()
+		}
+	}
+	return cc.data, cc.err
+}
+
+// cachedChunk returns the cached chunk for the given index,
+// marking it as most recently used, or adds a new one in case it isn't cached yet.
+// True is returned in case the chunk is new, and still has to be fetched by the caller.
+// The lock of the reader has to be held by the caller.
+func (r *ObjectReader) cachedChunk(index int64) (*cachedChunk, bool) {
+	if elem, ok := r.cache[index]; ok {
+		r.lru.MoveToFront(elem)
+		return elem.Value.(*cachedChunk), false
+	}
+
+	cc := &cachedChunk{index: index, done: make(chan struct{})}
+	r.cache[index] = r.lru.PushFront(cc)
+	for r.lru.Len() > r.cacheSize {
+		// evicted chunks which are still being fetched
+		// remain available to the readers waiting for them
+		elem := r.lru.Back()
+		r.lru.Remove(elem)
+		delete(r.cache, elem.Value.(*cachedChunk).index)
+	}
+	return cc, true
+}
+
+// fetch reads and decodes the given chunk from the 0-stor cluster
+func (r *ObjectReader) fetch(cc *cachedChunk) {
+	defer close(cc.done)
+	var buf bytes.Buffer
+	if r.chunkSize > 0 {
+		buf.Grow(int(r.chunkSize))
+	}
+	cc.err = r.pipeline.ReadContext(r.ctx, r.chunks[cc.index:cc.index+1], &buf)
+	if cc.err == nil {
+		cc.data = buf.Bytes()
+		return
+	}
+
+	// don't cache errors, such that the chunk can be fetched again
+	r.mux.Lock()
+	if elem, ok := r.cache[cc.index]; ok && elem.Value == cc {
+		r.lru.Remove(elem)
+		delete(r.cache, cc.index)
+	}
+	r.mux.Unlock()
+}
+
+// chunkAt returns the index of the chunk which contains the data at the given offset,
+// as well as the offset at which the data of that chunk starts,
+// using the given chunk size, or the offsets of the given chunks in case they are of variable size.
+func chunkAt(chunks []metatypes.Chunk, chunkSize, offset int64) (int64, int64) {
+	if chunkSize != metatypes.VariableChunkSize {
+		index := offset / chunkSize
+		return index, index * chunkSize
+	}
+	index := sort.Search(len(chunks), func(i int) bool {
+		return chunks[i].Offset > offset
+	}) - 1
+	if index < 0 {
+		return 0, 0
+	}
+	return int64(index), chunks[index].Offset
+}
+
+// checkChunkOffsets returns an error in case the chunks of variable size
+// of the given metadata don't define increasing offsets within its data
+func checkChunkOffsets(meta metatypes.Metadata) error {
+	if meta.Size > 0 && len(meta.Chunks) == 0 {
+		return fmt.Errorf("metadata of %q has no chunks, while it has %d bytes", meta.Key, meta.Size)
+	}
+	for index, chunk := range meta.Chunks {
+		if (index == 0 && chunk.Offset != 0) || (index > 0 && chunk.Offset <= meta.Chunks[index-1].Offset) ||
+			chunk.Offset >= meta.Size {
+			return fmt.Errorf(
+				"metadata of %q has chunk %d at offset %d, which is not a valid offset",
+				meta.Key, index, chunk.Offset)
+		}
+	}
+	return nil
+}
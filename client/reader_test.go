@@ -0,0 +1,301 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestObjectReaderChunked(t *testing.T) {
+	testObjectReader(t, 256)
+}
+
+func TestObjectReaderNotChunked(t *testing.T) {
+	testObjectReader(t, 0)
+}
+
+func TestObjectReaderVariableChunked(t *testing.T) {
+	testObjectReader(t, metatypes.VariableChunkSize)
+}
+
+func testObjectReader(t *testing.T, chunkSize int) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, _, err := getTestClient(newDefaultConfig(shards, chunkSize))
+	require.NoError(t, err)
+	defer c.Close()
+
+	data := make([]byte, 256*10+100)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	meta, err := c.Write([]byte("testkey"), bytes.NewReader(data))
+	require.NoError(t, err)
+
+	t.Run("read", func(t *testing.T) {
+		r, err := c.Open(*meta)
+		require.NoError(t, err)
+		defer r.Close()
+		require.Equal(t, int64(len(data)), r.Size())
+
+		output, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, output)
+
+		n, err := r.Read(make([]byte, 1))
+		require.Equal(t, io.EOF, err)
+		require.Equal(t, 0, n)
+	})
+
+	t.Run("read_at", func(t *testing.T) {
+		r, err := c.Open(*meta)
+		require.NoError(t, err)
+		defer r.Close()
+
+		testCases := []struct {
+			offset, length int
+		}{
+			{0, len(data)},
+			{0, 1},
+			{256, 256},
+			{255, 2},
+			{257, 256*3 - 1},
+			{len(data) - 1, 1},
+			{len(data) - 100, 100},
+		}
+		for _, tc := range testCases {
+			buf := make([]byte, tc.length)
+			n, err := r.ReadAt(buf, int64(tc.offset))
+			require.NoError(t, err)
+			require.Equal(t, tc.length, n)
+			require.Equal(t, data[tc.offset:tc.offset+tc.length], buf)
+		}
+
+		// reading past the end returns io.EOF
+		buf := make([]byte, 100)
+		n, err := r.ReadAt(buf, int64(len(data)-50))
+		require.Equal(t, io.EOF, err)
+		require.Equal(t, 50, n)
+		require.Equal(t, data[len(data)-50:], buf[:n])
+		n, err = r.ReadAt(buf, int64(len(data)))
+		require.Equal(t, io.EOF, err)
+		require.Equal(t, 0, n)
+		_, err = r.ReadAt(buf, -1)
+		require.Error(t, err)
+	})
+
+	t.Run("read_at_concurrent", func(t *testing.T) {
+		r, err := c.Open(*meta)
+		require.NoError(t, err)
+		defer r.Close()
+
+		var group errgroup.Group
+		for i := 0; i < 16; i++ {
+			offset := (i * 173) % (len(data) - 300)
+			group.Go(func() error {
+				buf := make([]byte, 300)
+				_, err := r.ReadAt(buf, int64(offset))
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(data[offset:offset+300], buf) {
+					t.Errorf("unexpected data read at offset %d", offset)
+				}
+				return nil
+			})
+		}
+		require.NoError(t, group.Wait())
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		r, err := c.Open(*meta)
+		require.NoError(t, err)
+		defer r.Close()
+
+		pos, err := r.Seek(-100, io.SeekEnd)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)-100), pos)
+		output, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data[len(data)-100:], output)
+
+		pos, err = r.Seek(300, io.SeekStart)
+		require.NoError(t, err)
+		require.Equal(t, int64(300), pos)
+		pos, err = r.Seek(-44, io.SeekCurrent)
+		require.NoError(t, err)
+		require.Equal(t, int64(256), pos)
+		buf := make([]byte, 10)
+		_, err = io.ReadFull(r, buf)
+		require.NoError(t, err)
+		require.Equal(t, data[256:266], buf)
+
+		_, err = r.Seek(-1, io.SeekStart)
+		require.Error(t, err)
+		_, err = r.Seek(0, 42)
+		require.Error(t, err)
+	})
+
+	t.Run("close", func(t *testing.T) {
+		r, err := c.Open(*meta)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, ErrReaderClosed, r.Close())
+		_, err = r.ReadAt(make([]byte, 1), 0)
+		require.Equal(t, ErrReaderClosed, err)
+	})
+}
+
+func TestObjectReaderCache(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, _, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(t, err)
+	defer c.Close()
+	cp := &countingPipeline{Pipeline: c.dataPipeline}
+	c.dataPipeline = cp
+
+	data := make([]byte, 64*32)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	meta, err := c.Write([]byte("testkey"), bytes.NewReader(data))
+	require.NoError(t, err)
+
+	r, err := c.Open(*meta)
+	require.NoError(t, err)
+	defer r.Close()
+
+	// random reads within the same chunk only fetch that chunk once
+	buf := make([]byte, 8)
+	for _, offset := range []int64{64 * 10, 64*10 + 8, 64*10 + 50, 64 * 10} {
+		_, err = r.ReadAt(buf, offset)
+		require.NoError(t, err)
+		require.Equal(t, data[offset:offset+8], buf)
+	}
+	require.Equal(t, []int{10}, cp.reads())
+
+	// sequential reads fetch the next chunks ahead of time
+	cp.reset()
+	_, err = r.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	buf = make([]byte, 64)
+	for i := 0; i < 2; i++ {
+		_, err = io.ReadFull(r, buf)
+		require.NoError(t, err)
+		require.Equal(t, data[i*64:(i+1)*64], buf)
+	}
+	expected := []int{0, 1, 2, 3}
+	for i := 0; i < 100 && len(cp.reads()) < len(expected); i++ {
+		time.Sleep(time.Millisecond)
+	}
+	require.ElementsMatch(t, expected, cp.reads())
+
+	// reading all data only fetches each chunk once,
+	// except for the chunks evicted from the cache
+	cp.reset()
+	_, err = r.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	output, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, output)
+	reads := cp.reads()
+	require.Len(t, reads, len(meta.Chunks)-4)
+	for _, index := range reads {
+		require.True(t, index >= 4)
+	}
+}
+
+// countingPipeline is a pipeline which keeps track of the chunks it reads,
+// identified by their index within the chunks given to it so far
+type countingPipeline struct {
+	pipeline.Pipeline
+	mux    sync.Mutex
+	chunks map[string]int
+	read   []int
+}
+
+func (cp *countingPipeline) ReadContext(ctx context.Context, chunks []metatypes.Chunk, w io.Writer) error {
+	cp.mux.Lock()
+	for _, chunk := range chunks {
+		cp.read = append(cp.read, cp.index(chunk))
+	}
+	cp.mux.Unlock()
+	return cp.Pipeline.ReadContext(ctx, chunks, w)
+}
+
+func (cp *countingPipeline) WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error) {
+	chunks, err := cp.Pipeline.WriteContext(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	cp.mux.Lock()
+	for _, chunk := range chunks {
+		cp.index(chunk)
+	}
+	cp.mux.Unlock()
+	return chunks, nil
+}
+
+func (cp *countingPipeline) index(chunk metatypes.Chunk) int {
+	if cp.chunks == nil {
+		cp.chunks = make(map[string]int)
+	}
+	key := string(chunk.Hash)
+	index, ok := cp.chunks[key]
+	if !ok {
+		index = len(cp.chunks)
+		cp.chunks[key] = index
+	}
+	return index
+}
+
+func (cp *countingPipeline) reads() []int {
+	cp.mux.Lock()
+	defer cp.mux.Unlock()
+	return append([]int(nil), cp.read...)
+}
+
+func (cp *countingPipeline) reset() {
+	cp.mux.Lock()
+	cp.read = nil
+	cp.mux.Unlock()
+}
@@ -0,0 +1,627 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package scrub provides a scrubber,
+// which checks the data of all keys stored in a 0-stor namespace,
+// and repairs the data which is still valid, but no longer optimal.
+//
+// The scrubber walks over all keys of the namespace in passes,
+// rate limited such that it can run continuously in the background.
+// The moment and result of the last scrub of each key are recorded in the metastor,
+// such that keys scrubbed recently aren't checked again by the next pass,
+// even when the scrubber got restarted in the meantime.
+package scrub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+// DefaultConcurrency is the amount of keys scrubbed in parallel,
+// in case no concurrency is defined in the Config.
+const DefaultConcurrency = 4
+
+// DefaultInterval is the interval used,
+// in case no interval is defined in the Config.
+const DefaultInterval = 7 * 24 * time.Hour
+
+// DefaultPause is the pause used between two passes,
+// in case no pause is defined in the Config.
+const DefaultPause = time.Hour
+
+// DefaultMaxProblems is the amount of problems listed in a report,
+// in case no maximum is defined in the Config.
+const DefaultMaxProblems = 1000
+
+var (
+	// ErrNilContext is returned in case no context is given.
+	ErrNilContext = errors.New("scrub: nil context")
+	// ErrNoClient is returned in case no client is given.
+	ErrNoClient = errors.New("scrub: no client given")
+	// ErrNoMetastorClient is returned in case no metastor client is given.
+	ErrNoMetastorClient = errors.New("scrub: no metastor client given")
+)
+
+// Config defines the configuration of a scrubber.
+type Config struct {
+	// Deep can be enabled in order to check all objects of each key,
+	// which is required to detect (and thus repair) data which is valid but not optimal.
+	// Otherwise only the minimum amount of objects required to read the data is checked,
+	// which is faster, but only detects data which can no longer be read.
+	Deep bool `yaml:"deep" json:"deep"`
+
+	// Interval defines how long the result of a scrub remains valid,
+	// keys which were scrubbed more recently, and not written since,
+	// are skipped by a pass.
+	// DefaultInterval is used in case the interval is zero,
+	// use a negative interval to scrub all keys in every pass.
+	Interval time.Duration `yaml:"interval" json:"interval"`
+
+	// Concurrency defines the amount of keys scrubbed in parallel.
+	// DefaultConcurrency is used in case the concurrency is zero or negative.
+	Concurrency int `yaml:"concurrency" json:"concurrency"`
+
+	// KeysPerSecond limits the amount of keys scrubbed per second,
+	// no limit is applied in case it is zero or negative.
+	KeysPerSecond float64 `yaml:"keys_per_second" json:"keys_per_second"`
+
+	// BytesPerSecond limits the amount of data deeply checked or repaired per second,
+	// computed using the size of the data of each key,
+	// no limit is applied in case it is zero or negative.
+	BytesPerSecond int `yaml:"bytes_per_second" json:"bytes_per_second"`
+
+	// Pause defines the time to wait in between two passes,
+	// when running the scrubber continuously.
+	// DefaultPause is used in case the pause is zero,
+	// use a negative pause to start the next pass immediately.
+	Pause time.Duration `yaml:"pause" json:"pause"`
+
+	// ProgressInterval defines the interval at which the progress
+	// of a pass is logged, no progress is logged in case it is zero or negative.
+	ProgressInterval time.Duration `yaml:"progress_interval" json:"progress_interval"`
+
+	// DryRun can be enabled in order to check all keys,
+	// without repairing any data, or recording the result of the scrub.
+	DryRun bool `yaml:"dry_run" json:"dry_run"`
+
+	// MaxProblems limits the amount of problems listed in the report of a pass,
+	// further problems are only counted.
+	// DefaultMaxProblems is used in case the maximum is zero,
+	// use a negative maximum to list all problems.
+	MaxProblems int `yaml:"max_problems" json:"max_problems"`
+}
+
+// Result defines the result of scrubbing a single key.
+type Result uint8
+
+const (
+	// ResultOptimal indicates that the data is optimal,
+	// and no further action is needed.
+	ResultOptimal Result = iota
+	// ResultValid indicates that the data can be read,
+	// it is unknown whether it is optimal, as it was checked in fast mode.
+	ResultValid
+	// ResultRepaired indicates that the data was valid, but not optimal,
+	// and got repaired.
+	ResultRepaired
+	// ResultDegraded indicates that the data is valid, but not optimal,
+	// and wasn't repaired, as the scrubber is running a dry run.
+	ResultDegraded
+	// ResultInvalid indicates that the data is invalid,
+	// and can no longer be repaired.
+	ResultInvalid
+	// ResultFailed indicates that the data couldn't be checked or repaired,
+	// due to an error.
+	ResultFailed
+)
+
+// String implements Stringer.String
+func (result Result) String() string {
+	switch result {
+	case ResultOptimal:
+		return "optimal"
+	case ResultValid:
+		return "valid"
+	case ResultRepaired:
+		return "repaired"
+	case ResultDegraded:
+		return "degraded"
+	case ResultInvalid:
+		return "invalid"
+	case ResultFailed:
+		return "failed"
+	default:
+		return ""
+	}
+}
+
+// Report is the (intermediate) result of a single scrub pass.
+type Report struct {
+	// Pass is the number of the pass, starting at 1.
+	Pass int
+	// DryRun is true in case no data was repaired, and no results were recorded.
+	DryRun bool
+	// Deep is true in case the data of all objects was checked.
+	Deep bool
+	// Started is the moment the pass started.
+	Started time.Time
+	// Finished is the moment the pass finished,
+	// zero in case the pass is still running.
+	Finished time.Time
+	// Aborted is true in case the pass was cancelled before all keys were handled,
+	// the keys which weren't handled yet are neither counted nor recorded.
+	Aborted bool
+	// Keys is the amount of keys found in the namespace at the start of the pass.
+	Keys int
+	// Skipped is the amount of keys skipped,
+	// as they were scrubbed recently, or got deleted during the pass.
+	Skipped int
+	// Optimal, Valid, Repaired, Degraded, Invalid and Failed
+	// are the amount of keys scrubbed, per Result.
+	Optimal, Valid, Repaired, Degraded, Invalid, Failed int
+	// Problems contains the keys of which the data is degraded or invalid,
+	// or which couldn't be scrubbed due to an error,
+	// limited to the maximum amount of problems defined in the Config.
+	Problems []Problem
+	// OmittedProblems is the amount of problems not listed in Problems,
+	// as the maximum amount of problems was reached.
+	OmittedProblems int
+	// Pruned is the amount of recorded results deleted at the end of the pass,
+	// as the keys they belong to no longer exist.
+	Pruned int
+}
+
+// Problem defines a key of which the data wasn't optimal after being scrubbed.
+type Problem struct {
+	Key    []byte
+	Result Result
+	// Error is set in case the result is ResultFailed.
+	Error error
+}
+
+// Done returns the amount of keys handled so far,
+// including the keys which were skipped.
+func (report *Report) Done() int {
+	return report.Skipped + report.Optimal + report.Valid +
+		report.Repaired + report.Degraded + report.Invalid + report.Failed
+}
+
+// String implements Stringer.String
+func (report *Report) String() string {
+	return fmt.Sprintf(
+		"pass %d: %d/%d keys (%d skipped, %d optimal, %d valid, %d repaired, %d degraded, %d invalid, %d failed)",
+		report.Pass, report.Done(), report.Keys, report.Skipped, report.Optimal, report.Valid,
+		report.Repaired, report.Degraded, report.Invalid, report.Failed)
+}
+
+func (report *Report) add(key []byte, result Result, err error, maxProblems int) {
+	switch result {
+	case ResultOptimal:
+		report.Optimal++
+		return
+	case ResultValid:
+		report.Valid++
+		return
+	case ResultRepaired:
+		report.Repaired++
+		return
+	case ResultDegraded:
+		report.Degraded++
+	case ResultInvalid:
+		report.Invalid++
+	case ResultFailed:
+		report.Failed++
+	}
+	if maxProblems >= 0 && len(report.Problems) >= maxProblems {
+		report.OmittedProblems++
+		return
+	}
+	report.Problems = append(report.Problems, Problem{Key: key, Result: result, Error: err})
+}
+
+// Scrubber checks the data of all keys stored in a namespace,
+// and repairs the data which is valid, but no longer optimal.
+// The latter can only be detected when checking deeply.
+type Scrubber struct {
+	client     *client.Client
+	metaClient *metastor.Client
+	cfg        Config
+
+	keyLimiter  *rate.Limiter
+	byteLimiter *rate.Limiter
+
+	mux    sync.Mutex
+	pass   int
+	report *Report
+}
+
+// New creates a scrubber, which scrubs the keys stored in the namespace of the given metastor client,
+// using the given client, which has to be created using that same metastor client.
+func New(c *client.Client, metaClient *metastor.Client, cfg Config) (*Scrubber, error) {
+	if c == nil {
+		return nil, ErrNoClient
+	}
+	if metaClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultConcurrency
+	}
+	if cfg.Pause == 0 {
+		cfg.Pause = DefaultPause
+	}
+	if cfg.MaxProblems == 0 {
+		cfg.MaxProblems = DefaultMaxProblems
+	}
+
+	s := &Scrubber{
+		client:     c,
+		metaClient: metaClient,
+		cfg:        cfg,
+	}
+	if cfg.KeysPerSecond > 0 {
+		s.keyLimiter = rate.NewLimiter(rate.Limit(cfg.KeysPerSecond), 1)
+	}
+	if cfg.BytesPerSecond > 0 {
+		s.byteLimiter = rate.NewLimiter(rate.Limit(cfg.BytesPerSecond), cfg.BytesPerSecond)
+	}
+	return s, nil
+}
+
+// Progress returns a copy of the report of the pass which is currently running,
+// or of the last finished pass in case no pass is running.
+// Nil is returned in case no pass has been started yet.
+func (s *Scrubber) Progress() *Report {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.report == nil {
+		return nil
+	}
+	report := *s.report
+	report.Problems = append([]Problem(nil), s.report.Problems...)
+	return &report
+}
+
+// Run scrubs all keys continuously, pausing in between passes,
+// until the given context is cancelled.
+// The report of each pass is logged, as errors which abort a pass are,
+// in which case the next pass is started after the pause as usual.
+func (s *Scrubber) Run(ctx context.Context) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	for {
+		report, err := s.Scrub(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			log.Errorf("scrub: pass failed: %v", err)
+		} else {
+			log.Infof("scrub: %s, finished in %v", report, report.Finished.Sub(report.Started))
+		}
+		if s.cfg.Pause < 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.cfg.Pause):
+		}
+	}
+}
+
+// Scrub runs a single pass over all keys, returning the report of that pass.
+//
+// The keys are collected prior to scrubbing them,
+// hence keys written during the pass are only scrubbed by the next pass.
+// Each key is checked, and repaired in case its data is found to be valid but not optimal,
+// after which the result is recorded in the metastor, unless this is a dry run.
+//
+// An error is only returned in case the keys couldn't be listed,
+// or the context got cancelled, in which case the returned report is incomplete,
+// and marked as aborted when cancelled.
+// Errors which occur while scrubbing a key are part of the report,
+// and do not abort the pass.
+func (s *Scrubber) Scrub(ctx context.Context) (*Report, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+
+	report := &Report{
+		DryRun:  s.cfg.DryRun,
+		Deep:    s.cfg.Deep,
+		Started: time.Now(),
+	}
+	s.mux.Lock()
+	s.pass++
+	report.Pass = s.pass
+	s.report = report
+	s.mux.Unlock()
+
+	// collect the keys first, as the metadata database
+	// might not support nested operations from within the list callback
+	var keys [][]byte
+	err := s.metaClient.ListKeys(func(key []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return s.finish(ctx, report, fmt.Errorf("failed to list metadata keys: %v", err))
+	}
+	s.mux.Lock()
+	report.Keys = len(keys)
+	s.mux.Unlock()
+
+	if s.cfg.ProgressInterval > 0 {
+		done := make(chan struct{})
+		defer close(done)
+		go s.logProgress(done)
+	}
+
+	ch := make(chan []byte)
+	var wg sync.WaitGroup
+	wg.Add(s.cfg.Concurrency)
+	for i := 0; i < s.cfg.Concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for key := range ch {
+				skipped, result, err := s.scrubKey(ctx, key)
+				if err != nil && ctx.Err() != nil {
+					// the key wasn't scrubbed, as the pass got aborted
+					continue
+				}
+				s.mux.Lock()
+				if skipped {
+					report.Skipped++
+				} else {
+					report.add(key, result, err, s.cfg.MaxProblems)
+				}
+				s.mux.Unlock()
+			}
+		}()
+	}
+feed:
+	for _, key := range keys {
+		if s.keyLimiter != nil && s.keyLimiter.Wait(ctx) != nil {
+			break
+		}
+		select {
+		case ch <- key:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(ch)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return s.finish(ctx, report, err)
+	}
+
+	if !s.cfg.DryRun {
+		pruned, err := s.prune(ctx)
+		s.mux.Lock()
+		report.Pruned = pruned
+		s.mux.Unlock()
+		if err != nil {
+			return s.finish(ctx, report, err)
+		}
+	}
+	return s.finish(ctx, report, nil)
+}
+
+// finish marks the given report as finished, and as aborted when the context got cancelled,
+// returning a copy of it, as it is owned by the scrubber.
+func (s *Scrubber) finish(ctx context.Context, report *Report, err error) (*Report, error) {
+	s.mux.Lock()
+	report.Finished = time.Now()
+	report.Aborted = ctx.Err() != nil
+	s.mux.Unlock()
+	return s.Progress(), err
+}
+
+// logProgress logs the progress of the current pass,
+// at the configured interval, until the given channel is closed.
+func (s *Scrubber) logProgress(done <-chan struct{}) {
+	ticker := time.NewTicker(s.cfg.ProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			log.Infof("scrub: %s", s.Progress())
+		}
+	}
+}
+
+// scrubKey checks the data of the given key,
+// and repairs it in case it is found to be valid but not optimal,
+// recording the result unless this is a dry run.
+// Keys scrubbed recently, as well as keys which no longer exist, are skipped.
+func (s *Scrubber) scrubKey(ctx context.Context, key []byte) (skipped bool, result Result, err error) {
+	md, err := s.metaClient.GetMetadata(key)
+	if err != nil {
+		if err == metastor.ErrNotFound {
+			// metadata was deleted in the meantime
+			return true, 0, nil
+		}
+		return false, ResultFailed, s.record(key, ResultFailed, fmt.Errorf("failed to get metadata: %v", err))
+	}
+	if s.recentlyScrubbed(md) {
+		return true, 0, nil
+	}
+
+	if s.cfg.Deep {
+		err = s.waitBytes(ctx, md.Size)
+		if err != nil {
+			return false, ResultFailed, err
+		}
+	}
+	status, err := s.client.CheckContext(ctx, *md, !s.cfg.Deep)
+	if err != nil {
+		if ctx.Err() != nil {
+			// cancelled, the data wasn't checked
+			return false, ResultFailed, err
+		}
+		return false, ResultFailed, s.record(key, ResultFailed, fmt.Errorf("failed to check data: %v", err))
+	}
+	switch status {
+	case storage.CheckStatusOptimal:
+		return false, ResultOptimal, s.record(key, ResultOptimal, nil)
+	case storage.CheckStatusInvalid:
+		log.Errorf("scrub: data of key %q is invalid and can no longer be repaired", key)
+		return false, ResultInvalid, s.record(key, ResultInvalid, nil)
+	}
+	if !s.cfg.Deep {
+		// a fast check stops as soon as the data is known to be valid
+		return false, ResultValid, s.record(key, ResultValid, nil)
+	}
+
+	if s.cfg.DryRun {
+		return false, ResultDegraded, nil
+	}
+	err = s.waitBytes(ctx, md.Size)
+	if err != nil {
+		return false, ResultFailed, err
+	}
+	_, err = s.client.RepairContext(ctx, *md)
+	if err != nil {
+		if ctx.Err() != nil {
+			// cancelled, the data might not be repaired
+			return false, ResultFailed, err
+		}
+		log.Errorf("scrub: failed to repair data of key %q: %v", key, err)
+		return false, ResultFailed, s.record(key, ResultFailed, fmt.Errorf("failed to repair data: %v", err))
+	}
+	log.Debugf("scrub: repaired data of key %q", key)
+	return false, ResultRepaired, s.record(key, ResultRepaired, nil)
+}
+
+// recentlyScrubbed returns true in case the given metadata was scrubbed within the interval,
+// and wasn't written since.
+func (s *Scrubber) recentlyScrubbed(md *metatypes.Metadata) bool {
+	if s.cfg.Interval < 0 {
+		return false
+	}
+	record, err := s.metaClient.GetScrubRecord(md.Key)
+	if err != nil {
+		if err != metastor.ErrNotFound {
+			log.Errorf("scrub: failed to get scrub record of key %q: %v", md.Key, err)
+		}
+		return false
+	}
+	return record.Epoch >= md.LastWriteEpoch &&
+		record.Epoch > time.Now().Add(-s.cfg.Interval).UnixNano()
+}
+
+// record stores the result of scrubbing the given key, unless this is a dry run,
+// returning the given error as is.
+// Errors which occur while recording are only logged,
+// as failing to record only means the key is scrubbed again by the next pass.
+func (s *Scrubber) record(key []byte, result Result, resultErr error) error {
+	if s.cfg.DryRun {
+		return resultErr
+	}
+	record := metastor.ScrubRecord{
+		Epoch:  client.EpochNow(),
+		Result: result.String(),
+	}
+	if resultErr != nil {
+		record.Error = resultErr.Error()
+	}
+	err := s.metaClient.SetScrubRecord(key, record)
+	if err != nil {
+		log.Errorf("scrub: failed to record scrub result of key %q: %v", key, err)
+	}
+	return resultErr
+}
+
+// waitBytes waits until the given amount of bytes can be processed,
+// according to the configured byte rate limit.
+func (s *Scrubber) waitBytes(ctx context.Context, n int64) error {
+	if s.byteLimiter == nil {
+		return nil
+	}
+	// the limiter can't wait for more bytes than its burst at once
+	burst := int64(s.byteLimiter.Burst())
+	for n > 0 {
+		size := n
+		if size > burst {
+			size = burst
+		}
+		err := s.byteLimiter.WaitN(ctx, int(size))
+		if err != nil {
+			return err
+		}
+		n -= size
+	}
+	return nil
+}
+
+// prune deletes the recorded results of keys which no longer exist,
+// returning the amount of deleted records.
+func (s *Scrubber) prune(ctx context.Context) (int, error) {
+	var keys [][]byte
+	err := s.metaClient.ListScrubRecords(func(key []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list scrub records: %v", err)
+	}
+
+	var pruned int
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return pruned, err
+		}
+		_, err := s.metaClient.GetMetadata(key)
+		if err != metastor.ErrNotFound {
+			if err != nil {
+				return pruned, fmt.Errorf("failed to get metadata for key %q: %v", key, err)
+			}
+			continue
+		}
+		err = s.metaClient.DeleteScrubRecord(key)
+		if err != nil {
+			return pruned, fmt.Errorf("failed to delete scrub record of key %q: %v", key, err)
+		}
+		pruned++
+	}
+	return pruned, nil
+}
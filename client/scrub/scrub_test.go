@@ -0,0 +1,338 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scrub
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client"
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
+	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
+	zdbtest "github.com/threefoldtech/0-stor/client/datastor/zerodb/test"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/db/test"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrub(t *testing.T) {
+	require := require.New(t)
+
+	c, metaClient, cluster, cleanup := newTestClient(t, 4)
+	defer cleanup()
+
+	// an optimal key, a key missing one object of each chunk,
+	// and a key missing too many objects to be repaired
+	data := bytes.Repeat([]byte("0-stor"), 100)
+	write := func(key string, deleted int) *metatypes.Metadata {
+		md, err := c.Write([]byte(key), bytes.NewReader(data))
+		require.NoError(err)
+		for _, chunk := range md.Chunks {
+			for _, object := range chunk.Objects[:deleted] {
+				shard, err := cluster.GetShard(object.ShardID)
+				require.NoError(err)
+				require.NoError(shard.DeleteObject(object.Key))
+			}
+		}
+		return md
+	}
+	write("optimal", 0)
+	valid := write("valid", 1)
+	write("invalid", 2)
+
+	// a fast check only detects data which can no longer be read
+	scrubber, err := New(c, metaClient, Config{DryRun: true})
+	require.NoError(err)
+	require.Nil(scrubber.Progress())
+	report, err := scrubber.Scrub(context.Background())
+	require.NoError(err)
+	require.True(report.DryRun)
+	require.False(report.Deep)
+	require.Equal(1, report.Pass)
+	require.Equal(3, report.Keys)
+	require.Equal(3, report.Done())
+	require.Equal(2, report.Valid)
+	require.Equal(1, report.Invalid)
+	require.Equal([]Problem{{Key: []byte("invalid"), Result: ResultInvalid}}, report.Problems)
+
+	// nothing is repaired or recorded during a dry run
+	scrubber, err = New(c, metaClient, Config{Deep: true, DryRun: true})
+	require.NoError(err)
+	report, err = scrubber.Scrub(context.Background())
+	require.NoError(err)
+	require.True(report.Deep)
+	require.Equal(1, report.Optimal)
+	require.Equal(1, report.Degraded)
+	require.Equal(1, report.Invalid)
+	require.Len(report.Problems, 2)
+	require.False(report.Finished.IsZero())
+	require.Equal(report, scrubber.Progress())
+	_, err = metaClient.GetScrubRecord([]byte("optimal"))
+	require.Equal(metastor.ErrNotFound, err)
+
+	// data which is valid but not optimal is repaired
+	scrubber, err = New(c, metaClient, Config{Deep: true, BytesPerSecond: 1024 * 1024})
+	require.NoError(err)
+	report, err = scrubber.Scrub(context.Background())
+	require.NoError(err)
+	require.Equal(1, report.Optimal)
+	require.Equal(1, report.Repaired)
+	require.Equal(1, report.Invalid)
+	require.Equal([]Problem{{Key: []byte("invalid"), Result: ResultInvalid}}, report.Problems)
+	md, err := metaClient.GetMetadata([]byte("valid"))
+	require.NoError(err)
+	require.NotEqual(valid.Chunks, md.Chunks)
+	status, err := c.Check(*md, false)
+	require.NoError(err)
+	require.Equal(storage.CheckStatusOptimal, status)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(c.Read(*md, buf))
+	require.Equal(data, buf.Bytes())
+
+	// the results are recorded
+	for key, result := range map[string]Result{
+		"optimal": ResultOptimal,
+		"valid":   ResultRepaired,
+		"invalid": ResultInvalid,
+	} {
+		record, err := metaClient.GetScrubRecord([]byte(key))
+		require.NoError(err)
+		require.Equal(result.String(), record.Result)
+		require.Empty(record.Error)
+		require.NotZero(record.Epoch)
+	}
+
+	// keys scrubbed recently are skipped,
+	// unless they were written since
+	_, err = c.Write([]byte("optimal"), bytes.NewReader(data))
+	require.NoError(err)
+	report, err = scrubber.Scrub(context.Background())
+	require.NoError(err)
+	require.Equal(2, report.Pass)
+	require.Equal(2, report.Skipped)
+	require.Equal(1, report.Optimal)
+
+	// the records of deleted keys are pruned
+	md, err = metaClient.GetMetadata([]byte("valid"))
+	require.NoError(err)
+	require.NoError(c.Delete(*md))
+	report, err = scrubber.Scrub(context.Background())
+	require.NoError(err)
+	require.Equal(2, report.Skipped)
+	require.Equal(1, report.Pruned)
+	_, err = metaClient.GetScrubRecord([]byte("valid"))
+	require.Equal(metastor.ErrNotFound, err)
+
+	// all keys are scrubbed when using a negative interval
+	scrubber, err = New(c, metaClient, Config{Interval: -1, KeysPerSecond: 100})
+	require.NoError(err)
+	report, err = scrubber.Scrub(context.Background())
+	require.NoError(err)
+	require.Equal(1, report.Valid)
+	require.Equal(1, report.Invalid)
+	require.Zero(report.Skipped)
+	record, err := metaClient.GetScrubRecord([]byte("optimal"))
+	require.NoError(err)
+	require.Equal(ResultValid.String(), record.Result)
+}
+
+func TestScrubRun(t *testing.T) {
+	require := require.New(t)
+
+	c, metaClient, _, cleanup := newTestClient(t, 4)
+	defer cleanup()
+
+	_, err := c.Write([]byte("foo"), bytes.NewReader([]byte("bar")))
+	require.NoError(err)
+
+	scrubber, err := New(c, metaClient, Config{Deep: true, Interval: -1, Pause: -1})
+	require.NoError(err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- scrubber.Run(ctx)
+	}()
+
+	// passes are run continuously until cancelled
+	for {
+		report := scrubber.Progress()
+		if report != nil && report.Pass > 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	require.NoError(<-done)
+	report := scrubber.Progress()
+	require.False(report.Finished.IsZero())
+}
+
+func TestScrubAborted(t *testing.T) {
+	require := require.New(t)
+
+	c, metaClient, _, cleanup := newTestClient(t, 4)
+	defer cleanup()
+
+	const keys = 5
+	for i := 0; i < keys; i++ {
+		_, err := c.Write([]byte(fmt.Sprintf("key%d", i)), bytes.NewReader([]byte("bar")))
+		require.NoError(err)
+	}
+
+	scrubber, err := New(c, metaClient, Config{Deep: true, Concurrency: 1, KeysPerSecond: 10})
+	require.NoError(err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		_, err := scrubber.Scrub(ctx)
+		done <- err
+	}()
+	for {
+		report := scrubber.Progress()
+		if report != nil && report.Done() > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	require.Equal(context.Canceled, <-done)
+
+	// the keys which weren't scrubbed aren't reported or recorded as failed
+	report := scrubber.Progress()
+	require.True(report.Aborted)
+	require.Equal(keys, report.Keys)
+	require.True(report.Done() < keys)
+	require.Equal(report.Done(), report.Optimal)
+	require.Equal(0, report.Failed)
+	require.Empty(report.Problems)
+	var records int
+	for i := 0; i < keys; i++ {
+		record, err := metaClient.GetScrubRecord([]byte(fmt.Sprintf("key%d", i)))
+		if err == metastor.ErrNotFound {
+			continue
+		}
+		require.NoError(err)
+		require.Equal(ResultOptimal.String(), record.Result)
+		records++
+	}
+	require.Equal(report.Done(), records)
+}
+
+func TestScrubMaxProblems(t *testing.T) {
+	require := require.New(t)
+
+	c, metaClient, cluster, cleanup := newTestClient(t, 4)
+	defer cleanup()
+
+	for i := 0; i < 3; i++ {
+		md, err := c.Write([]byte(fmt.Sprintf("invalid%d", i)), bytes.NewReader([]byte("bar")))
+		require.NoError(err)
+		for _, chunk := range md.Chunks {
+			for _, object := range chunk.Objects[:2] {
+				shard, err := cluster.GetShard(object.ShardID)
+				require.NoError(err)
+				require.NoError(shard.DeleteObject(object.Key))
+			}
+		}
+	}
+
+	// only the maximum amount of problems is listed, the others are counted
+	scrubber, err := New(c, metaClient, Config{DryRun: true, MaxProblems: 1})
+	require.NoError(err)
+	report, err := scrubber.Scrub(context.Background())
+	require.NoError(err)
+	require.False(report.Aborted)
+	require.Equal(3, report.Invalid)
+	require.Len(report.Problems, 1)
+	require.Equal(2, report.OmittedProblems)
+
+	scrubber, err = New(c, metaClient, Config{DryRun: true, MaxProblems: -1})
+	require.NoError(err)
+	report, err = scrubber.Scrub(context.Background())
+	require.NoError(err)
+	require.Len(report.Problems, 3)
+	require.Equal(0, report.OmittedProblems)
+}
+
+func TestScrubErrors(t *testing.T) {
+	require := require.New(t)
+
+	c, metaClient, _, cleanup := newTestClient(t, 1)
+	defer cleanup()
+
+	_, err := New(nil, metaClient, Config{})
+	require.Equal(ErrNoClient, err)
+	_, err = New(c, nil, Config{})
+	require.Equal(ErrNoMetastorClient, err)
+
+	scrubber, err := New(c, metaClient, Config{})
+	require.NoError(err)
+	_, err = scrubber.Scrub(nil)
+	require.Equal(ErrNilContext, err)
+	require.Equal(ErrNilContext, scrubber.Run(nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report, err := scrubber.Scrub(ctx)
+	require.Equal(context.Canceled, err)
+	require.True(report.Aborted)
+}
+
+func newTestClient(t *testing.T, n int) (*client.Client, *metastor.Client, *zerodb.Cluster, func()) {
+	require := require.New(t)
+
+	var (
+		shards   []datastor.ShardConfig
+		cleanups []func()
+	)
+	for i := 0; i < n; i++ {
+		_, addr, cleanup, err := zdbtest.NewInMem0DBServer("ns")
+		require.NoError(err)
+		cleanups = append(cleanups, cleanup)
+		shards = append(shards, datastor.ShardConfig{Address: addr})
+	}
+	cluster, err := zerodb.NewCluster(shards, "", "ns", nil, datastor.SpreadingTypeRandom)
+	require.NoError(err)
+
+	metaClient, err := metastor.NewClient("ns", test.New(), "")
+	require.NoError(err)
+
+	cfg := pipeline.Config{BlockSize: 64}
+	if n > 1 {
+		cfg.Distribution = pipeline.ObjectDistributionConfig{
+			DataShardCount:   2,
+			ParityShardCount: 1,
+		}
+	}
+	dataPipeline, err := pipeline.NewPipeline(cfg, cluster, -1)
+	require.NoError(err)
+
+	return client.NewClient(metaClient, dataPipeline), metaClient, cluster, func() {
+		metaClient.Close()
+		cluster.Close()
+		for _, cleanup := range cleanups {
+			cleanup()
+		}
+	}
+}
@@ -0,0 +1,433 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrInvalidUploadPart is returned when uploading a part with a negative part number.
+	ErrInvalidUploadPart = errors.New("Client: invalid (negative) upload part number")
+	// ErrEmptyUpload is returned when completing an upload for which no parts were uploaded.
+	ErrEmptyUpload = errors.New("Client: upload has no parts")
+)
+
+// UploadedPart describes a part which is stored as part of an upload session.
+type UploadedPart struct {
+	// Number of the part, defining its position within the completed data.
+	Number int64
+	// Size of the data of the part, in bytes.
+	Size int64
+	// LastWriteEpoch defines the time the part was uploaded, in nano seconds.
+	LastWriteEpoch int64
+}
+
+// CreateUpload starts a new (multipart) upload session for the given key,
+// returning the ID of that session.
+//
+// The data of the key can be uploaded in parts, using UploadPart,
+// where each part is stored as soon as it is uploaded.
+// As the session is persisted in the metastor, an interrupted upload can be resumed,
+// even by another client, uploading only the parts which aren't stored yet (see ListUploadParts).
+// Once all parts are uploaded, CompleteUpload stores the data as the given key,
+// while AbortUpload discards the session and all its data.
+//
+// Sessions which are neither completed nor aborted expire,
+// and are cleaned up by the garbage collector.
+func (c *Client) CreateUpload(key []byte, opts CreateOptions) (string, error) {
+	if len(key) == 0 {
+		return "", ErrNilKey
+	}
+	if c.metastorClient == nil {
+		return "", ErrNoMetastorClient
+	}
+
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+	uploadID := hex.EncodeToString(id)
+
+	now := EpochNow()
+	err = c.metastorClient.SetUpload(uploadID, metatypes.Metadata{
+		Key:            key,
+		CreationEpoch:  now,
+		LastWriteEpoch: now,
+		ChunkSize:      int32(c.dataPipeline.ChunkSize()),
+		UserDefined:    opts.UserDefined,
+	})
+	if err != nil {
+		return "", err
+	}
+	return uploadID, nil
+}
+
+// UploadPart stores the data read from the given reader
+// as the given part of the upload session with the given ID.
+// See UploadPartContext for more information.
+func (c *Client) UploadPart(uploadID string, part int64, r io.Reader) (*UploadedPart, error) {
+	return c.UploadPartContext(context.Background(), uploadID, part, r)
+}
+
+// UploadPartContext stores the data read from the given reader
+// as the given part of the upload session with the given ID,
+// aborting as soon as the given context is cancelled or its deadline expires.
+//
+// Parts can be uploaded in any order, and are put together in the order of their number.
+// All parts except the last one should have a size which is a multiple
+// of the chunk size of the client, or else the upload can't be completed,
+// unless the client splits data into chunks of variable size.
+// Uploading a part which is already stored replaces that part.
+//
+// metastor.ErrNotFound is returned in case the session doesn't exist.
+func (c *Client) UploadPartContext(ctx context.Context, uploadID string, part int64, r io.Reader) (*UploadedPart, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if part < 0 {
+		return nil, ErrInvalidUploadPart
+	}
+	if r == nil {
+		return nil, errors.New("no reader given to read from")
+	}
+	if c.metastorClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+
+	session, err := c.metastorClient.GetUpload(uploadID)
+	if err != nil {
+		return nil, err
+	}
+	// mark the session as active, such that it doesn't expire
+	now := EpochNow()
+	session.LastWriteEpoch = now
+	err = c.metastorClient.SetUpload(uploadID, *session)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &readCounter{r: r}
+	chunks, err := c.writeChunks(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+	md := metatypes.Metadata{
+		Key:            session.Key,
+		Size:           rc.Size(),
+		CreationEpoch:  now,
+		LastWriteEpoch: EpochNow(),
+		ChunkSize:      int32(c.dataPipeline.ChunkSize()),
+		Chunks:         chunks,
+	}
+	for _, chunk := range chunks {
+		md.StorageSize += chunk.Size
+	}
+
+	previous, err := c.metastorClient.GetUploadPart(uploadID, part)
+	if err != nil && err != metastor.ErrNotFound {
+		c.deleteUnusedChunks(chunks)
+		return nil, err
+	}
+	err = c.metastorClient.SetUploadPart(uploadID, part, md)
+	if err != nil {
+		c.deleteUnusedChunks(chunks)
+		return nil, err
+	}
+	if previous != nil {
+		// the part got replaced, its previous data is no longer needed
+		c.deleteUnusedChunks(previous.Chunks)
+	}
+
+	return &UploadedPart{
+		Number:         part,
+		Size:           md.Size,
+		LastWriteEpoch: md.LastWriteEpoch,
+	}, nil
+}
+
+// ListUploadParts returns all parts stored as part of the upload session with the given ID,
+// sorted by their number.
+//
+// metastor.ErrNotFound is returned in case the session doesn't exist.
+func (c *Client) ListUploadParts(uploadID string) ([]UploadedPart, error) {
+	if c.metastorClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+	_, parts, err := c.getUpload(uploadID)
+	if err != nil {
+		return nil, err
+	}
+	uploaded := make([]UploadedPart, len(parts))
+	for i, part := range parts {
+		uploaded[i] = UploadedPart{
+			Number:         part.number,
+			Size:           part.md.Size,
+			LastWriteEpoch: part.md.LastWriteEpoch,
+		}
+	}
+	return uploaded, nil
+}
+
+// CompleteUpload stores the data of all parts of the upload session with the given ID
+// as the key of that session, returning the (committed) metadata of that key.
+// The session itself is deleted, as it is no longer needed.
+//
+// Just like a regular write, the data previously stored for the key (if any)
+// is handled as defined by the overwrite mode of the client.
+//
+// metastor.ErrNotFound is returned in case the session doesn't exist.
+func (c *Client) CompleteUpload(uploadID string) (*metatypes.Metadata, error) {
+	if c.metastorClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+	session, parts, err := c.getUpload(uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, ErrEmptyUpload
+	}
+
+	now := EpochNow()
+	md := metatypes.Metadata{
+		Key:            session.Key,
+		CreationEpoch:  now,
+		LastWriteEpoch: now,
+		ChunkSize:      session.ChunkSize,
+		UserDefined:    session.UserDefined,
+	}
+	for i, part := range parts {
+		if part.md.ChunkSize != session.ChunkSize {
+			return nil, fmt.Errorf(
+				"part %d of upload %s has chunk size %d, while the upload has chunk size %d",
+				part.number, uploadID, part.md.ChunkSize, session.ChunkSize)
+		}
+		// all data has to be chunked evenly,
+		// such that it can be read using the chunk size of the metadata
+		if i < len(parts)-1 && session.ChunkSize != metatypes.VariableChunkSize &&
+			(session.ChunkSize <= 0 || part.md.Size == 0 || part.md.Size%int64(session.ChunkSize) != 0) {
+			return nil, fmt.Errorf(
+				"part %d of upload %s has size %d, which is not a multiple of the chunk size %d",
+				part.number, uploadID, part.md.Size, session.ChunkSize)
+		}
+		if session.ChunkSize == metatypes.VariableChunkSize {
+			offsetChunks(part.md.Chunks, md.Size)
+		}
+		md.Size += part.md.Size
+		md.StorageSize += part.md.StorageSize
+		md.Chunks = append(md.Chunks, part.md.Chunks...)
+	}
+
+	current, err := c.metastorClient.GetMetadata(md.Key)
+	if err != nil && err != metastor.ErrNotFound {
+		return nil, err
+	}
+	if metastor.UploadCompleted(*session) && current != nil && referencesChunks(*current, md.Chunks) {
+		// the upload was completed already, but its session couldn't be deleted
+		c.deleteCompletedUpload(uploadID)
+		return current, nil
+	}
+
+	// mark the session as completed prior to committing its data,
+	// such that aborting it never frees the chunks of the committed metadata
+	err = c.metastorClient.SetUploadCompleted(uploadID, true)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := c.commitMetadata(md)
+	if err != nil {
+		// the data isn't committed, such that the upload can still be aborted
+		unmarkErr := c.metastorClient.SetUploadCompleted(uploadID, false)
+		if unmarkErr != nil {
+			log.Errorf("failed to unmark upload %s as completed: %v", uploadID, unmarkErr)
+		}
+		return nil, err
+	}
+	if previous != nil {
+		c.handleSupersededMetadata(*previous)
+	}
+	c.deleteCompletedUpload(uploadID)
+	return &md, nil
+}
+
+// AbortUpload discards the upload session with the given ID.
+// See AbortUploadContext for more information.
+func (c *Client) AbortUpload(uploadID string) error {
+	return c.AbortUploadContext(context.Background(), uploadID)
+}
+
+// AbortUploadContext discards the upload session with the given ID,
+// deleting the data of all its parts,
+// aborting as soon as the given context is cancelled or its deadline expires.
+// The session is only deleted in case all its data could be deleted,
+// unless deduplication is enabled, in which case the session is deleted first,
+// as the references to its chunks can only be released once.
+//
+// The data of a session which is (being) completed is in use by the metadata of its key,
+// and is never deleted, aborting such a session only deletes the session itself.
+//
+// metastor.ErrNotFound is returned in case the session doesn't exist.
+func (c *Client) AbortUploadContext(ctx context.Context, uploadID string) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	if c.metastorClient == nil {
+		return ErrNoMetastorClient
+	}
+	session, parts, err := c.getUpload(uploadID)
+	if err != nil {
+		return err
+	}
+	if metastor.UploadCompleted(*session) {
+		// the upload was completed already, its data is in use
+		return c.metastorClient.DeleteUpload(uploadID)
+	}
+
+	var unused []metatypes.Chunk
+	for _, part := range parts {
+		if c.dedup {
+			unused = append(unused, part.md.Chunks...)
+			continue
+		}
+		err = c.dataPipeline.DeleteContext(ctx, part.md.Chunks)
+		if err != nil {
+			return err
+		}
+	}
+	err = c.metastorClient.DeleteUpload(uploadID)
+	if err != nil || !c.dedup {
+		return err
+	}
+	// the parts no longer reference their chunks
+	return c.freeChunks(ctx, unused)
+}
+
+// sessionPart is a part of an upload session, as stored in the metastor
+type sessionPart struct {
+	number int64
+	md     *metatypes.Metadata
+}
+
+// getUpload returns the upload session with the given ID,
+// as well as all its parts, sorted by their number
+func (c *Client) getUpload(uploadID string) (*metatypes.Metadata, []sessionPart, error) {
+	session, err := c.metastorClient.GetUpload(uploadID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// collect the part numbers first,
+	// as the metastor database might not allow nested calls while listing
+	var numbers []int64
+	err = c.metastorClient.ListUploadParts(uploadID, func(part int64) error {
+		numbers = append(numbers, part)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parts := make([]sessionPart, 0, len(numbers))
+	for _, number := range numbers {
+		md, err := c.metastorClient.GetUploadPart(uploadID, number)
+		if err == metastor.ErrNotFound {
+			// deleted while listing
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		parts = append(parts, sessionPart{number: number, md: md})
+	}
+	return session, parts, nil
+}
+
+// deleteCompletedUpload deletes the session of a completed upload.
+//
+// Errors are only logged, as the data of the upload has been committed already,
+// a session that couldn't be deleted expires and is cleaned up by the garbage collector.
+func (c *Client) deleteCompletedUpload(uploadID string) {
+	err := c.metastorClient.DeleteUpload(uploadID)
+	if err != nil {
+		log.Errorf("failed to delete session of completed upload %s: %v", uploadID, err)
+	}
+}
+
+// deleteUnusedChunks deletes the given chunks, which are no longer referenced.
+//
+// Errors are only logged, chunks that couldn't be deleted
+// are left to be collected by the garbage collector.
+func (c *Client) deleteUnusedChunks(chunks []metatypes.Chunk) {
+	if len(chunks) == 0 {
+		return
+	}
+	err := c.freeChunks(context.Background(), chunks)
+	if err != nil {
+		log.Errorf("failed to delete unused chunks: %v", err)
+	}
+}
+
+// referencesChunks returns true in case the given metadata references exactly the given chunks,
+// in the same order, even though some of them might have been repaired since
+func referencesChunks(md metatypes.Metadata, chunks []metatypes.Chunk) bool {
+	if len(md.Chunks) != len(chunks) {
+		return false
+	}
+	for index, chunk := range chunks {
+		if !sameChunk(md.Chunks[index], chunk) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameChunk returns true in case both chunks share any of their objects,
+// meaning that they define the same stored data
+func sameChunk(a, b metatypes.Chunk) bool {
+	return referencesAnyChunk(metatypes.Metadata{Chunks: []metatypes.Chunk{a}}, []metatypes.Chunk{b})
+}
+
+// referencesAnyChunk returns true in case the given metadata
+// references any of the objects of the given chunks
+func referencesAnyChunk(md metatypes.Metadata, chunks []metatypes.Chunk) bool {
+	objects := make(map[string]struct{})
+	for _, chunk := range md.Chunks {
+		for _, object := range chunk.Objects {
+			objects[object.ShardID+"/"+string(object.Key)] = struct{}{}
+		}
+	}
+	for _, chunk := range chunks {
+		for _, object := range chunk.Objects {
+			if _, ok := objects[object.ShardID+"/"+string(object.Key)]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientUpload(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(t, err)
+	defer c.Close()
+
+	// 3 parts, of which the last one is smaller
+	data := make([]byte, 64*10*2+42)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	parts := [][]byte{data[:64*10], data[64*10 : 64*20], data[64*20:]}
+
+	t.Run("complete", func(t *testing.T) {
+		key := []byte("complete")
+		uploadID, err := c.CreateUpload(key, CreateOptions{
+			UserDefined: map[string]string{"foo": "bar"},
+		})
+		require.NoError(t, err)
+
+		// upload the parts out of order, and replace one of them
+		for _, i := range []int{2, 0} {
+			part, err := c.UploadPart(uploadID, int64(i), bytes.NewReader(parts[i]))
+			require.NoError(t, err)
+			require.Equal(t, int64(i), part.Number)
+			require.Equal(t, int64(len(parts[i])), part.Size)
+		}
+		before := objectCount(t, cluster)
+		_, err = c.UploadPart(uploadID, 2, bytes.NewReader(parts[2]))
+		require.NoError(t, err)
+		require.Equal(t, before, objectCount(t, cluster))
+
+		// resume the upload, only uploading the missing part
+		uploaded, err := c.ListUploadParts(uploadID)
+		require.NoError(t, err)
+		require.Len(t, uploaded, 2)
+		require.Equal(t, int64(0), uploaded[0].Number)
+		require.Equal(t, int64(2), uploaded[1].Number)
+		_, err = c.UploadPart(uploadID, 1, bytes.NewReader(parts[1]))
+		require.NoError(t, err)
+
+		// nothing is stored as the key, until the upload is completed
+		_, err = c.metastorClient.GetMetadata(key)
+		require.Equal(t, metastor.ErrNotFound, err)
+
+		md, err := c.CompleteUpload(uploadID)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), md.Size)
+		require.Equal(t, "bar", md.UserDefined["foo"])
+		stored, err := c.metastorClient.GetMetadata(key)
+		require.NoError(t, err)
+		require.Equal(t, md.Chunks, stored.Chunks)
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, c.Read(*stored, buf))
+		require.Equal(t, data, buf.Bytes())
+		buf.Reset()
+		require.NoError(t, c.ReadRange(*stored, buf, 64*10-10, 20))
+		require.Equal(t, data[64*10-10:64*10+10], buf.Bytes())
+
+		// the session no longer exists
+		_, err = c.ListUploadParts(uploadID)
+		require.Equal(t, metastor.ErrNotFound, err)
+		_, err = c.CompleteUpload(uploadID)
+		require.Equal(t, metastor.ErrNotFound, err)
+		_, err = c.UploadPart(uploadID, 0, bytes.NewReader(parts[0]))
+		require.Equal(t, metastor.ErrNotFound, err)
+		require.NoError(t, c.Delete(*stored))
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		before := objectCount(t, cluster)
+
+		key := []byte("abort")
+		uploadID, err := c.CreateUpload(key, CreateOptions{})
+		require.NoError(t, err)
+		for i, part := range parts {
+			_, err = c.UploadPart(uploadID, int64(i), bytes.NewReader(part))
+			require.NoError(t, err)
+		}
+		require.NoError(t, c.AbortUpload(uploadID))
+
+		// all data is deleted, and no metadata is stored
+		require.Equal(t, before, objectCount(t, cluster))
+		_, err = c.metastorClient.GetMetadata(key)
+		require.Equal(t, metastor.ErrNotFound, err)
+		_, err = c.CompleteUpload(uploadID)
+		require.Equal(t, metastor.ErrNotFound, err)
+		require.Equal(t, metastor.ErrNotFound, c.AbortUpload(uploadID))
+	})
+
+	t.Run("uneven_parts", func(t *testing.T) {
+		uploadID, err := c.CreateUpload([]byte("uneven"), CreateOptions{})
+		require.NoError(t, err)
+		_, err = c.CompleteUpload(uploadID)
+		require.Equal(t, ErrEmptyUpload, err)
+
+		// only the last part can have a size which isn't a multiple of the chunk size
+		_, err = c.UploadPart(uploadID, 0, bytes.NewReader(parts[2]))
+		require.NoError(t, err)
+		_, err = c.UploadPart(uploadID, 1, bytes.NewReader(parts[1]))
+		require.NoError(t, err)
+		_, err = c.CompleteUpload(uploadID)
+		require.Error(t, err)
+		require.NoError(t, c.AbortUpload(uploadID))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := c.CreateUpload(nil, CreateOptions{})
+		require.Equal(t, ErrNilKey, err)
+		_, err = c.UploadPart("foo", -1, bytes.NewReader(nil))
+		require.Equal(t, ErrInvalidUploadPart, err)
+		_, err = c.UploadPartContext(nil, "foo", 0, bytes.NewReader(nil))
+		require.Equal(t, ErrNilContext, err)
+		_, err = c.UploadPart("foo/bar", 0, bytes.NewReader(nil))
+		require.Equal(t, metastor.ErrInvalidUploadID, err)
+		_, err = c.UploadPart("foo", 0, bytes.NewReader(nil))
+		require.Equal(t, metastor.ErrNotFound, err)
+	})
+}
+
+func TestClientUploadVariableChunked(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, _, err := getTestClient(newDefaultConfig(shards, metatypes.VariableChunkSize))
+	require.NoError(t, err)
+	defer c.Close()
+
+	// parts of any size can be put together
+	data := make([]byte, 1000)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	parts := [][]byte{data[:333], data[333:334], data[334:]}
+
+	uploadID, err := c.CreateUpload([]byte("variable"), CreateOptions{})
+	require.NoError(t, err)
+	for i, part := range parts {
+		_, err = c.UploadPart(uploadID, int64(i), bytes.NewReader(part))
+		require.NoError(t, err)
+	}
+	md, err := c.CompleteUpload(uploadID)
+	require.NoError(t, err)
+	require.Equal(t, int64(metatypes.VariableChunkSize), int64(md.ChunkSize))
+	require.NoError(t, checkChunkOffsets(*md))
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, c.Read(*md, buf))
+	require.Equal(t, data, buf.Bytes())
+	buf.Reset()
+	require.NoError(t, c.ReadRange(*md, buf, 300, 100))
+	require.Equal(t, data[300:400], buf.Bytes())
+	require.NoError(t, c.Delete(*md))
+}
+
+func TestClientUploadDedup(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(t, err)
+	defer c.Close()
+	require.NoError(t, c.SetDeduplication(true))
+
+	data := make([]byte, 64*4)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	key := []byte("dedup")
+	md, err := c.Write(key, bytes.NewReader(data))
+	require.NoError(t, err)
+	count := objectCount(t, cluster)
+
+	upload := func() string {
+		uploadID, err := c.CreateUpload(key, CreateOptions{})
+		require.NoError(t, err)
+		_, err = c.UploadPart(uploadID, 0, bytes.NewReader(data))
+		require.NoError(t, err)
+		return uploadID
+	}
+	requireRefs := func(expected int64) {
+		for _, chunk := range md.Chunks {
+			_, refs, err := c.metastorClient.GetChunk(chunk.Hash)
+			require.NoError(t, err)
+			require.Equal(t, expected, refs)
+		}
+	}
+
+	// aborting a re-upload of the current data releases the chunks it references,
+	// even though they are shared with the current metadata of the key
+	uploadID := upload()
+	requireRefs(2)
+	require.NoError(t, c.AbortUpload(uploadID))
+	requireRefs(1)
+	require.Equal(t, count, objectCount(t, cluster))
+
+	// aborting a completed session only deletes the session itself
+	uploadID = upload()
+	require.NoError(t, c.metastorClient.SetUploadCompleted(uploadID, true))
+	require.NoError(t, c.AbortUpload(uploadID))
+	requireRefs(2)
+	_, err = c.ListUploadParts(uploadID)
+	require.Equal(t, metastor.ErrNotFound, err)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, c.Read(*md, buf))
+	require.Equal(t, data, buf.Bytes())
+}
@@ -17,6 +17,7 @@
 package client
 
 import (
+	"context"
 	"testing"
 
 	"github.com/threefoldtech/0-stor/client/datastor"
@@ -63,7 +64,7 @@ func testZdbServer(t testing.TB, n int) (servers []*testServer, cleanups func())
 
 func getTestClient(cfg Config) (*Client, datastor.Cluster, error) {
 	// create datastor cluster
-	datastorCluster, err := createDataClusterFromConfig(cfg)
+	datastorCluster, err := NewDataCluster(cfg)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,3 +90,18 @@ func getTestMetastorClient(namespace string) (*metastor.Client, error) {
 
 	return metastor.NewClient(namespace, db, "")
 }
+
+// objectCount returns the total amount of objects stored on all shards of the given cluster
+func objectCount(t testing.TB, cluster datastor.Cluster) int {
+	var count int
+	it := cluster.GetShardIterator(nil)
+	for it.Next() {
+		ch, err := it.Shard().ListObjectKeyIterator(context.Background())
+		require.NoError(t, err)
+		for result := range ch {
+			require.NoError(t, result.Error)
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+// Versions of a key are only created while the client is configured
+// to keep overwritten data (see OverwriteModeKeep).
+// Each version is an immutable copy of the metadata that got overwritten,
+// identified by the LastWriteEpoch of that metadata.
+// Versions outlive the deletion of their key, such that they can still be restored.
+
+// ListVersions returns the older versions of the given key,
+// sorted from oldest to newest. The current metadata of the key isn't included.
+func (c *Client) ListVersions(key []byte) ([]metatypes.Metadata, error) {
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+	if c.metastorClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+
+	// collect the versions first,
+	// as the metastor database might not allow nested calls while listing
+	var versions []int64
+	err := c.metastorClient.ListVersions(key, func(_ []byte, version int64) error {
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mds := make([]metatypes.Metadata, 0, len(versions))
+	for _, version := range versions {
+		md, err := c.metastorClient.GetVersion(key, version)
+		if err != nil {
+			return nil, err
+		}
+		mds = append(mds, *md)
+	}
+	return mds, nil
+}
+
+// GetVersion returns the metadata of the given version of the given key,
+// which can be used to read that version's data.
+//
+// metastor.ErrNotFound is returned in case the version doesn't exist.
+func (c *Client) GetVersion(key []byte, version int64) (*metatypes.Metadata, error) {
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+	if c.metastorClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+	return c.metastorClient.GetVersion(key, version)
+}
+
+// GetVersionAsOf returns the metadata of the given key,
+// as it was current at the given epoch (see EpochNow),
+// which can be used to read the data as it was at that time.
+// This can be either the current metadata or one of its older versions.
+//
+// metastor.ErrNotFound is returned in case the key had no data yet at the given epoch.
+func (c *Client) GetVersionAsOf(key []byte, epoch int64) (*metatypes.Metadata, error) {
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+	if c.metastorClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+
+	md, err := c.metastorClient.GetMetadata(key)
+	if err == nil && md.LastWriteEpoch <= epoch {
+		return md, nil
+	}
+	if err != nil && err != metastor.ErrNotFound {
+		return nil, err
+	}
+
+	// find the newest version written at or before the given epoch
+	asOf := int64(-1)
+	err = c.metastorClient.ListVersions(key, func(_ []byte, version int64) error {
+		if version <= epoch {
+			asOf = version
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if asOf < 0 {
+		return nil, metastor.ErrNotFound
+	}
+	return c.metastorClient.GetVersion(key, asOf)
+}
+
+// DeleteVersion deletes the data of the given version of the given key,
+// as well as the version itself.
+func (c *Client) DeleteVersion(key []byte, version int64) error {
+	return c.DeleteVersionContext(context.Background(), key, version)
+}
+
+// DeleteVersionContext deletes the data of the given version of the given key,
+// as well as the version itself,
+// aborting as soon as the given context is cancelled or its deadline expires.
+// The version is only deleted in case all its data could be deleted,
+// unless deduplication is enabled, in which case the version is deleted first,
+// as the references to its chunks can only be released once.
+func (c *Client) DeleteVersionContext(ctx context.Context, key []byte, version int64) error {
+	if ctx == nil {
+		return ErrNilContext
+	}
+	md, err := c.GetVersion(key, version)
+	if err != nil {
+		return err
+	}
+	if c.dedup {
+		err = c.metastorClient.DeleteVersion(key, version)
+		if err != nil {
+			return err
+		}
+		return c.freeChunks(ctx, md.Chunks)
+	}
+	err = c.dataPipeline.DeleteContext(ctx, md.Chunks)
+	if err != nil {
+		return err
+	}
+	return c.metastorClient.DeleteVersion(key, version)
+}
+
+// RestoreVersion makes the given version of the given key the current one,
+// returning the restored (current) metadata.
+// The metadata that was current until now is kept as an older version,
+// regardless of the overwrite mode of the client,
+// while the restored version is no longer listed as an older version.
+func (c *Client) RestoreVersion(key []byte, version int64) (*metatypes.Metadata, error) {
+	restored, err := c.GetVersion(key, version)
+	if err != nil {
+		return nil, err
+	}
+	// restoring a version counts as a write,
+	// such that it is correctly ordered in between the other versions
+	restored.LastWriteEpoch = EpochNow()
+
+	_, err = c.metastorClient.UpdateMetadata(key, func(current metatypes.Metadata) (*metatypes.Metadata, error) {
+		// keep the current metadata first, such that it isn't lost,
+		// in case the restored version couldn't be committed
+		if len(current.Chunks) > 0 {
+			err := c.metastorClient.SetVersion(current)
+			if err != nil {
+				return nil, err
+			}
+		}
+		md := *restored
+		md.Namespace = current.Namespace
+		return &md, nil
+	})
+	if err == metastor.ErrNotFound {
+		// the key was deleted, simply restore the version as the current metadata
+		err = c.metastorClient.SetMetadata(*restored)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the chunks of the version are now referenced by the current metadata,
+	// and should no longer be referenced by the version itself
+	err = c.metastorClient.DeleteVersion(key, version)
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
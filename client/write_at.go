@@ -0,0 +1,386 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+var (
+	// ErrWriteConflict is returned when the metadata of a key
+	// was modified by another write while writing at an offset of that key.
+	// None of the written data is stored in that case, and the write can be retried.
+	ErrWriteConflict = errors.New("Client: key was modified while writing to it")
+	// ErrInvalidWriteOffset is returned when writing at an offset
+	// which is negative or past the end of the data.
+	ErrInvalidWriteOffset = errors.New("Client: invalid write offset")
+)
+
+// WriteAt overwrites the data stored as the given key, starting at the given offset,
+// with the data read from the given reader, returning the updated metadata.
+// See WriteAtContext for more information.
+func (c *Client) WriteAt(key []byte, offset int64, r io.Reader) (*metatypes.Metadata, error) {
+	return c.WriteAtContext(context.Background(), key, offset, r)
+}
+
+// WriteAtContext overwrites the data stored as the given key, starting at the given offset,
+// with the data read from the given reader, returning the updated metadata,
+// aborting as soon as the given context is cancelled or its deadline expires.
+// The data grows in case more data is written than is stored past the offset,
+// while the offset itself can't be past the end of the data.
+//
+// Only the chunks affected by the write are read, updated and written again,
+// using the chunk size defined in the metadata of the key,
+// which has to equal the chunk size of the client.
+// Data which is split into chunks of variable size is rewritten
+// starting from the chunk containing the offset, as the boundaries
+// of the chunks that follow it might move because of the write,
+// until the boundaries of the rewritten chunks line up with those of the original chunks again.
+// Data which isn't chunked at all is stored as a single object,
+// and is thus read and rewritten completely, no matter how little data is written.
+//
+// The metadata is updated atomically, ErrWriteConflict is returned in case
+// the key was modified by another write in the meantime.
+// Writing doesn't create an older version of the key, regardless of the overwrite mode,
+// while the replaced chunks are deleted immediately, or delayed in case of the delayed overwrite mode.
+func (c *Client) WriteAtContext(ctx context.Context, key []byte, offset int64, r io.Reader) (*metatypes.Metadata, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+	if r == nil {
+		return nil, errors.New("no reader given to read from")
+	}
+	if c.metastorClient == nil {
+		return nil, ErrNoMetastorClient
+	}
+	if offset < 0 {
+		return nil, ErrInvalidWriteOffset
+	}
+
+	base, err := c.metastorClient.GetMetadata(key)
+	if err != nil {
+		return nil, err
+	}
+	if offset > base.Size {
+		return nil, ErrInvalidWriteOffset
+	}
+	err = c.checkChunkSize(*base)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		first    int
+		chunks   []metatypes.Chunk
+		replaced []metatypes.Chunk
+		size     int64
+	)
+	if base.ChunkSize == metatypes.VariableChunkSize {
+		index, _ := chunkAt(base.Chunks, metatypes.VariableChunkSize, offset)
+		first = int(index)
+		var last int
+		chunks, last, size, err = c.rewriteAt(ctx, *base, first, offset, r)
+		if len(chunks) > 0 {
+			replaced = base.Chunks[first:last]
+		}
+	} else if base.ChunkSize <= 0 {
+		var last int
+		chunks, last, size, err = c.rewriteAt(ctx, *base, 0, offset, r)
+		if len(chunks) > 0 {
+			replaced = base.Chunks[:last]
+		}
+	} else {
+		first = int(offset / int64(base.ChunkSize))
+		chunks, size, err = c.writeChunksAt(ctx, *base, offset, r)
+		if len(chunks) > 0 {
+			end := first + len(chunks)
+			if end > len(base.Chunks) {
+				end = len(base.Chunks)
+			}
+			replaced = base.Chunks[first:end]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(chunks) == 0 {
+		// nothing was written
+		return base, nil
+	}
+
+	md := *base
+	md.Chunks = make([]metatypes.Chunk, 0, len(base.Chunks)-len(replaced)+len(chunks))
+	md.Chunks = append(md.Chunks, base.Chunks[:first]...)
+	md.Chunks = append(md.Chunks, chunks...)
+	md.Chunks = append(md.Chunks, base.Chunks[first+len(replaced):]...)
+	md.Size = size
+	md.StorageSize = 0
+	for _, chunk := range md.Chunks {
+		md.StorageSize += chunk.Size
+	}
+	md.LastWriteEpoch = EpochNow()
+
+	err = c.commitUpdate(base, md, ErrWriteConflict)
+	if err != nil {
+		c.deleteUnusedChunks(chunks)
+		return nil, err
+	}
+	c.deleteReplacedChunks(key, replaced)
+	return &md, nil
+}
+
+// writeChunksAt writes the chunks affected by writing the data of the given reader
+// at the given offset of the given (chunked) data, returning those chunks,
+// which replace the chunks at the same position, as well as the (new) size of the data.
+// No chunks are returned in case no data was read.
+func (c *Client) writeChunksAt(ctx context.Context, md metatypes.Metadata, offset int64, r io.Reader) ([]metatypes.Chunk, int64, error) {
+	var (
+		chunks    []metatypes.Chunk
+		size      = md.Size
+		chunkSize = int64(md.ChunkSize)
+		index     = offset / chunkSize
+		start     = offset - index*chunkSize
+		original  = bytes.NewBuffer(make([]byte, 0, chunkSize))
+	)
+	for {
+		// fetch the chunk which is (partially) overwritten, if any
+		original.Reset()
+		if index < int64(len(md.Chunks)) {
+			err := c.dataPipeline.ReadContext(ctx, md.Chunks[index:index+1], original)
+			if err != nil {
+				c.deleteUnusedChunks(chunks)
+				return nil, 0, err
+			}
+		}
+		data := make([]byte, chunkSize)
+		copy(data, original.Bytes())
+
+		n, readErr := io.ReadFull(r, data[start:])
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			c.deleteUnusedChunks(chunks)
+			return nil, 0, readErr
+		}
+		if n == 0 {
+			return chunks, size, nil
+		}
+		end := start + int64(n)
+		if end < int64(original.Len()) {
+			end = int64(original.Len())
+		}
+		written, err := c.writeChunks(ctx, bytes.NewReader(data[:end]))
+		if err != nil {
+			c.deleteUnusedChunks(chunks)
+			return nil, 0, err
+		}
+		chunks = append(chunks, written...)
+		if s := index*chunkSize + end; s > size {
+			size = s
+		}
+		if readErr != nil {
+			return chunks, size, nil
+		}
+		index++
+		start = 0
+	}
+}
+
+// rewriteAt rewrites the data of the given metadata, starting from the chunk with the given index,
+// overwritten at the given offset with the data of the given reader,
+// returning the written chunks, which replace the chunks starting from that index
+// up to the returned index, as well as the (new) size of the data.
+// The index has to be 0, unless the metadata has chunks of variable size.
+// No chunks are returned in case no data was read.
+//
+// The data is streamed to the pipeline, such that only the chunk being overwritten
+// is buffered, rather than all data that is rewritten.
+func (c *Client) rewriteAt(ctx context.Context, md metatypes.Metadata, first int, offset int64, r io.Reader) ([]metatypes.Chunk, int, int64, error) {
+	// only rewrite the data in case there is any data to write
+	var peek [1]byte
+	n, err := io.ReadFull(r, peek[:])
+	if n == 0 {
+		if err == io.EOF {
+			err = nil
+		}
+		return nil, 0, 0, err
+	}
+
+	var start int64
+	if first < len(md.Chunks) {
+		start = md.Chunks[first].Offset
+	}
+	or := &overwriteReader{
+		ctx:      ctx,
+		client:   c,
+		md:       md,
+		pos:      start,
+		offset:   offset,
+		input:    io.MultiReader(bytes.NewReader(peek[:n]), r),
+		inputEnd: -1,
+	}
+	var (
+		src io.Reader = or
+		ar  *alignedReader
+	)
+	if cp, ok := c.dataPipeline.(pipeline.ChunkingPipeline); ok && md.ChunkSize == metatypes.VariableChunkSize {
+		if chunker := cp.NewChunker(or); chunker != nil {
+			ar = &alignedReader{or: or, chunker: chunker, end: start, aligned: -1}
+			src = ar
+		}
+	}
+	chunks, err := c.writeChunks(ctx, src)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if md.ChunkSize == metatypes.VariableChunkSize {
+		offsetChunks(chunks, start)
+	}
+	if ar != nil && ar.aligned >= 0 {
+		// the chunks that follow the written chunks are untouched
+		return chunks, ar.aligned, md.Size, nil
+	}
+	size := or.pos
+	if size < md.Size {
+		size = md.Size
+	}
+	return chunks, len(md.Chunks), size, nil
+}
+
+// alignedReader reads the content-defined chunks of an overwriteReader,
+// up to the first boundary past the written data, which is a boundary of the overwritten chunks as well.
+// The chunks that follow that boundary are the same as the overwritten chunks that follow it,
+// as the boundaries of content-defined chunks only depend upon the content within the chunk,
+// such that they don't have to be rewritten.
+type alignedReader struct {
+	or      *overwriteReader
+	chunker pipeline.Chunker
+
+	// remaining data of the current chunk, and the position at which it ends
+	chunk []byte
+	end   int64
+	// index of the first overwritten chunk which starts at the boundary
+	// at which the chunks are aligned again, -1 as long as they aren't
+	aligned int
+}
+
+// Read implements io.Reader.Read
+func (ar *alignedReader) Read(p []byte) (int, error) {
+	for len(ar.chunk) == 0 {
+		if ar.aligned >= 0 {
+			return 0, io.EOF
+		}
+		if ar.or.inputEnd >= 0 && ar.end >= ar.or.inputEnd {
+			chunks := ar.or.md.Chunks
+			index := sort.Search(len(chunks), func(i int) bool {
+				return chunks[i].Offset >= ar.end
+			})
+			if index < len(chunks) && chunks[index].Offset == ar.end {
+				ar.aligned = index
+				return 0, io.EOF
+			}
+		}
+		chunk, err := ar.chunker.Next()
+		if err != nil {
+			return 0, err
+		}
+		ar.chunk = chunk
+		ar.end += int64(len(chunk))
+	}
+	n := copy(p, ar.chunk)
+	ar.chunk = ar.chunk[n:]
+	return n, nil
+}
+
+// overwriteReader reads the data of the chunks of the given metadata, starting from a given position,
+// overwritten at a given offset with the data of a reader.
+// A chunk is only read once its data is required, which is never the case
+// for a chunk that is overwritten completely.
+type overwriteReader struct {
+	ctx    context.Context
+	client *Client
+	md     metatypes.Metadata
+
+	// position of the next byte to read within the data
+	pos int64
+	// offset at which the input is written, and the position at which it ended,
+	// which is -1 as long as the input isn't exhausted
+	offset, inputEnd int64
+	input            io.Reader
+
+	// data of the last read chunk, which starts at the given position
+	chunk      []byte
+	chunkStart int64
+}
+
+// Read implements io.Reader.Read
+func (or *overwriteReader) Read(p []byte) (int, error) {
+	if or.inputEnd < 0 && or.pos >= or.offset {
+		n, err := or.input.Read(p)
+		or.pos += int64(n)
+		if err != io.EOF {
+			return n, err
+		}
+		or.inputEnd = or.pos
+		if n > 0 {
+			return n, nil
+		}
+	}
+
+	if or.pos >= or.md.Size {
+		return 0, io.EOF
+	}
+	if or.inputEnd < 0 && or.offset-or.pos < int64(len(p)) {
+		p = p[:or.offset-or.pos]
+	}
+	if or.pos < or.chunkStart || or.pos >= or.chunkStart+int64(len(or.chunk)) {
+		err := or.readChunk()
+		if err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, or.chunk[or.pos-or.chunkStart:])
+	or.pos += int64(n)
+	return n, nil
+}
+
+// readChunk reads the chunk containing the current position
+func (or *overwriteReader) readChunk() error {
+	var index, start int64
+	if or.md.ChunkSize == metatypes.VariableChunkSize {
+		index, start = chunkAt(or.md.Chunks, metatypes.VariableChunkSize, or.pos)
+	}
+	buf := bytes.NewBuffer(or.chunk[:0])
+	err := or.client.dataPipeline.ReadContext(or.ctx, or.md.Chunks[index:index+1], buf)
+	if err != nil {
+		return err
+	}
+	or.chunk, or.chunkStart = buf.Bytes(), start
+	if or.pos >= or.chunkStart+int64(len(or.chunk)) {
+		return fmt.Errorf("chunk %d of %q doesn't contain the data at offset %d", index, or.md.Key, or.pos)
+	}
+	return nil
+}
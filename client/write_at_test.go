@@ -0,0 +1,230 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"crypto/rand"
+	mrand "math/rand"
+	"testing"
+	"testing/iotest"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientWriteAtChunked(t *testing.T) {
+	testClientWriteAt(t, 64)
+}
+
+func TestClientWriteAtNotChunked(t *testing.T) {
+	testClientWriteAt(t, 0)
+}
+
+func TestClientWriteAtVariableChunked(t *testing.T) {
+	testClientWriteAt(t, metatypes.VariableChunkSize)
+}
+
+func testClientWriteAt(t *testing.T, chunkSize int) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, cluster, err := getTestClient(newDefaultConfig(shards, chunkSize))
+	require.NoError(t, err)
+	defer c.Close()
+
+	key := []byte("disk")
+	data := make([]byte, 64*5+10)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	md, err := c.Write(key, bytes.NewReader(data))
+	require.NoError(t, err)
+	chunks := len(md.Chunks)
+
+	testCases := []struct {
+		name           string
+		offset, length int
+	}{
+		{"within_chunk", 10, 20},
+		{"whole_chunk", 64, 64},
+		{"spanning_chunks", 100, 100},
+		{"last_chunk", 64*5 + 2, 4},
+		{"extending", 64*5 + 5, 100},
+		{"appending", 64*5 + 105, 23},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := make([]byte, tc.length)
+			_, err := rand.Read(input)
+			require.NoError(t, err)
+			if end := tc.offset + tc.length; end > len(data) {
+				data = append(data, make([]byte, end-len(data))...)
+			}
+			copy(data[tc.offset:], input)
+
+			previous, err := c.metastorClient.GetMetadata(key)
+			require.NoError(t, err)
+			md, err := c.WriteAt(key, int64(tc.offset), bytes.NewReader(input))
+			require.NoError(t, err)
+			require.Equal(t, int64(len(data)), md.Size)
+			stored, err := c.metastorClient.GetMetadata(key)
+			require.NoError(t, err)
+			require.Equal(t, md.Chunks, stored.Chunks)
+
+			buf := bytes.NewBuffer(nil)
+			require.NoError(t, c.Read(*stored, buf))
+			require.Equal(t, data, buf.Bytes())
+
+			if chunkSize > 0 {
+				// all chunks but the last one are full
+				require.Len(t, stored.Chunks, (len(data)+chunkSize-1)/chunkSize)
+				// the chunks which weren't written to are untouched
+				first := tc.offset / chunkSize
+				require.Equal(t, previous.Chunks[:first], stored.Chunks[:first])
+			} else if chunkSize == metatypes.VariableChunkSize {
+				// the chunks preceding the written one are untouched
+				first, _ := chunkAt(previous.Chunks, metatypes.VariableChunkSize, int64(tc.offset))
+				require.Equal(t, previous.Chunks[:first], stored.Chunks[:first])
+				require.NoError(t, checkChunkOffsets(*stored))
+			} else {
+				require.Len(t, stored.Chunks, chunks)
+			}
+
+			// replaced chunks are deleted
+			var objects int
+			for _, chunk := range stored.Chunks {
+				objects += len(chunk.Objects)
+			}
+			require.Equal(t, objects, objectCount(t, cluster))
+		})
+	}
+
+	// the input is streamed, regardless of how it is read
+	input := make([]byte, 100)
+	_, err = rand.Read(input)
+	require.NoError(t, err)
+	copy(data[30:], input)
+	md, err = c.WriteAt(key, 30, iotest.OneByteReader(bytes.NewReader(input)))
+	require.NoError(t, err)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, c.Read(*md, buf))
+	require.Equal(t, data, buf.Bytes())
+	_, err = c.WriteAt(key, 30, iotest.TimeoutReader(bytes.NewReader(input)))
+	require.Equal(t, iotest.ErrTimeout, err)
+	var objects int
+	for _, chunk := range md.Chunks {
+		objects += len(chunk.Objects)
+	}
+	require.Equal(t, objects, objectCount(t, cluster))
+
+	// writing nothing doesn't change anything
+	stored, err := c.metastorClient.GetMetadata(key)
+	require.NoError(t, err)
+	md, err = c.WriteAt(key, 10, bytes.NewReader(nil))
+	require.NoError(t, err)
+	require.Equal(t, stored, md)
+	md, err = c.WriteAt(key, int64(len(data)), bytes.NewReader(nil))
+	require.NoError(t, err)
+	require.Equal(t, stored, md)
+
+	_, err = c.WriteAt(key, int64(len(data))+1, bytes.NewReader([]byte("foo")))
+	require.Equal(t, ErrInvalidWriteOffset, err)
+	_, err = c.WriteAt(key, -1, bytes.NewReader([]byte("foo")))
+	require.Equal(t, ErrInvalidWriteOffset, err)
+	_, err = c.WriteAt([]byte("foo"), 0, bytes.NewReader([]byte("foo")))
+	require.Equal(t, metastor.ErrNotFound, err)
+	_, err = c.WriteAt(nil, 0, bytes.NewReader(nil))
+	require.Equal(t, ErrNilKey, err)
+	_, err = c.WriteAtContext(nil, key, 0, bytes.NewReader(nil))
+	require.Equal(t, ErrNilContext, err)
+}
+
+func TestClientWriteAtVariableChunkedAligned(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	// chunks of a realistic size, which are much larger than the window of the rolling hash,
+	// such that their boundaries line up again soon after the written data
+	config := newDefaultConfig(shards, metatypes.VariableChunkSize)
+	config.DataStor.Pipeline.Chunking.AverageSize = 1024
+	c, cluster, err := getTestClient(config)
+	require.NoError(t, err)
+	defer c.Close()
+
+	key := []byte("disk")
+	data := make([]byte, 1024*128)
+	_, err = mrand.New(mrand.NewSource(42)).Read(data)
+	require.NoError(t, err)
+	_, err = c.Write(key, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	for _, offset := range []int{0, 10, 1024 * 50, 1024*64 + 3, len(data) - 100} {
+		previous, err := c.metastorClient.GetMetadata(key)
+		require.NoError(t, err)
+		require.True(t, len(previous.Chunks) > 100)
+
+		input := []byte{data[offset] + 1, data[offset+1] + 1}
+		copy(data[offset:], input)
+		md, err := c.WriteAt(key, int64(offset), bytes.NewReader(input))
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), md.Size)
+		require.NoError(t, checkChunkOffsets(*md))
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, c.Read(*md, buf))
+		require.Equal(t, data, buf.Bytes())
+
+		// only the chunks around the written data are rewritten,
+		// while all other chunks are untouched
+		var head, tail int
+		for head < len(md.Chunks) && head < len(previous.Chunks) &&
+			chunkEqual(md.Chunks[head], previous.Chunks[head]) {
+			head++
+		}
+		for tail < len(md.Chunks)-head && tail < len(previous.Chunks)-head &&
+			chunkEqual(md.Chunks[len(md.Chunks)-1-tail], previous.Chunks[len(previous.Chunks)-1-tail]) {
+			tail++
+		}
+		require.True(t, len(md.Chunks)-head-tail <= 3,
+			"%d out of %d chunks rewritten", len(md.Chunks)-head-tail, len(md.Chunks))
+
+		var objects int
+		for _, chunk := range md.Chunks {
+			objects += len(chunk.Objects)
+		}
+		require.Equal(t, objects, objectCount(t, cluster))
+	}
+}
+
+func chunkEqual(a, b metatypes.Chunk) bool {
+	return a.Offset == b.Offset && bytes.Equal(a.Hash, b.Hash) &&
+		len(a.Objects) > 0 && len(a.Objects) == len(b.Objects) &&
+		bytes.Equal(a.Objects[0].Key, b.Objects[0].Key)
+}
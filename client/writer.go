@@ -0,0 +1,174 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
+	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
+)
+
+var (
+	// ErrWriterClosed is returned when using an ObjectWriter which is already closed or aborted.
+	ErrWriterClosed = errors.New("Client: object writer is closed")
+	// ErrWriteAborted is the error with which the data pipeline
+	// of an aborted ObjectWriter is interrupted.
+	ErrWriteAborted = errors.New("Client: write aborted")
+)
+
+var (
+	_ io.WriteCloser = (*ObjectWriter)(nil)
+)
+
+// CreateOptions can be used to configure an ObjectWriter.
+type CreateOptions struct {
+	// UserDefined metadata, stored in the `UserDefined` field of the metadata.
+	UserDefined map[string]string
+}
+
+// Create returns a writer which stores all data written to it as the given key.
+// The data is processed and written to the 0-stor cluster as it arrives,
+// while the metadata is only stored once the writer is closed.
+// See ObjectWriter for more information.
+func (c *Client) Create(key []byte, opts CreateOptions) (*ObjectWriter, error) {
+	return c.CreateContext(context.Background(), key, opts)
+}
+
+// CreateContext returns a writer which stores all data written to it as the given key.
+// The write is aborted as soon as the given context is cancelled or its deadline expires.
+// See ObjectWriter for more information.
+func (c *Client) CreateContext(ctx context.Context, key []byte, opts CreateOptions) (*ObjectWriter, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if len(key) == 0 {
+		return nil, ErrNilKey
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+	w := &ObjectWriter{
+		cancel: cancel,
+		pw:     pw,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(w.done)
+		w.md, w.err = c.write(ctx, key, pr, opts.UserDefined)
+		if w.err != nil {
+			// unblock pending and future writes
+			pr.CloseWithError(w.err)
+		}
+	}()
+	return w, nil
+}
+
+// ObjectWriter is an io.WriteCloser which stores all data written to it,
+// in a streaming fashion, as a single key in the 0-stor cluster.
+//
+// The metadata is only stored when calling Close, which returns an error
+// in case the data couldn't be stored. As long as the writer isn't closed,
+// the upload can be discarded by calling Abort, which deletes all data written so far.
+//
+// Write shouldn't be called concurrently,
+// while Abort can be called from any goroutine, interrupting a pending Write.
+type ObjectWriter struct {
+	cancel context.CancelFunc
+	pw     *io.PipeWriter
+
+	mux    sync.Mutex
+	closed bool
+
+	// only available once done is closed
+	done chan struct{}
+	md   *metatypes.Metadata
+	err  error
+}
+
+// Write implements io.Writer.Write
+func (w *ObjectWriter) Write(p []byte) (int, error) {
+	if w.isClosed() {
+		return 0, ErrWriterClosed
+	}
+	return w.pw.Write(p)
+}
+
+// Close implements io.Closer.Close,
+// finishing the upload and storing its metadata.
+func (w *ObjectWriter) Close() error {
+	if !w.close() {
+		return ErrWriterClosed
+	}
+	w.pw.Close()
+	<-w.done
+	w.cancel()
+	return w.err
+}
+
+// Abort discards the upload, deleting all data which was already written.
+// An error is only returned in case not all of that data could be deleted,
+// in which case it is a *storage.RollbackError, listing the objects which remain stored.
+func (w *ObjectWriter) Abort() error {
+	if !w.close() {
+		return ErrWriterClosed
+	}
+	// only interrupt the input of the pipeline, rather than cancelling its context,
+	// such that pending object writes can finish, and be rolled back as well
+	w.pw.CloseWithError(ErrWriteAborted)
+	<-w.done
+	w.cancel()
+	if err, ok := w.err.(*storage.RollbackError); ok {
+		return err
+	}
+	return nil
+}
+
+// Metadata returns the metadata of the stored data,
+// only available once the writer has been closed successfully.
+func (w *ObjectWriter) Metadata() *metatypes.Metadata {
+	select {
+	case <-w.done:
+		if w.err != nil {
+			return nil
+		}
+		return w.md
+	default:
+		return nil
+	}
+}
+
+// close marks the writer as closed,
+// returning false in case it was already closed.
+func (w *ObjectWriter) close() bool {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if w.closed {
+		return false
+	}
+	w.closed = true
+	return true
+}
+
+func (w *ObjectWriter) isClosed() bool {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return w.closed
+}
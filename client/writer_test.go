@@ -0,0 +1,137 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/metastor"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectWriter(t *testing.T) {
+	servers, serverClean := testZdbServer(t, 4)
+	defer serverClean()
+
+	shards := make([]datastor.ShardConfig, len(servers))
+	for i, server := range servers {
+		shards[i] = datastor.ShardConfig{Address: server.Address()}
+	}
+
+	c, cluster, err := getTestClient(newDefaultConfig(shards, 64))
+	require.NoError(t, err)
+	defer c.Close()
+
+	data := make([]byte, 64*20+10)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	t.Run("close", func(t *testing.T) {
+		key := []byte("close")
+		w, err := c.Create(key, CreateOptions{
+			UserDefined: map[string]string{"foo": "bar"},
+		})
+		require.NoError(t, err)
+
+		// write the data in uneven parts
+		for offset := 0; offset < len(data); offset += 100 {
+			end := offset + 100
+			if end > len(data) {
+				end = len(data)
+			}
+			n, err := w.Write(data[offset:end])
+			require.NoError(t, err)
+			require.Equal(t, end-offset, n)
+		}
+		// metadata isn't stored until the writer is closed
+		require.Nil(t, w.Metadata())
+		_, err = c.metastorClient.GetMetadata(key)
+		require.Equal(t, metastor.ErrNotFound, err)
+
+		require.NoError(t, w.Close())
+		md := w.Metadata()
+		require.NotNil(t, md)
+		require.Equal(t, int64(len(data)), md.Size)
+		require.Equal(t, "bar", md.UserDefined["foo"])
+
+		stored, err := c.metastorClient.GetMetadata(key)
+		require.NoError(t, err)
+		require.Equal(t, md.Chunks, stored.Chunks)
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, c.Read(*stored, buf))
+		require.Equal(t, data, buf.Bytes())
+
+		// a closed writer can no longer be used
+		_, err = w.Write(data)
+		require.Equal(t, ErrWriterClosed, err)
+		require.Equal(t, ErrWriterClosed, w.Close())
+		require.Equal(t, ErrWriterClosed, w.Abort())
+		require.NoError(t, c.Delete(*stored))
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		before := objectCount(t, cluster)
+
+		key := []byte("abort")
+		w, err := c.Create(key, CreateOptions{})
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Abort())
+		require.Nil(t, w.Metadata())
+
+		// the data written so far is deleted, and no metadata is stored
+		require.Equal(t, before, objectCount(t, cluster))
+		_, err = c.metastorClient.GetMetadata(key)
+		require.Equal(t, metastor.ErrNotFound, err)
+
+		_, err = w.Write(data)
+		require.Equal(t, ErrWriterClosed, err)
+		require.Equal(t, ErrWriterClosed, w.Close())
+		require.Equal(t, ErrWriterClosed, w.Abort())
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		w, err := c.CreateContext(ctx, []byte("cancel"), CreateOptions{})
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		cancel()
+
+		// writes fail once the pipeline is interrupted,
+		// and close returns the error of the pipeline
+		for err == nil {
+			_, err = w.Write(data)
+		}
+		require.Equal(t, context.Canceled, err)
+		require.Equal(t, context.Canceled, w.Close())
+		require.Nil(t, w.Metadata())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := c.Create(nil, CreateOptions{})
+		require.Equal(t, ErrNilKey, err)
+		_, err = c.CreateContext(nil, []byte("foo"), CreateOptions{})
+		require.Equal(t, ErrNilContext, err)
+	})
+}
@@ -22,6 +22,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -102,6 +104,41 @@ var fileUploadCmd = &cobra.Command{
 	},
 }
 
+// fileAppendCmd represents the file-append command
+var fileAppendCmd = &cobra.Command{
+	Use:   "append <key> [path]",
+	Short: "Append data to a file.",
+	Long: "Append data to a file which is stored securely onto (a) 0-stor server(s),\n" +
+		"creating the file in case it doesn't exist yet.\n" +
+		"The data is read from the given file, or from the STDIN if no file is given.",
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		cl, _, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		key := args[0]
+		inputName, input := "STDIN", io.Reader(os.Stdin)
+		if len(args) == 2 {
+			inputName = args[1]
+			file, err := os.Open(inputName)
+			if err != nil {
+				return fmt.Errorf("can't read the file %q: %v", inputName, err)
+			}
+			defer file.Close()
+			input = file
+		}
+
+		md, err := cl.Append([]byte(key), input)
+		if err != nil {
+			return fmt.Errorf("appending data from %q to %q failed: %v", inputName, key, err)
+		}
+		log.Infof("data from %q appended to key = %q (size = %d)", inputName, key, md.Size)
+		return nil
+	},
+}
+
 var fileUploadCfg struct {
 	Key string
 }
@@ -275,14 +312,73 @@ var fileRepairCmd = &cobra.Command{
 	},
 }
 
+// fileVersionsCmd represents the file-versions command
+var fileVersionsCmd = &cobra.Command{
+	Use:   "versions <key>",
+	Short: "Print the older versions of a file.",
+	Long: "Print the older versions of a file which is stored securely onto (a) 0-stor server(s).\n" +
+		"Older versions are only kept when overwriting a file using the keep overwrite mode.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		cl, _, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		key := args[0]
+		versions, err := cl.ListVersions([]byte(key))
+		if err != nil {
+			return fmt.Errorf("failed to list versions of %q: %v", key, err)
+		}
+
+		// print one version per line, from oldest to newest
+		for _, md := range versions {
+			fmt.Printf("%d\t%d\t%s\n", md.LastWriteEpoch, md.Size,
+				time.Unix(0, md.LastWriteEpoch).UTC().Format(time.RFC3339))
+		}
+		return nil
+	},
+}
+
+// fileRestoreCmd represents the file-restore command
+var fileRestoreCmd = &cobra.Command{
+	Use:   "restore <key> <version>",
+	Short: "Restore an older version of a file.",
+	Long: "Restore an older version of a file which is stored securely onto (a) 0-stor server(s).\n" +
+		"The current version of the file is kept as an older version.",
+	Args: cobra.ExactArgs(2),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		cl, _, err := getClient()
+		if err != nil {
+			return err
+		}
+
+		key := args[0]
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q: %v", args[1], err)
+		}
+		_, err = cl.RestoreVersion([]byte(key), version)
+		if err != nil {
+			return fmt.Errorf("failed to restore version %d of %q: %v", version, key, err)
+		}
+
+		log.Infof("version %d of file %q restored", version, key)
+		return nil
+	},
+}
+
 func init() {
 	fileCmd.AddCommand(
 		fileUploadCmd,
+		fileAppendCmd,
 		fileDownloadCmd,
 		fileDeleteCmd,
 		fileMetadataCmd,
 		fileListCmd,
 		fileRepairCmd,
+		fileVersionsCmd,
+		fileRestoreCmd,
 	)
 
 	fileCmd.PersistentFlags().StringVar(
@@ -0,0 +1,142 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/gc"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// gcCmd represents the garbage-collection command
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Delete orphaned objects from the 0-db shards.",
+	Long: "Delete all objects stored on the 0-db shards, which aren't referenced by any metadata " +
+		"stored in the namespace, and which are older than the grace period.",
+	Args: cobra.ExactArgs(0),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		metaCli, err := getMetaClient()
+		if err != nil {
+			return err
+		}
+		defer metaCli.Close()
+
+		cluster, err := getDataCluster()
+		if err != nil {
+			return err
+		}
+		defer cluster.Close()
+
+		report, err := gc.Collect(context.Background(), metaCli, cluster, gc.Config{
+			GracePeriod:      gcCfg.GracePeriod,
+			UploadExpiration: gcCfg.UploadExpiration,
+			DryRun:           gcCfg.DryRun,
+		})
+		if err != nil {
+			return fmt.Errorf("garbage collection failed: %v", err)
+		}
+
+		writeGCReport(os.Stdout, report, gcCfg.Report)
+
+		var shardErrors int
+		for _, shard := range report.Shards {
+			if shard.Error != nil {
+				shardErrors++
+				log.Errorf("garbage collection of shard %s failed: %v", shard.Shard, shard.Error)
+			}
+		}
+		if shardErrors > 0 {
+			return fmt.Errorf("garbage collection failed for %d shard(s)", shardErrors)
+		}
+		return nil
+	},
+}
+
+var gcCfg struct {
+	GracePeriod      time.Duration
+	UploadExpiration time.Duration
+	DryRun           bool
+	Report           bool
+}
+
+func writeGCReport(w io.Writer, report *gc.Report, listOrphans bool) {
+	if report.DryRun {
+		fmt.Fprintln(w, "dry run: no objects or upload sessions were deleted")
+	}
+	fmt.Fprintf(w, "grace period: %v\n", report.GracePeriod)
+	fmt.Fprintf(w, "metadata: %d\n", report.MetadataCount)
+	fmt.Fprintf(w, "upload sessions: %d active, %d expired\n", report.UploadCount, report.ExpiredUploads)
+	fmt.Fprintf(w, "indexed chunks: %d\n", report.IndexedChunks)
+	fmt.Fprintf(w, "referenced objects: %d\n", report.ReferencedObjects)
+
+	for _, shard := range report.Shards {
+		var recent int
+		for _, orphan := range shard.Orphans {
+			if orphan.Recent {
+				recent++
+			}
+		}
+		fmt.Fprintf(w, "shard %s: %d scanned, %d orphaned (%d within grace period)\n",
+			shard.Shard, shard.ScannedObjects, len(shard.Orphans), recent)
+		if !listOrphans {
+			continue
+		}
+		for _, orphan := range shard.Orphans {
+			var status string
+			switch {
+			case orphan.Deleted:
+				status = "deleted"
+			case orphan.Error != nil:
+				status = "error: " + orphan.Error.Error()
+			case orphan.Recent:
+				status = "within grace period"
+			default:
+				status = "not deleted"
+			}
+			fmt.Fprintf(w, "  0x%X (%d bytes, stored at %s): %s\n",
+				orphan.Key, orphan.Size,
+				time.Unix(orphan.Timestamp, 0).UTC().Format(time.RFC3339), status)
+		}
+	}
+
+	orphans, deletedObjects, deletedBytes := report.Totals()
+	fmt.Fprintf(w, "total: %d orphaned, %d deleted (%d bytes)\n",
+		orphans, deletedObjects, deletedBytes)
+}
+
+func init() {
+	gcCmd.Flags().DurationVar(
+		&gcCfg.GracePeriod, "grace-period", gc.DefaultGracePeriod,
+		"Only delete orphaned objects older than this period, use a negative value to disable.")
+	gcCmd.Flags().DurationVar(
+		&gcCfg.UploadExpiration, "upload-expiration", gc.DefaultUploadExpiration,
+		"Delete upload sessions which were inactive for longer than this period, use a negative value to disable.")
+	gcCmd.Flags().BoolVar(
+		&gcCfg.DryRun, "dry-run", false,
+		"Collect the orphaned objects, without deleting them.")
+	gcCmd.Flags().BoolVar(
+		&gcCfg.Report, "report", false,
+		"List every orphaned object found, as part of the printed report.")
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/threefoldtech/0-stor/client"
+	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
+
+	"github.com/spf13/cobra"
+)
+
+// namespaceCmd represents the namespace for all namespace subcommands
+var namespaceCmd = &cobra.Command{
+	Use:   "namespace",
+	Short: "Manage the 0-db namespaces of the shards of the cluster.",
+	Long: "Manage the 0-db namespaces of the shards of the cluster, " +
+		"using the admin password defined by the provision config of the datastor.",
+}
+
+// namespaceCreateCmd represents the namespace-create command
+var namespaceCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create the namespaces of all shards of the cluster.",
+	Long: "Create the namespaces of all shards listed in the config, if they don't exist yet, " +
+		"and apply the password, size limit and mode defined by the config to all of them.",
+	Args: cobra.ExactArgs(0),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		cfg, err := getNamespaceClusterConfig()
+		if err != nil {
+			return err
+		}
+		return zerodb.ProvisionNamespaces(context.Background(), cfg)
+	},
+}
+
+// namespaceResizeCmd represents the namespace-resize command
+var namespaceResizeCmd = &cobra.Command{
+	Use:   "resize <size>",
+	Short: "Set the size limit of the namespaces in bytes, use 0 to remove the limit.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		size, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || size < 0 {
+			return fmt.Errorf("invalid namespace size %q", args[0])
+		}
+		return forEachNamespace(func(ctx context.Context, admin *zerodb.AdminClient, addr string, ns zerodb.NamespaceConfig) error {
+			return admin.SetNamespaceMaxSize(ctx, ns.Name, size)
+		})
+	},
+}
+
+// namespaceDeleteCmd represents the namespace-delete command
+var namespaceDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete the namespaces, and all objects stored within them.",
+	Args:  cobra.ExactArgs(0),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		if !namespaceCfg.Force {
+			return errors.New("deleting namespaces deletes all their data, use --force to confirm")
+		}
+		return forEachNamespace(func(ctx context.Context, admin *zerodb.AdminClient, addr string, ns zerodb.NamespaceConfig) error {
+			return admin.DeleteNamespace(ctx, ns.Name)
+		})
+	},
+}
+
+// namespaceListCmd represents the namespace-list command
+var namespaceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the namespaces of the 0-db servers of the shards.",
+	Args:  cobra.ExactArgs(0),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		return forEachNamespace(func(ctx context.Context, admin *zerodb.AdminClient, addr string, ns zerodb.NamespaceConfig) error {
+			names, err := admin.ListNamespaces(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s:\n", addr)
+			for _, name := range names {
+				fmt.Printf("  %s\n", name)
+			}
+			return nil
+		})
+	},
+}
+
+var namespaceCfg struct {
+	Shard string
+	Force bool
+}
+
+// getNamespaceClusterConfig returns the cluster config of the datastor,
+// as used to administer the namespaces of its shards.
+func getNamespaceClusterConfig() (zerodb.ClusterConfig, error) {
+	cfg, err := getClientConfig()
+	if err != nil {
+		return zerodb.ClusterConfig{}, err
+	}
+
+	clusterCfg, err := client.DataClusterConfig(cfg.Config)
+	if err != nil {
+		return zerodb.ClusterConfig{}, err
+	}
+	if namespaceCfg.Shard == "" {
+		return clusterCfg, nil
+	}
+	for _, shardCfg := range clusterCfg.Shards {
+		if shardCfg.Address == namespaceCfg.Shard {
+			clusterCfg.Shards = append(clusterCfg.Shards[:0:0], shardCfg)
+			return clusterCfg, nil
+		}
+	}
+	return zerodb.ClusterConfig{}, fmt.Errorf("shard %s isn't listed in the config", namespaceCfg.Shard)
+}
+
+// forEachNamespace calls the given function for the namespace of each shard,
+// using an admin client connected to the 0-db server of that shard.
+func forEachNamespace(fn func(ctx context.Context, admin *zerodb.AdminClient, addr string, ns zerodb.NamespaceConfig) error) error {
+	cfg, err := getNamespaceClusterConfig()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	for _, shardCfg := range cfg.Shards {
+		ns := zerodb.ShardNamespaceConfig(cfg, shardCfg)
+		admin, err := zerodb.NewAdminClient(shardCfg.Address, cfg.Provision.AdminPassword,
+			cfg.TLSConfig, cfg.Connection.Merge(shardCfg.Connection))
+		if err != nil {
+			return fmt.Errorf("failed to connect to shard %s: %v", shardCfg.Address, err)
+		}
+		err = fn(ctx, admin, shardCfg.Address, ns)
+		admin.Close()
+		if err != nil {
+			return fmt.Errorf("namespace %s of shard %s: %v", ns.Name, shardCfg.Address, err)
+		}
+	}
+	return nil
+}
+
+func init() {
+	namespaceCmd.AddCommand(
+		namespaceCreateCmd,
+		namespaceResizeCmd,
+		namespaceDeleteCmd,
+		namespaceListCmd,
+	)
+
+	namespaceCmd.PersistentFlags().StringVar(
+		&namespaceCfg.Shard, "shard", "",
+		"Address of the only shard to manage, all shards listed in the config are managed by default.")
+	namespaceDeleteCmd.Flags().BoolVar(
+		&namespaceCfg.Force, "force", false,
+		"Confirm that the namespaces and all their data have to be deleted.")
+}
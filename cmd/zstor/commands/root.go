@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"github.com/threefoldtech/0-stor/client"
+	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/metastor"
 	db_utils "github.com/threefoldtech/0-stor/client/metastor/db/utils"
 	"github.com/threefoldtech/0-stor/client/metastor/encoding"
@@ -80,6 +81,15 @@ func getClient() (*client.Client, *metastor.Client, error) {
 	return cl, metaCli, nil
 }
 
+func getDataCluster() (datastor.Cluster, error) {
+	cfg, err := getClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewDataCluster(cfg.Config)
+}
+
 func getMetaClient() (*metastor.Client, error) {
 	clientCfg, err := getClientConfig()
 	if err != nil {
@@ -143,6 +153,10 @@ func init() {
 	rootCmd.AddCommand(
 		fileCmd,
 		daemonCmd,
+		gcCmd,
+		scrubCmd,
+		shardCmd,
+		namespaceCmd,
 		cmd.VersionCmd,
 	)
 
@@ -0,0 +1,166 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/scrub"
+
+	"github.com/spf13/cobra"
+)
+
+// scrubCmd represents the scrub command
+var scrubCmd = &cobra.Command{
+	Use:   "scrub",
+	Short: "Check and repair the data of all keys.",
+	Long: "Check the data of all keys stored in the namespace, " +
+		"and repair the data which is valid, but no longer optimal.",
+	Args: cobra.ExactArgs(0),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		cl, metaCli, err := getClient()
+		if err != nil {
+			return err
+		}
+		defer cl.Close()
+
+		scrubber, err := scrub.New(cl, metaCli, scrubCfg.Config)
+		if err != nil {
+			return err
+		}
+
+		// stop scrubbing when interrupted
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGINT)
+		go func() {
+			select {
+			case <-sigChan:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		if scrubCfg.Continuous {
+			return scrubber.Run(ctx)
+		}
+
+		report, err := scrubber.Scrub(ctx)
+		if report != nil {
+			writeScrubReport(os.Stdout, report, scrubCfg.Report)
+		}
+		if report != nil && report.Aborted {
+			return fmt.Errorf("scrub aborted after %d/%d key(s)", report.Done(), report.Keys)
+		}
+		if err != nil {
+			return fmt.Errorf("scrub failed: %v", err)
+		}
+		if report.Invalid > 0 || report.Failed > 0 {
+			return fmt.Errorf("scrub found %d invalid key(s), and failed for %d key(s)",
+				report.Invalid, report.Failed)
+		}
+		return nil
+	},
+}
+
+var scrubCfg struct {
+	scrub.Config
+	Continuous bool
+	Report     bool
+}
+
+func writeScrubReport(w io.Writer, report *scrub.Report, listProblems bool) {
+	if report.DryRun {
+		fmt.Fprintln(w, "dry run: no data was repaired, and no results were recorded")
+	}
+	if report.Aborted {
+		fmt.Fprintln(w, "aborted: not all keys were scrubbed")
+	}
+	mode := "fast"
+	if report.Deep {
+		mode = "deep"
+	}
+	fmt.Fprintf(w, "mode: %s\n", mode)
+	fmt.Fprintf(w, "keys: %d scanned, %d skipped\n", report.Keys, report.Skipped)
+	fmt.Fprintf(w, "optimal: %d\n", report.Optimal)
+	fmt.Fprintf(w, "valid: %d\n", report.Valid)
+	fmt.Fprintf(w, "repaired: %d\n", report.Repaired)
+	fmt.Fprintf(w, "degraded: %d\n", report.Degraded)
+	fmt.Fprintf(w, "invalid: %d\n", report.Invalid)
+	fmt.Fprintf(w, "failed: %d\n", report.Failed)
+	fmt.Fprintf(w, "pruned records: %d\n", report.Pruned)
+	if !report.Finished.IsZero() {
+		fmt.Fprintf(w, "duration: %v\n", report.Finished.Sub(report.Started))
+	}
+
+	if !listProblems {
+		return
+	}
+	for _, problem := range report.Problems {
+		if problem.Error != nil {
+			fmt.Fprintf(w, "  %q: %s: %v\n", problem.Key, problem.Result, problem.Error)
+			continue
+		}
+		fmt.Fprintf(w, "  %q: %s\n", problem.Key, problem.Result)
+	}
+	if report.OmittedProblems > 0 {
+		fmt.Fprintf(w, "  ... and %d more\n", report.OmittedProblems)
+	}
+}
+
+func init() {
+	scrubCmd.Flags().BoolVar(
+		&scrubCfg.Deep, "deep", false,
+		"Check all objects of each key, required to detect and repair data which isn't optimal.")
+	scrubCmd.Flags().DurationVar(
+		&scrubCfg.Interval, "interval", scrub.DefaultInterval,
+		"Skip keys scrubbed within this interval, and not written since, use a negative value to disable.")
+	scrubCmd.Flags().IntVar(
+		&scrubCfg.Concurrency, "concurrency", scrub.DefaultConcurrency,
+		"Amount of keys scrubbed in parallel.")
+	scrubCmd.Flags().Float64Var(
+		&scrubCfg.KeysPerSecond, "keys-per-second", 0,
+		"Maximum amount of keys scrubbed per second, unlimited by default.")
+	scrubCmd.Flags().IntVar(
+		&scrubCfg.BytesPerSecond, "bytes-per-second", 0,
+		"Maximum amount of data deeply checked or repaired per second, unlimited by default.")
+	scrubCmd.Flags().BoolVar(
+		&scrubCfg.Continuous, "continuous", false,
+		"Keep scrubbing until interrupted, pausing in between passes.")
+	scrubCmd.Flags().DurationVar(
+		&scrubCfg.Pause, "pause", scrub.DefaultPause,
+		"Time to wait in between passes, when scrubbing continuously.")
+	scrubCmd.Flags().DurationVar(
+		&scrubCfg.ProgressInterval, "progress-interval", time.Minute,
+		"Interval at which the progress is logged, use zero to disable.")
+	scrubCmd.Flags().BoolVar(
+		&scrubCfg.DryRun, "dry-run", false,
+		"Check all keys, without repairing data or recording the results.")
+	scrubCmd.Flags().BoolVar(
+		&scrubCfg.Report, "report", false,
+		"List every key with a problem, as part of the printed report.")
+	scrubCmd.Flags().IntVar(
+		&scrubCfg.MaxProblems, "max-problems", scrub.DefaultMaxProblems,
+		"Maximum amount of keys with a problem listed in the report, use a negative value to list all.")
+}
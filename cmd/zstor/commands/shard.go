@@ -0,0 +1,247 @@
+/*
+ * Copyright (C) 2017-2018 GIG Technology NV and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/threefoldtech/0-stor/client/datastor"
+	"github.com/threefoldtech/0-stor/client/drain"
+
+	"github.com/spf13/cobra"
+)
+
+// shardCmd represents the namespace for all shard subcommands
+var shardCmd = &cobra.Command{
+	Use:   "shard",
+	Short: "Manage the 0-db shards of the cluster.",
+}
+
+// shardDrainCmd represents the shard-drain command
+var shardDrainCmd = &cobra.Command{
+	Use:   "drain <address>",
+	Short: "Move all objects from a 0-db shard to the other shards.",
+	Long: "Move all objects referenced by the metadata of the namespace, " +
+		"from the 0-db shard with the given address to the other shards of the cluster, " +
+		"such that the shard can be decommissioned.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		metaCli, err := getMetaClient()
+		if err != nil {
+			return err
+		}
+		defer metaCli.Close()
+
+		cluster, err := getDataCluster()
+		if err != nil {
+			return err
+		}
+		defer cluster.Close()
+
+		// moved objects are spread over the failure domains just like the chunk storage does
+		clientCfg, err := getClientConfig()
+		if err != nil {
+			return err
+		}
+		shardDrainCfg.MaxPerDomain = clientCfg.DataStor.Pipeline.Distribution.MaxShardsPerDomain()
+
+		// the shard can be given by its address or identifier,
+		// and is looked up regardless of its health
+		shardID := args[0]
+		if listed, ok := cluster.(datastor.ListedCluster); ok {
+			for _, health := range cluster.GetShardHealth() {
+				shard, err := listed.GetListedShard(health.Shard)
+				if err == nil && shard.Address() == args[0] {
+					shardID = shard.Identifier()
+					break
+				}
+			}
+		}
+
+		// stop draining when interrupted
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGINT)
+		go func() {
+			select {
+			case <-sigChan:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		report, err := drain.Drain(ctx, metaCli, cluster, shardID, shardDrainCfg.Config)
+		if report != nil {
+			writeDrainReport(os.Stdout, report, shardDrainCfg.Report)
+		}
+		if err != nil {
+			return fmt.Errorf("drain failed: %v", err)
+		}
+		if len(report.Failures) > 0 {
+			return fmt.Errorf("drain failed for %d metadata, drain the shard again to retry",
+				len(report.Failures))
+		}
+		return nil
+	},
+}
+
+var shardDrainCfg struct {
+	drain.Config
+	Report bool
+}
+
+// shardRebalanceCmd represents the shard-rebalance command
+var shardRebalanceCmd = &cobra.Command{
+	Use:   "rebalance",
+	Short: "Move objects from over-full 0-db shards to under-used ones.",
+	Long: "Move objects referenced by the metadata of the namespace, " +
+		"from the 0-db shards which use more than their share of the total data, " +
+		"to the shards which use less, such as shards which were just added to the cluster.",
+	Args: cobra.ExactArgs(0),
+	RunE: func(_cmd *cobra.Command, args []string) error {
+		metaCli, err := getMetaClient()
+		if err != nil {
+			return err
+		}
+		defer metaCli.Close()
+
+		cluster, err := getDataCluster()
+		if err != nil {
+			return err
+		}
+		defer cluster.Close()
+
+		// moved objects are spread over the failure domains just like the chunk storage does
+		clientCfg, err := getClientConfig()
+		if err != nil {
+			return err
+		}
+		shardRebalanceCfg.MaxPerDomain = clientCfg.DataStor.Pipeline.Distribution.MaxShardsPerDomain()
+
+		// stop rebalancing when interrupted
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGINT)
+		go func() {
+			select {
+			case <-sigChan:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		report, err := drain.Rebalance(ctx, metaCli, cluster, shardRebalanceCfg.RebalanceConfig)
+		if report != nil {
+			writeRebalanceReport(os.Stdout, report, shardRebalanceCfg.Report)
+		}
+		if err != nil {
+			return fmt.Errorf("rebalance failed: %v", err)
+		}
+		if len(report.Failures) > 0 {
+			return fmt.Errorf("rebalance failed for %d metadata, rebalance again to retry",
+				len(report.Failures))
+		}
+		return nil
+	},
+}
+
+var shardRebalanceCfg struct {
+	drain.RebalanceConfig
+	Report bool
+}
+
+func writeDrainReport(w io.Writer, report *drain.Report, listFailures bool) {
+	if report.DryRun {
+		fmt.Fprintln(w, "dry run: no objects were moved, and no metadata was updated")
+	}
+	fmt.Fprintf(w, "shard: %s\n", report.Shard)
+	fmt.Fprintf(w, "metadata: %d scanned, %d drained, %d failed\n",
+		report.Scanned, report.Drained, len(report.Failures))
+	fmt.Fprintf(w, "moved objects: %d (%d bytes)\n", report.MovedObjects, report.MovedBytes)
+
+	if !listFailures {
+		return
+	}
+	for _, failure := range report.Failures {
+		fmt.Fprintf(w, "  %s: %v\n", failure.Metadata, failure.Error)
+	}
+}
+
+func writeRebalanceReport(w io.Writer, report *drain.RebalanceReport, listFailures bool) {
+	if report.DryRun {
+		fmt.Fprintln(w, "dry run: no objects were moved, and no metadata was updated")
+	}
+	fmt.Fprintln(w, "shards (used, target, balanced bytes):")
+	for _, usage := range report.Shards {
+		fmt.Fprintf(w, "  %s: %d, %d, %d\n", usage.Shard, usage.Used, usage.Target, usage.Balanced)
+	}
+	fmt.Fprintf(w, "metadata: %d scanned, %d updated, %d failed\n",
+		report.Scanned, report.Drained, len(report.Failures))
+	fmt.Fprintf(w, "moved objects: %d (%d bytes), %d deleted from their original shard\n",
+		report.MovedObjects, report.MovedBytes, report.DeletedObjects)
+
+	if !listFailures {
+		return
+	}
+	for _, failure := range report.Failures {
+		fmt.Fprintf(w, "  %s: %v\n", failure.Metadata, failure.Error)
+	}
+}
+
+func init() {
+	shardCmd.AddCommand(
+		shardDrainCmd,
+		shardRebalanceCmd,
+	)
+
+	shardDrainCmd.Flags().DurationVar(
+		&shardDrainCfg.ProgressInterval, "progress-interval", time.Minute,
+		"Interval at which the progress is logged, use zero to disable.")
+	shardDrainCmd.Flags().BoolVar(
+		&shardDrainCfg.DryRun, "dry-run", false,
+		"Collect the objects stored on the shard, without moving them.")
+	shardDrainCmd.Flags().IntVar(
+		&shardDrainCfg.BytesPerSecond, "bytes-per-second", 0,
+		"Maximum amount of object data moved per second, unlimited by default.")
+	shardDrainCmd.Flags().BoolVar(
+		&shardDrainCfg.Report, "report", false,
+		"List all metadata which couldn't be drained, as part of the printed report.")
+
+	shardRebalanceCmd.Flags().Float64Var(
+		&shardRebalanceCfg.Tolerance, "tolerance", drain.DefaultTolerance,
+		"Fraction by which a shard can exceed its target utilization, before objects are moved away from it.")
+	shardRebalanceCmd.Flags().IntVar(
+		&shardRebalanceCfg.BytesPerSecond, "bytes-per-second", 0,
+		"Maximum amount of object data moved per second, unlimited by default.")
+	shardRebalanceCmd.Flags().DurationVar(
+		&shardRebalanceCfg.ProgressInterval, "progress-interval", time.Minute,
+		"Interval at which the progress is logged, use zero to disable.")
+	shardRebalanceCmd.Flags().BoolVar(
+		&shardRebalanceCfg.DryRun, "dry-run", false,
+		"Plan which objects would be moved, without moving them.")
+	shardRebalanceCmd.Flags().BoolVar(
+		&shardRebalanceCfg.Report, "report", false,
+		"List all metadata which couldn't be updated, as part of the printed report.")
+}
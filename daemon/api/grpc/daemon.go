@@ -17,23 +17,20 @@
 package grpc
 
 import (
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	"errors"
-	"fmt"
 	"io"
-	"io/ioutil"
 	"net"
 	"strings"
 
 	"github.com/threefoldtech/0-stor/client"
 	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
-	"github.com/threefoldtech/0-stor/client/datastor/zerodb"
 	"github.com/threefoldtech/0-stor/client/metastor"
 	db_utils "github.com/threefoldtech/0-stor/client/metastor/db/utils"
 	"github.com/threefoldtech/0-stor/client/metastor/encoding"
 	"github.com/threefoldtech/0-stor/client/processing"
+	"github.com/threefoldtech/0-stor/client/scrub"
 	"github.com/threefoldtech/0-stor/daemon"
 	"github.com/threefoldtech/0-stor/daemon/api"
 	pb "github.com/threefoldtech/0-stor/daemon/api/grpc/schema"
@@ -50,6 +47,9 @@ type Daemon struct {
 	closer     interface {
 		Close() error
 	}
+
+	// stops the scrubber and waits until it stopped
+	stopScrubber func()
 }
 
 // Config is used to configure a GRPC daemon manually.
@@ -60,12 +60,24 @@ type Config struct {
 
 	MaxMsgSize           int // size in MiB
 	DisableLocalFSAccess bool
+
+	// optional overwrite config, used by the file service
+	Overwrite client.OverwriteConfig
+	// optional deduplication of chunks, used by the file service
+	Deduplication bool
+	// optional scrubber config, scrubbing all keys in the background
+	Scrub *scrub.Config
+	// optional cluster used by the pipeline, used to report the health of its shards
+	Cluster datastor.Cluster
 }
 
 func (cfg *Config) validateAndSanitize() error {
 	if cfg.Pipeline == nil {
 		return errors.New("no pipeline given, while one is required")
 	}
+	if cfg.Scrub != nil && cfg.MetaClient == nil {
+		return errors.New("no metastor client given, while one is required for scrubbing")
+	}
 
 	if cfg.MaxMsgSize <= 0 {
 		cfg.MaxMsgSize = DefaultMaxMsgSize
@@ -81,7 +93,7 @@ const (
 // NewFromConfig creates new daemon with given Config.
 func NewFromConfig(cfg daemon.Config, maxMsgSize, jobCount int, disableLocalFSAccess bool) (*Daemon, error) {
 	// create data stor cluster
-	cluster, err := createDataClusterFromConfig(&cfg)
+	cluster, err := client.NewDataCluster(cfg.Config)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +118,10 @@ func NewFromConfig(cfg daemon.Config, maxMsgSize, jobCount int, disableLocalFSAc
 		MetaClient:           metastorClient,
 		MaxMsgSize:           maxMsgSize,
 		DisableLocalFSAccess: disableLocalFSAccess,
+		Overwrite:            cfg.Overwrite,
+		Deduplication:        cfg.Deduplication || cfg.DataStor.KeyMode == datastor.KeyModeUser,
+		Scrub:                cfg.Scrub,
+		Cluster:              cluster,
 	})
 }
 
@@ -152,51 +168,6 @@ func createMetastorClientFromConfig(namespace string, cfg *daemon.MetaStorConfig
 	return metastor.NewClientFromConfig([]byte(namespace), config)
 }
 
-func createDataClusterFromConfig(cfg *daemon.Config) (datastor.Cluster, error) {
-	// optionally create the global datastor TLS config
-	tlsConfig, err := createTLSConfigFromDatastorTLSConfig(&cfg.DataStor.TLS)
-	if err != nil {
-		return nil, err
-	}
-
-	return zerodb.NewCluster(cfg.DataStor.Shards, cfg.Password, cfg.Namespace, tlsConfig, cfg.DataStor.Spreading)
-}
-
-func createTLSConfigFromDatastorTLSConfig(config *client.DataStorTLSConfig) (*tls.Config, error) {
-	if config == nil || !config.Enabled {
-		return nil, nil
-	}
-	tlsConfig := new(tls.Config)
-
-	if config.ServerName != "" {
-		tlsConfig.ServerName = config.ServerName
-	} else {
-		log.Warning("TLS is configured to skip verificaitons of certs, " +
-			"making the client susceptible to man-in-the-middle attacks!!!")
-		tlsConfig.InsecureSkipVerify = true
-	}
-
-	if config.RootCA == "" {
-		var err error
-		tlsConfig.RootCAs, err = x509.SystemCertPool()
-		if err != nil {
-			return nil, fmt.Errorf("failed to create datastor TLS config: %v", err)
-		}
-	} else {
-		tlsConfig.RootCAs = x509.NewCertPool()
-		caFile, err := ioutil.ReadFile(config.RootCA)
-		if err != nil {
-			return nil, err
-		}
-		if !tlsConfig.RootCAs.AppendCertsFromPEM(caFile) {
-			return nil, fmt.Errorf("error reading CA file '%s', while creating datastor TLS config: %v",
-				config.RootCA, err)
-		}
-	}
-
-	return tlsConfig, nil
-}
-
 // New creates new daemon with given Config.
 func New(cfg Config) (*Daemon, error) {
 	// validate our config and sanitize its properties
@@ -217,7 +188,10 @@ func New(cfg Config) (*Daemon, error) {
 		grpc.MaxSendMsgSize(maxMsgSize),
 	)
 
-	var closer io.Closer
+	var (
+		closer       io.Closer
+		stopScrubber func()
+	)
 
 	if cfg.MetaClient != nil {
 		// register the metadata service
@@ -225,18 +199,57 @@ func New(cfg Config) (*Daemon, error) {
 
 		// create the master 0-stor client, so we can create the file service
 		client := client.NewClient(cfg.MetaClient, cfg.Pipeline)
+		err = client.SetOverwriteConfig(cfg.Overwrite)
+		if err != nil {
+			return nil, err
+		}
+		err = client.SetDeduplication(cfg.Deduplication)
+		if err != nil {
+			return nil, err
+		}
 		pb.RegisterFileServiceServer(grpcServer, newFileService(client, cfg.MetaClient, cfg.DisableLocalFSAccess))
 
+		if cfg.Scrub != nil {
+			stopScrubber, err = startScrubber(client, cfg.MetaClient, *cfg.Scrub)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		closer = client
 	}
 
 	// register the data pipeline service
-	pb.RegisterDataServiceServer(grpcServer, newDataService(cfg.Pipeline, cfg.DisableLocalFSAccess))
+	pb.RegisterDataServiceServer(grpcServer, newDataService(cfg.Pipeline, cfg.Cluster, cfg.DisableLocalFSAccess))
 
 	// return our daemon ready for usage
 	return &Daemon{
-		grpcServer: grpcServer,
-		closer:     closer,
+		grpcServer:   grpcServer,
+		closer:       closer,
+		stopScrubber: stopScrubber,
+	}, nil
+}
+
+// startScrubber starts scrubbing all keys in the background,
+// returning a function which stops the scrubber and waits until it stopped.
+func startScrubber(c *client.Client, metaClient *metastor.Client, cfg scrub.Config) (func(), error) {
+	scrubber, err := scrub.New(c, metaClient, cfg)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		log.Infof("scrubbing all keys in the background (deep: %v)", cfg.Deep)
+		err := scrubber.Run(ctx)
+		if err != nil {
+			log.Errorf("scrubber stopped: %v", err)
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
 	}, nil
 }
 
@@ -253,6 +266,10 @@ func (d *Daemon) Serve(lis net.Listener) error {
 func (d *Daemon) Close() error {
 	log.Debugln("stop grpc daemon server and all its active listeners")
 	d.grpcServer.GracefulStop()
+	if d.stopScrubber != nil {
+		log.Debugln("stop scrubber")
+		d.stopScrubber()
+	}
 	log.Debugln("closing internal resources")
 	if d.closer != nil {
 		return d.closer.Close()
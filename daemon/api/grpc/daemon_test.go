@@ -21,6 +21,7 @@ import (
 
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
 	"github.com/threefoldtech/0-stor/client/metastor"
+	"github.com/threefoldtech/0-stor/client/scrub"
 
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +37,11 @@ func TestConfig_ValidateAndSanitize(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, DefaultMaxMsgSize, cfg.MaxMsgSize)
 
+	// scrubbing requires a metastor client
+	cfg.Scrub = new(scrub.Config)
+	err = cfg.validateAndSanitize()
+	require.Error(t, err)
+
 	cfg.MetaClient = new(metastor.Client)
 	cfg.MaxMsgSize = 0
 
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
 	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
@@ -31,9 +32,10 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-func newDataService(client dataClient, disableLocalFSAccess bool) *dataService {
+func newDataService(client dataClient, cluster datastor.Cluster, disableLocalFSAccess bool) *dataService {
 	return &dataService{
 		client:               client,
+		cluster:              cluster,
 		disableLocalFSAccess: disableLocalFSAccess,
 	}
 }
@@ -44,6 +46,7 @@ func newDataService(client dataClient, disableLocalFSAccess bool) *dataService {
 // as that metadata is required to read and manage the data, later on.
 type dataService struct {
 	client               dataClient
+	cluster              datastor.Cluster // optional
 	disableLocalFSAccess bool
 }
 
@@ -55,7 +58,7 @@ func (service *dataService) Write(ctx context.Context, req *pb.DataWriteRequest)
 	}
 
 	// write the given data
-	chunks, err := service.client.Write(bytes.NewReader(data))
+	chunks, err := service.client.WriteContext(ctx, bytes.NewReader(data))
 	if err != nil {
 		return nil, mapDataStorError(err)
 	}
@@ -95,7 +98,7 @@ func (service *dataService) WriteFile(ctx context.Context, req *pb.DataWriteFile
 	}()
 
 	// write the file data
-	chunks, err := service.client.Write(file)
+	chunks, err := service.client.WriteContext(ctx, file)
 	if err != nil {
 		return nil, mapDataStorError(err)
 	}
@@ -114,7 +117,7 @@ func (service *dataService) WriteStream(stream pb.DataService_WriteStreamServer)
 	var chunks []metatypes.Chunk
 	group.Go(func() error {
 		var err error
-		chunks, err = service.client.Write(reader)
+		chunks, err = service.client.WriteContext(ctx, reader)
 		return mapDataStorError(err)
 	})
 
@@ -181,7 +184,7 @@ func (service *dataService) Read(ctx context.Context, req *pb.DataReadRequest) (
 
 	buf := bytes.NewBuffer(nil)
 	imChunks := convertProtoToInMemoryChunkSlice(chunks)
-	err := service.client.Read(imChunks, buf)
+	err := service.client.ReadContext(ctx, imChunks, buf)
 	if err != nil {
 		return nil, mapDataStorError(err)
 	}
@@ -224,7 +227,7 @@ func (service *dataService) ReadFile(ctx context.Context, req *pb.DataReadFileRe
 	}()
 
 	imChunks := convertProtoToInMemoryChunkSlice(chunks)
-	err = service.client.Read(imChunks, file)
+	err = service.client.ReadContext(ctx, imChunks, file)
 	if err != nil {
 		return nil, mapDataStorError(err)
 	}
@@ -259,7 +262,7 @@ func (service *dataService) ReadStream(req *pb.DataReadStreamRequest, stream pb.
 			}
 		}()
 		imChunks := convertProtoToInMemoryChunkSlice(chunks)
-		err = service.client.Read(imChunks, writer)
+		err = service.client.ReadContext(ctx, imChunks, writer)
 		if err != nil {
 			return mapDataStorError(err)
 		}
@@ -300,7 +303,7 @@ func (service *dataService) Delete(ctx context.Context, req *pb.DataDeleteReques
 	}
 
 	imChunks := convertProtoToInMemoryChunkSlice(chunks)
-	err := service.client.Delete(imChunks)
+	err := service.client.DeleteContext(ctx, imChunks)
 	if err != nil {
 		return nil, mapDataStorError(err)
 	}
@@ -315,7 +318,7 @@ func (service *dataService) Check(ctx context.Context, req *pb.DataCheckRequest)
 	}
 
 	imChunks := convertProtoToInMemoryChunkSlice(chunks)
-	status, err := service.client.Check(imChunks, req.GetFast())
+	status, err := service.client.CheckContext(ctx, imChunks, req.GetFast())
 	if err != nil {
 		return nil, mapDataStorError(err)
 	}
@@ -331,7 +334,7 @@ func (service *dataService) Repair(ctx context.Context, req *pb.DataRepairReques
 	}
 
 	imChunks := convertProtoToInMemoryChunkSlice(chunks)
-	imChunks, err := service.client.Repair(imChunks)
+	imChunks, err := service.client.RepairContext(ctx, imChunks)
 	if err != nil {
 		return nil, mapDataStorError(err)
 	}
@@ -339,12 +342,25 @@ func (service *dataService) Repair(ctx context.Context, req *pb.DataRepairReques
 	return &pb.DataRepairResponse{Chunks: protoChunks}, nil
 }
 
+// ShardHealth implements DataServiceServer.ShardHealth
+func (service *dataService) ShardHealth(ctx context.Context, req *pb.DataShardHealthRequest) (*pb.DataShardHealthResponse, error) {
+	if service.cluster == nil {
+		return nil, rpctypes.ErrGRPCNotSupported
+	}
+	health := service.cluster.GetShardHealth()
+	shards := make([]*pb.ShardHealth, len(health))
+	for i, shard := range health {
+		shards[i] = convertShardHealthToProto(shard)
+	}
+	return &pb.DataShardHealthResponse{Shards: shards}, nil
+}
+
 type dataClient interface {
-	Write(r io.Reader) ([]metatypes.Chunk, error)
-	Read(chunks []metatypes.Chunk, w io.Writer) error
-	Delete(chunks []metatypes.Chunk) error
-	Check(chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error)
-	Repair(chunks []metatypes.Chunk) ([]metatypes.Chunk, error)
+	WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error)
+	ReadContext(ctx context.Context, chunks []metatypes.Chunk, w io.Writer) error
+	DeleteContext(ctx context.Context, chunks []metatypes.Chunk) error
+	CheckContext(ctx context.Context, chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error)
+	RepairContext(ctx context.Context, chunks []metatypes.Chunk) ([]metatypes.Chunk, error)
 }
 
 var (
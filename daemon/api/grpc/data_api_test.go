@@ -20,7 +20,9 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"time"
 
+	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
 	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
 	"github.com/threefoldtech/0-stor/daemon/api/grpc/rpctypes"
@@ -31,7 +33,7 @@ import (
 )
 
 func TestDataService_Write(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Write(context.Background(),
 		&pb.DataWriteRequest{Data: []byte("data")})
@@ -39,21 +41,21 @@ func TestDataService_Write(t *testing.T) {
 }
 
 func TestDataService_WriteError(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Write(context.Background(),
 		&pb.DataWriteRequest{Data: nil})
 	require.Equal(t, rpctypes.ErrGRPCNilData, err)
 
 	// client errors should propagate, iff those code paths hit
-	dSrv = newDataService(dataErrorClient{}, false)
+	dSrv = newDataService(dataErrorClient{}, nil, false)
 	_, err = dSrv.Write(context.Background(),
 		&pb.DataWriteRequest{Data: []byte("data")})
 	require.Equal(t, errFooDataClient, err)
 }
 
 func TestDataService_WriteFile(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.WriteFile(context.Background(),
 		&pb.DataWriteFileRequest{FilePath: "foo"})
@@ -61,7 +63,7 @@ func TestDataService_WriteFile(t *testing.T) {
 }
 
 func TestDataService_WriteFileError(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.WriteFile(context.Background(),
 		&pb.DataWriteFileRequest{FilePath: ""})
@@ -73,14 +75,14 @@ func TestDataService_WriteFileError(t *testing.T) {
 	require.Equal(t, rpctypes.ErrGRPCNoLocalFS, err)
 
 	// client errors should propagate, iff those code paths hit
-	dSrv = newDataService(dataErrorClient{}, false)
+	dSrv = newDataService(dataErrorClient{}, nil, false)
 	_, err = dSrv.WriteFile(context.Background(),
 		&pb.DataWriteFileRequest{FilePath: "foo"})
 	require.Equal(t, errFooDataClient, err)
 }
 
 func TestDataService_Read(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Read(context.Background(),
 		&pb.DataReadRequest{Chunks: []*pb.Chunk{nil}})
@@ -88,21 +90,21 @@ func TestDataService_Read(t *testing.T) {
 }
 
 func TestDataService_ReadError(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Read(context.Background(),
 		&pb.DataReadRequest{Chunks: nil})
 	require.Equal(t, rpctypes.ErrGRPCNilChunks, err)
 
 	// client errors should propagate, iff those code paths hit
-	dSrv = newDataService(dataErrorClient{}, false)
+	dSrv = newDataService(dataErrorClient{}, nil, false)
 	_, err = dSrv.Read(context.Background(),
 		&pb.DataReadRequest{Chunks: []*pb.Chunk{nil}})
 	require.Equal(t, errFooDataClient, err)
 }
 
 func TestDataService_ReadFile(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.ReadFile(context.Background(),
 		&pb.DataReadFileRequest{Chunks: []*pb.Chunk{nil}, FilePath: "foo"})
@@ -110,7 +112,7 @@ func TestDataService_ReadFile(t *testing.T) {
 }
 
 func TestDataService_ReadFileError(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.ReadFile(context.Background(),
 		&pb.DataReadFileRequest{Chunks: nil, FilePath: "foo"})
@@ -126,14 +128,14 @@ func TestDataService_ReadFileError(t *testing.T) {
 	require.Equal(t, rpctypes.ErrGRPCNoLocalFS, err)
 
 	// client errors should propagate, iff those code paths hit
-	dSrv = newDataService(dataErrorClient{}, false)
+	dSrv = newDataService(dataErrorClient{}, nil, false)
 	_, err = dSrv.ReadFile(context.Background(),
 		&pb.DataReadFileRequest{Chunks: []*pb.Chunk{nil}, FilePath: "foo"})
 	require.Equal(t, errFooDataClient, err)
 }
 
 func TestDataService_Delete(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Delete(context.Background(),
 		&pb.DataDeleteRequest{Chunks: []*pb.Chunk{nil}})
@@ -141,21 +143,21 @@ func TestDataService_Delete(t *testing.T) {
 }
 
 func TestDataService_DeleteError(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Delete(context.Background(),
 		&pb.DataDeleteRequest{Chunks: nil})
 	require.Equal(t, rpctypes.ErrGRPCNilChunks, err)
 
 	// client errors should propagate, iff those code paths hit
-	dSrv = newDataService(dataErrorClient{}, false)
+	dSrv = newDataService(dataErrorClient{}, nil, false)
 	_, err = dSrv.Delete(context.Background(),
 		&pb.DataDeleteRequest{Chunks: []*pb.Chunk{nil}})
 	require.Equal(t, errFooDataClient, err)
 }
 
 func TestDataService_Check(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Check(context.Background(),
 		&pb.DataCheckRequest{Chunks: []*pb.Chunk{nil}})
@@ -163,54 +165,110 @@ func TestDataService_Check(t *testing.T) {
 }
 
 func TestDataService_CheckError(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Check(context.Background(),
 		&pb.DataCheckRequest{Chunks: nil})
 	require.Equal(t, rpctypes.ErrGRPCNilChunks, err)
 
 	// client errors should propagate, iff those code paths hit
-	dSrv = newDataService(dataErrorClient{}, false)
+	dSrv = newDataService(dataErrorClient{}, nil, false)
 	_, err = dSrv.Check(context.Background(),
 		&pb.DataCheckRequest{Chunks: []*pb.Chunk{nil}})
 	require.Equal(t, errFooDataClient, err)
 }
 
 func TestDataService_Repair(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Repair(context.Background(), &pb.DataRepairRequest{Chunks: []*pb.Chunk{nil}})
 	require.NoError(t, err)
 }
 
 func TestDataService_RepairError(t *testing.T) {
-	dSrv := newDataService(&dataClientStub{}, false)
+	dSrv := newDataService(&dataClientStub{}, nil, false)
 
 	_, err := dSrv.Repair(context.Background(), &pb.DataRepairRequest{Chunks: nil})
 	require.Equal(t, rpctypes.ErrGRPCNilChunks, err)
 
 	// client errors should propagate, iff those code paths hit
-	dSrv = newDataService(dataErrorClient{}, false)
+	dSrv = newDataService(dataErrorClient{}, nil, false)
 	_, err = dSrv.Repair(context.Background(), &pb.DataRepairRequest{Chunks: []*pb.Chunk{nil}})
 	require.Equal(t, errFooDataClient, err)
 }
 
+func TestDataService_ShardHealth(t *testing.T) {
+	require := require.New(t)
+
+	// the health can only be reported when the cluster is known
+	dSrv := newDataService(&dataClientStub{}, nil, false)
+	_, err := dSrv.ShardHealth(context.Background(), &pb.DataShardHealthRequest{})
+	require.Equal(rpctypes.ErrGRPCNotSupported, err)
+
+	since := time.Now()
+	dSrv = newDataService(&dataClientStub{}, clusterHealthStub{
+		{Shard: "ns@a", Since: since, Latency: time.Millisecond},
+		{
+			Shard:         "ns@b",
+			State:         datastor.ShardStateUnhealthy,
+			Since:         since,
+			Failures:      3,
+			LastError:     errors.New("connection refused"),
+			LastErrorTime: since,
+			Namespace:     &datastor.Health{DataIOErrors: 2},
+		},
+	}, false)
+	resp, err := dSrv.ShardHealth(context.Background(), &pb.DataShardHealthRequest{})
+	require.NoError(err)
+	require.Equal([]*pb.ShardHealth{
+		{
+			Shard:   "ns@a",
+			State:   pb.ShardStateHealthy,
+			Since:   since.UnixNano(),
+			Latency: int64(time.Millisecond),
+		},
+		{
+			Shard:         "ns@b",
+			State:         pb.ShardStateUnhealthy,
+			Since:         since.UnixNano(),
+			Failures:      3,
+			LastError:     "connection refused",
+			LastErrorTime: since.UnixNano(),
+			Namespace:     &pb.NamespaceHealth{DataIOErrors: 2},
+		},
+	}, resp.GetShards())
+}
+
+// clusterHealthStub is a cluster which only reports the health of its shards
+type clusterHealthStub []datastor.ShardHealth
+
+func (stub clusterHealthStub) GetShard(id string) (datastor.Shard, error) { panic("stub::GetShard") }
+func (stub clusterHealthStub) GetRandomShard() (datastor.Shard, error) {
+	panic("stub::GetRandomShard")
+}
+func (stub clusterHealthStub) GetShardIterator(exceptShards []string) datastor.ShardIterator {
+	panic("stub::GetShardIterator")
+}
+func (stub clusterHealthStub) ListedShardCount() int                  { return len(stub) }
+func (stub clusterHealthStub) GetShardHealth() []datastor.ShardHealth { return stub }
+func (stub clusterHealthStub) Close() error                           { return nil }
+
 type dataClientStub struct{}
 
-func (stub dataClientStub) Write(r io.Reader) ([]metatypes.Chunk, error) {
+func (stub dataClientStub) WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error) {
 	return nil, nil
 }
-func (stub dataClientStub) Read(chunks []metatypes.Chunk, w io.Writer) error {
+func (stub dataClientStub) ReadContext(ctx context.Context, chunks []metatypes.Chunk, w io.Writer) error {
 	_, err := w.Write([]byte("hello"))
 	return err
 }
-func (stub dataClientStub) Delete(chunks []metatypes.Chunk) error {
+func (stub dataClientStub) DeleteContext(ctx context.Context, chunks []metatypes.Chunk) error {
 	return nil
 }
-func (stub dataClientStub) Check(chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
+func (stub dataClientStub) CheckContext(ctx context.Context, chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
 	return 0, nil
 }
-func (stub dataClientStub) Repair(chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
+func (stub dataClientStub) RepairContext(ctx context.Context, chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
 	return nil, nil
 }
 
@@ -218,23 +276,25 @@ var errFooDataClient = errors.New("dataErrorClient: foo")
 
 type dataErrorClient struct{}
 
-func (stub dataErrorClient) Write(r io.Reader) ([]metatypes.Chunk, error) {
+func (stub dataErrorClient) WriteContext(ctx context.Context, r io.Reader) ([]metatypes.Chunk, error) {
 	return nil, errFooDataClient
 }
-func (stub dataErrorClient) Read(chunks []metatypes.Chunk, w io.Writer) error {
+func (stub dataErrorClient) ReadContext(ctx context.Context, chunks []metatypes.Chunk, w io.Writer) error {
 	return errFooDataClient
 }
-func (stub dataErrorClient) Delete(chunks []metatypes.Chunk) error {
+func (stub dataErrorClient) DeleteContext(ctx context.Context, chunks []metatypes.Chunk) error {
 	return errFooDataClient
 }
-func (stub dataErrorClient) Check(chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
+func (stub dataErrorClient) CheckContext(ctx context.Context, chunks []metatypes.Chunk, fast bool) (storage.CheckStatus, error) {
 	return 0, errFooDataClient
 }
-func (stub dataErrorClient) Repair(chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
+func (stub dataErrorClient) RepairContext(ctx context.Context, chunks []metatypes.Chunk) ([]metatypes.Chunk, error) {
 	return nil, errFooDataClient
 }
 
 var (
 	_ dataClient = dataClientStub{}
 	_ dataClient = dataErrorClient{}
+
+	_ datastor.Cluster = clusterHealthStub{}
 )
@@ -60,7 +60,7 @@ func (service *fileService) Write(ctx context.Context, req *pb.WriteRequest) (*p
 		return nil, rpctypes.ErrGRPCNilData
 	}
 
-	metadata, err := service.client.Write(key, bytes.NewReader(data))
+	metadata, err := service.client.WriteContext(ctx, key, bytes.NewReader(data))
 	if err != nil {
 		return nil, mapZstorError(err)
 	}
@@ -106,7 +106,7 @@ func (service *fileService) WriteFile(ctx context.Context, req *pb.WriteFileRequ
 	}()
 
 	// write directly from the file
-	metadata, err := service.client.Write(key, file)
+	metadata, err := service.client.WriteContext(ctx, key, file)
 	if err != nil {
 		return nil, mapZstorError(err)
 	}
@@ -136,7 +136,7 @@ func (service *fileService) WriteStream(stream pb.FileService_WriteStreamServer)
 	var metadata *metatypes.Metadata
 	group.Go(func() error {
 		var err error
-		metadata, err = service.client.Write(key, reader)
+		metadata, err = service.client.WriteContext(ctx, key, reader)
 		return mapZstorError(err)
 	})
 
@@ -194,6 +194,28 @@ func (service *fileService) WriteStream(stream pb.FileService_WriteStreamServer)
 	})
 }
 
+// Append implements FileServiceServer.Append
+func (service *fileService) Append(ctx context.Context, req *pb.AppendRequest) (*pb.AppendResponse, error) {
+	key := req.GetKey()
+	if len(key) == 0 {
+		return nil, rpctypes.ErrGRPCNilKey
+	}
+	data := req.GetData()
+	if len(data) == 0 {
+		return nil, rpctypes.ErrGRPCNilData
+	}
+
+	metadata, err := service.client.AppendContext(ctx, key, bytes.NewReader(data))
+	if err != nil {
+		return nil, mapZstorError(err)
+	}
+
+	output := convertInMemoryToProtoMetadata(*metadata)
+	return &pb.AppendResponse{
+		Metadata: output,
+	}, nil
+}
+
 // Read implements FileServiceServer.Read
 func (service *fileService) Read(ctx context.Context, req *pb.ReadRequest) (*pb.ReadResponse, error) {
 	var (
@@ -211,13 +233,13 @@ func (service *fileService) Read(ctx context.Context, req *pb.ReadRequest) (*pb.
 		if err != nil {
 			return nil, mapZstorError(err)
 		}
-		err = service.client.Read(*metadata, buf)
+		err = service.client.ReadContext(ctx, *metadata, buf)
 	case *pb.ReadRequest_Metadata:
 		if v.Metadata == nil {
 			return nil, rpctypes.ErrGRPCNilMetadata
 		}
 		metadata := convertProtoToInMemoryMetadata(v.Metadata)
-		err = service.client.Read(metadata, buf)
+		err = service.client.ReadContext(ctx, metadata, buf)
 	default:
 		// if no key or metadata is given,
 		// we'll simply assume that a key is forgotten,
@@ -271,13 +293,13 @@ func (service *fileService) ReadFile(ctx context.Context, req *pb.ReadFileReques
 		if err != nil {
 			return nil, mapZstorError(err)
 		}
-		err = service.client.Read(*metadata, file)
+		err = service.client.ReadContext(ctx, *metadata, file)
 	case *pb.ReadFileRequest_Metadata:
 		if v.Metadata == nil {
 			return nil, rpctypes.ErrGRPCNilMetadata
 		}
 		metadata := convertProtoToInMemoryMetadata(v.Metadata)
-		err = service.client.Read(metadata, file)
+		err = service.client.ReadContext(ctx, metadata, file)
 	default:
 		// if no key or metadata is given,
 		// we'll simply assume that a key is forgotten,
@@ -323,14 +345,14 @@ func (service *fileService) ReadStream(req *pb.ReadStreamRequest, stream pb.File
 			if err != nil {
 				return mapZstorError(err)
 			}
-			return service.client.Read(*metadata, writer)
+			return service.client.ReadContext(ctx, *metadata, writer)
 
 		case *pb.ReadStreamRequest_Metadata:
 			if v.Metadata == nil {
 				return rpctypes.ErrGRPCNilMetadata
 			}
 			metadata := convertProtoToInMemoryMetadata(v.Metadata)
-			return service.client.Read(metadata, writer)
+			return service.client.ReadContext(ctx, metadata, writer)
 
 		default:
 			// if no key or metadata is given,
@@ -383,13 +405,13 @@ func (service *fileService) Delete(ctx context.Context, req *pb.DeleteRequest) (
 		if err != nil {
 			return nil, mapZstorError(err)
 		}
-		err = service.client.Delete(*metadata)
+		err = service.client.DeleteContext(ctx, *metadata)
 	case *pb.DeleteRequest_Metadata:
 		if v.Metadata == nil {
 			return nil, rpctypes.ErrGRPCNilMetadata
 		}
 		metadata := convertProtoToInMemoryMetadata(v.Metadata)
-		err = service.client.Delete(metadata)
+		err = service.client.DeleteContext(ctx, metadata)
 	default:
 		// if no key or metadata is given,
 		// we'll simply assume that a key is forgotten,
@@ -420,13 +442,13 @@ func (service *fileService) Check(ctx context.Context, req *pb.CheckRequest) (*p
 		if err != nil {
 			return nil, mapZstorError(err)
 		}
-		status, err = service.client.Check(*metadata, fast)
+		status, err = service.client.CheckContext(ctx, *metadata, fast)
 	case *pb.CheckRequest_Metadata:
 		if v.Metadata == nil {
 			return nil, rpctypes.ErrGRPCNilMetadata
 		}
 		metadata := convertProtoToInMemoryMetadata(v.Metadata)
-		status, err = service.client.Check(metadata, fast)
+		status, err = service.client.CheckContext(ctx, metadata, fast)
 	default:
 		// if no key or metadata is given,
 		// we'll simply assume that a key is forgotten,
@@ -450,7 +472,7 @@ func (service *fileService) Repair(ctx context.Context, req *pb.RepairRequest) (
 		return nil, mapZstorError(err)
 	}
 
-	metadata, err = service.client.Repair(*metadata)
+	metadata, err = service.client.RepairContext(ctx, *metadata)
 	if err != nil {
 		return nil, mapZstorError(err)
 	}
@@ -459,12 +481,166 @@ func (service *fileService) Repair(ctx context.Context, req *pb.RepairRequest) (
 	return &pb.RepairResponse{Metadata: output}, nil
 }
 
+// CreateUpload implements FileServiceServer.CreateUpload
+func (service *fileService) CreateUpload(ctx context.Context, req *pb.CreateUploadRequest) (*pb.CreateUploadResponse, error) {
+	key := req.GetKey()
+	if len(key) == 0 {
+		return nil, rpctypes.ErrGRPCNilKey
+	}
+
+	uploadID, err := service.client.CreateUpload(key, client.CreateOptions{})
+	if err != nil {
+		return nil, mapUploadError(err)
+	}
+	return &pb.CreateUploadResponse{UploadID: uploadID}, nil
+}
+
+// UploadPart implements FileServiceServer.UploadPart
+func (service *fileService) UploadPart(stream pb.FileService_UploadPartServer) error {
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	header := msg.GetPart()
+	uploadID := header.GetUploadID()
+	if len(uploadID) == 0 {
+		return rpctypes.ErrGRPCNilUploadID
+	}
+	number := header.GetNumber()
+	if number < 0 {
+		return rpctypes.ErrGRPCInvalidPart
+	}
+
+	reader, writer := io.Pipe()
+	ctx := stream.Context()
+	group, ctx := errgroup.WithContext(ctx)
+
+	// start the uploader
+	var part *client.UploadedPart
+	group.Go(func() error {
+		var err error
+		part, err = service.client.UploadPartContext(ctx, uploadID, number, reader)
+		if err != nil {
+			// unblock the receiver, in case it is still writing
+			reader.CloseWithError(err)
+		}
+		return mapUploadError(err)
+	})
+
+	// start the receiver
+	group.Go(func() (err error) {
+		defer func() {
+			if err != nil {
+				// ensure the uploader doesn't store the incomplete part
+				writer.CloseWithError(err)
+				return
+			}
+			e := writer.Close()
+			if e != nil {
+				err = e
+				log.Errorf("error while closing (*fileService).UploadPart's PipeWriter: %v", e)
+			}
+		}()
+
+		var (
+			data []byte
+			msg  *pb.UploadPartRequest
+		)
+		for {
+			// as long as we receive data,
+			// we keep writing data
+			msg, err = stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+
+			data = msg.GetData().GetDataChunk()
+			if len(data) == 0 {
+				return rpctypes.ErrGRPCNilData
+			}
+
+			_, err = writer.Write(data)
+			if err != nil {
+				return err
+			}
+		}
+	})
+
+	// wait until all data has been received and uploaded,
+	// or until an error has interupted the process
+	err = group.Wait()
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&pb.UploadPartResponse{
+		Part: convertUploadedPartToProto(*part),
+	})
+}
+
+// ListUploadParts implements FileServiceServer.ListUploadParts
+func (service *fileService) ListUploadParts(ctx context.Context, req *pb.ListUploadPartsRequest) (*pb.ListUploadPartsResponse, error) {
+	uploadID := req.GetUploadID()
+	if len(uploadID) == 0 {
+		return nil, rpctypes.ErrGRPCNilUploadID
+	}
+
+	parts, err := service.client.ListUploadParts(uploadID)
+	if err != nil {
+		return nil, mapUploadError(err)
+	}
+	output := make([]*pb.UploadedPart, len(parts))
+	for i, part := range parts {
+		output[i] = convertUploadedPartToProto(part)
+	}
+	return &pb.ListUploadPartsResponse{Parts: output}, nil
+}
+
+// CompleteUpload implements FileServiceServer.CompleteUpload
+func (service *fileService) CompleteUpload(ctx context.Context, req *pb.CompleteUploadRequest) (*pb.CompleteUploadResponse, error) {
+	uploadID := req.GetUploadID()
+	if len(uploadID) == 0 {
+		return nil, rpctypes.ErrGRPCNilUploadID
+	}
+
+	metadata, err := service.client.CompleteUpload(uploadID)
+	if err != nil {
+		return nil, mapUploadError(err)
+	}
+
+	output := convertInMemoryToProtoMetadata(*metadata)
+	return &pb.CompleteUploadResponse{Metadata: output}, nil
+}
+
+// AbortUpload implements FileServiceServer.AbortUpload
+func (service *fileService) AbortUpload(ctx context.Context, req *pb.AbortUploadRequest) (*pb.AbortUploadResponse, error) {
+	uploadID := req.GetUploadID()
+	if len(uploadID) == 0 {
+		return nil, rpctypes.ErrGRPCNilUploadID
+	}
+
+	err := service.client.AbortUploadContext(ctx, uploadID)
+	if err != nil {
+		return nil, mapUploadError(err)
+	}
+	return &pb.AbortUploadResponse{}, nil
+}
+
 type fileClient interface {
-	Write(key []byte, r io.Reader) (*metatypes.Metadata, error)
-	Read(meta metatypes.Metadata, w io.Writer) error
-	Delete(meta metatypes.Metadata) error
-	Check(meta metatypes.Metadata, fast bool) (storage.CheckStatus, error)
-	Repair(meta metatypes.Metadata) (*metatypes.Metadata, error)
+	WriteContext(ctx context.Context, key []byte, r io.Reader) (*metatypes.Metadata, error)
+	AppendContext(ctx context.Context, key []byte, r io.Reader) (*metatypes.Metadata, error)
+	ReadContext(ctx context.Context, meta metatypes.Metadata, w io.Writer) error
+	DeleteContext(ctx context.Context, meta metatypes.Metadata) error
+	CheckContext(ctx context.Context, meta metatypes.Metadata, fast bool) (storage.CheckStatus, error)
+	RepairContext(ctx context.Context, meta metatypes.Metadata) (*metatypes.Metadata, error)
+
+	CreateUpload(key []byte, opts client.CreateOptions) (string, error)
+	UploadPartContext(ctx context.Context, uploadID string, part int64, r io.Reader) (*client.UploadedPart, error)
+	ListUploadParts(uploadID string) ([]client.UploadedPart, error)
+	CompleteUpload(uploadID string) (*metatypes.Metadata, error)
+	AbortUploadContext(ctx context.Context, uploadID string) error
 }
 
 var (
@@ -437,3 +437,122 @@ func TestFileAPI_Client_WriteStreamRead(t *testing.T) {
 	data = readResp.GetData()
 	require.Equal([]byte("answer"), data)
 }
+
+func TestFileAPI_Client_Upload(t *testing.T) {
+	require := require.New(t)
+
+	daemon := newTestDaemon(t)
+	require.NotNil(daemon)
+	defer daemon.Close()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(err)
+	go func() {
+		err := daemon.Serve(lis)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(err, "can't connect to the server")
+
+	client := pb.NewFileServiceClient(conn)
+	require.NotNil(client)
+
+	ctx := context.Background()
+
+	createResp, err := client.CreateUpload(ctx, &pb.CreateUploadRequest{Key: []byte("foo")})
+	require.NoError(err)
+	uploadID := createResp.GetUploadID()
+	require.NotEmpty(uploadID)
+
+	// upload the only part of the upload, in multiple messages
+	stream, err := client.UploadPart(ctx)
+	require.NoError(err)
+	err = stream.Send(&pb.UploadPartRequest{Input: &pb.UploadPartRequest_Part_{
+		Part: &pb.UploadPartRequest_Part{UploadID: uploadID, Number: 0},
+	}})
+	require.NoError(err)
+	for _, data := range []string{"b", "ar"} {
+		err = stream.Send(&pb.UploadPartRequest{Input: &pb.UploadPartRequest_Data_{
+			Data: &pb.UploadPartRequest_Data{DataChunk: []byte(data)},
+		}})
+		require.NoError(err)
+	}
+	uploadResp, err := stream.CloseAndRecv()
+	require.NoError(err)
+	require.Equal(int64(0), uploadResp.GetPart().GetNumber())
+	require.Equal(int64(3), uploadResp.GetPart().GetSize_())
+
+	listResp, err := client.ListUploadParts(ctx, &pb.ListUploadPartsRequest{UploadID: uploadID})
+	require.NoError(err)
+	require.Len(listResp.GetParts(), 1)
+
+	// nothing is stored as the key, until the upload is completed
+	_, err = client.Read(ctx, &pb.ReadRequest{Input: &pb.ReadRequest_Key{Key: []byte("foo")}})
+	require.Equal(rpctypes.ErrKeyNotFound, rpctypes.Error(err))
+
+	completeResp, err := client.CompleteUpload(ctx, &pb.CompleteUploadRequest{UploadID: uploadID})
+	require.NoError(err)
+	require.Equal([]byte("foo"), completeResp.GetMetadata().GetKey())
+	readResp, err := client.Read(ctx, &pb.ReadRequest{Input: &pb.ReadRequest_Key{Key: []byte("foo")}})
+	require.NoError(err)
+	require.Equal([]byte("bar"), readResp.GetData())
+
+	// the session no longer exists
+	_, err = client.CompleteUpload(ctx, &pb.CompleteUploadRequest{UploadID: uploadID})
+	require.Equal(rpctypes.ErrUploadNotFound, rpctypes.Error(err))
+	_, err = client.AbortUpload(ctx, &pb.AbortUploadRequest{UploadID: uploadID})
+	require.Equal(rpctypes.ErrUploadNotFound, rpctypes.Error(err))
+
+	// an aborted session can't be completed
+	createResp, err = client.CreateUpload(ctx, &pb.CreateUploadRequest{Key: []byte("bar")})
+	require.NoError(err)
+	uploadID = createResp.GetUploadID()
+	_, err = client.CompleteUpload(ctx, &pb.CompleteUploadRequest{UploadID: uploadID})
+	require.Equal(rpctypes.ErrEmptyUpload, rpctypes.Error(err))
+	_, err = client.AbortUpload(ctx, &pb.AbortUploadRequest{UploadID: uploadID})
+	require.NoError(err)
+	_, err = client.CompleteUpload(ctx, &pb.CompleteUploadRequest{UploadID: uploadID})
+	require.Equal(rpctypes.ErrUploadNotFound, rpctypes.Error(err))
+}
+
+func TestFileAPI_Client_Append(t *testing.T) {
+	require := require.New(t)
+
+	daemon := newTestDaemon(t)
+	require.NotNil(daemon)
+	defer daemon.Close()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(err)
+	go func() {
+		err := daemon.Serve(lis)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(err, "can't connect to the server")
+
+	client := pb.NewFileServiceClient(conn)
+	require.NotNil(client)
+
+	ctx := context.Background()
+
+	// appending to a non-existing key creates it
+	for _, data := range []string{"foo", "bar", "baz"} {
+		appendResp, err := client.Append(ctx, &pb.AppendRequest{Key: []byte("foo"), Data: []byte(data)})
+		require.NoError(err)
+		require.NotNil(appendResp.GetMetadata())
+	}
+
+	readResp, err := client.Read(ctx, &pb.ReadRequest{Input: &pb.ReadRequest_Key{Key: []byte("foo")}})
+	require.NoError(err)
+	require.Equal([]byte("foobarbaz"), readResp.GetData())
+
+	_, err = client.Append(ctx, &pb.AppendRequest{Key: []byte("foo")})
+	require.Equal(rpctypes.ErrNilData, rpctypes.Error(err))
+}
@@ -22,7 +22,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/threefoldtech/0-stor/client"
 	"github.com/threefoldtech/0-stor/client/datastor/pipeline/storage"
+	"github.com/threefoldtech/0-stor/client/metastor"
 	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
 	"github.com/threefoldtech/0-stor/daemon/api/grpc/rpctypes"
 	pb "github.com/threefoldtech/0-stor/daemon/api/grpc/schema"
@@ -57,6 +59,31 @@ func TestFileService_WriteError(t *testing.T) {
 	require.Equal(t, errFooFileClient, err)
 }
 
+func TestFileService_Append(t *testing.T) {
+	fSrv := newFileService(&fileClientStub{}, &metadataClientStub{}, false)
+
+	_, err := fSrv.Append(context.Background(),
+		&pb.AppendRequest{Key: []byte("key"), Data: []byte("data")})
+	require.NoError(t, err)
+}
+
+func TestFileService_AppendError(t *testing.T) {
+	fSrv := newFileService(&fileClientStub{}, &metadataClientStub{}, false)
+
+	_, err := fSrv.Append(context.Background(),
+		&pb.AppendRequest{Key: nil, Data: []byte("data")})
+	require.Equal(t, rpctypes.ErrGRPCNilKey, err)
+	_, err = fSrv.Append(context.Background(),
+		&pb.AppendRequest{Key: []byte("key"), Data: nil})
+	require.Equal(t, rpctypes.ErrGRPCNilData, err)
+
+	// client errors should propagate, iff those code paths hit
+	fSrv = newFileService(fileErrorClient{}, &metadataClientStub{}, false)
+	_, err = fSrv.Append(context.Background(),
+		&pb.AppendRequest{Key: []byte("key"), Data: []byte("data")})
+	require.Equal(t, rpctypes.ErrGRPCAppendConflict, err)
+}
+
 func TestFileService_WriteFile(t *testing.T) {
 	fSrv := newFileService(&fileClientStub{}, &metadataClientStub{}, false)
 
@@ -262,40 +289,120 @@ func TestFileService_RepairError(t *testing.T) {
 	require.Equal(t, errFooFileClient, err)
 }
 
+func TestFileService_Upload(t *testing.T) {
+	fSrv := newFileService(&fileClientStub{}, &metadataClientStub{}, false)
+
+	createResp, err := fSrv.CreateUpload(context.Background(), &pb.CreateUploadRequest{Key: []byte("key")})
+	require.NoError(t, err)
+	require.Equal(t, "upload", createResp.GetUploadID())
+	listResp, err := fSrv.ListUploadParts(context.Background(), &pb.ListUploadPartsRequest{UploadID: "upload"})
+	require.NoError(t, err)
+	require.Len(t, listResp.GetParts(), 2)
+	_, err = fSrv.CompleteUpload(context.Background(), &pb.CompleteUploadRequest{UploadID: "upload"})
+	require.NoError(t, err)
+	_, err = fSrv.AbortUpload(context.Background(), &pb.AbortUploadRequest{UploadID: "upload"})
+	require.NoError(t, err)
+}
+
+func TestFileService_UploadError(t *testing.T) {
+	fSrv := newFileService(&fileClientStub{}, &metadataClientStub{}, false)
+
+	_, err := fSrv.CreateUpload(context.Background(), &pb.CreateUploadRequest{})
+	require.Equal(t, rpctypes.ErrGRPCNilKey, err)
+	_, err = fSrv.ListUploadParts(context.Background(), &pb.ListUploadPartsRequest{})
+	require.Equal(t, rpctypes.ErrGRPCNilUploadID, err)
+	_, err = fSrv.CompleteUpload(context.Background(), &pb.CompleteUploadRequest{})
+	require.Equal(t, rpctypes.ErrGRPCNilUploadID, err)
+	_, err = fSrv.AbortUpload(context.Background(), &pb.AbortUploadRequest{})
+	require.Equal(t, rpctypes.ErrGRPCNilUploadID, err)
+
+	// client errors should propagate, iff those code paths hit,
+	// mapping the errors of upload sessions
+	fSrv = newFileService(fileErrorClient{}, &metadataClientStub{}, false)
+	_, err = fSrv.CreateUpload(context.Background(), &pb.CreateUploadRequest{Key: []byte("key")})
+	require.Equal(t, errFooFileClient, err)
+	_, err = fSrv.ListUploadParts(context.Background(), &pb.ListUploadPartsRequest{UploadID: "upload"})
+	require.Equal(t, rpctypes.ErrGRPCUploadNotFound, err)
+	_, err = fSrv.CompleteUpload(context.Background(), &pb.CompleteUploadRequest{UploadID: "upload"})
+	require.Equal(t, rpctypes.ErrGRPCEmptyUpload, err)
+	_, err = fSrv.AbortUpload(context.Background(), &pb.AbortUploadRequest{UploadID: "upload"})
+	require.Equal(t, errFooFileClient, err)
+}
+
 type fileClientStub struct{}
 
-func (stub fileClientStub) Write(key []byte, r io.Reader) (*metatypes.Metadata, error) {
+func (stub fileClientStub) WriteContext(ctx context.Context, key []byte, r io.Reader) (*metatypes.Metadata, error) {
+	return &metatypes.Metadata{}, nil
+}
+func (stub fileClientStub) AppendContext(ctx context.Context, key []byte, r io.Reader) (*metatypes.Metadata, error) {
 	return &metatypes.Metadata{}, nil
 }
-func (stub fileClientStub) Read(meta metatypes.Metadata, w io.Writer) error {
+func (stub fileClientStub) ReadContext(ctx context.Context, meta metatypes.Metadata, w io.Writer) error {
 	_, err := w.Write(append([]byte("hello"), meta.Key...))
 	return err
 }
-func (stub fileClientStub) Delete(meta metatypes.Metadata) error { return nil }
-func (stub fileClientStub) Check(meta metatypes.Metadata, fast bool) (storage.CheckStatus, error) {
+func (stub fileClientStub) DeleteContext(ctx context.Context, meta metatypes.Metadata) error {
+	return nil
+}
+func (stub fileClientStub) CheckContext(ctx context.Context, meta metatypes.Metadata, fast bool) (storage.CheckStatus, error) {
 	return 0, nil
 }
-func (stub fileClientStub) Repair(meta metatypes.Metadata) (*metatypes.Metadata, error) {
+func (stub fileClientStub) RepairContext(ctx context.Context, meta metatypes.Metadata) (*metatypes.Metadata, error) {
+	return &metatypes.Metadata{}, nil
+}
+func (stub fileClientStub) CreateUpload(key []byte, opts client.CreateOptions) (string, error) {
+	return "upload", nil
+}
+func (stub fileClientStub) UploadPartContext(ctx context.Context, uploadID string, part int64, r io.Reader) (*client.UploadedPart, error) {
+	return &client.UploadedPart{Number: part}, nil
+}
+func (stub fileClientStub) ListUploadParts(uploadID string) ([]client.UploadedPart, error) {
+	return []client.UploadedPart{{Number: 0}, {Number: 1}}, nil
+}
+func (stub fileClientStub) CompleteUpload(uploadID string) (*metatypes.Metadata, error) {
 	return &metatypes.Metadata{}, nil
 }
+func (stub fileClientStub) AbortUploadContext(ctx context.Context, uploadID string) error {
+	return nil
+}
 
 var errFooFileClient = errors.New("fileErrorClient: foo")
 
 type fileErrorClient struct{}
 
-func (c fileErrorClient) Write(key []byte, r io.Reader) (*metatypes.Metadata, error) {
+func (c fileErrorClient) WriteContext(ctx context.Context, key []byte, r io.Reader) (*metatypes.Metadata, error) {
 	return nil, errFooFileClient
 }
-func (c fileErrorClient) Read(meta metatypes.Metadata, w io.Writer) error {
+func (c fileErrorClient) AppendContext(ctx context.Context, key []byte, r io.Reader) (*metatypes.Metadata, error) {
+	return nil, client.ErrAppendConflict
+}
+func (c fileErrorClient) ReadContext(ctx context.Context, meta metatypes.Metadata, w io.Writer) error {
 	return errFooFileClient
 }
-func (c fileErrorClient) Delete(meta metatypes.Metadata) error { return errFooFileClient }
-func (c fileErrorClient) Check(meta metatypes.Metadata, fast bool) (storage.CheckStatus, error) {
+func (c fileErrorClient) DeleteContext(ctx context.Context, meta metatypes.Metadata) error {
+	return errFooFileClient
+}
+func (c fileErrorClient) CheckContext(ctx context.Context, meta metatypes.Metadata, fast bool) (storage.CheckStatus, error) {
 	return 0, errFooFileClient
 }
-func (c fileErrorClient) Repair(meta metatypes.Metadata) (*metatypes.Metadata, error) {
+func (c fileErrorClient) RepairContext(ctx context.Context, meta metatypes.Metadata) (*metatypes.Metadata, error) {
+	return nil, errFooFileClient
+}
+func (c fileErrorClient) CreateUpload(key []byte, opts client.CreateOptions) (string, error) {
+	return "", errFooFileClient
+}
+func (c fileErrorClient) UploadPartContext(ctx context.Context, uploadID string, part int64, r io.Reader) (*client.UploadedPart, error) {
 	return nil, errFooFileClient
 }
+func (c fileErrorClient) ListUploadParts(uploadID string) ([]client.UploadedPart, error) {
+	return nil, metastor.ErrNotFound
+}
+func (c fileErrorClient) CompleteUpload(uploadID string) (*metatypes.Metadata, error) {
+	return nil, client.ErrEmptyUpload
+}
+func (c fileErrorClient) AbortUploadContext(ctx context.Context, uploadID string) error {
+	return errFooFileClient
+}
 
 var (
 	_ fileClient = fileClientStub{}
@@ -38,6 +38,14 @@ var (
 	ErrGRPCNotSupported     = grpc.Errorf(codes.Unimplemented, "daemon: method not supported")
 	ErrGRPCInvalidFileMode  = grpc.Errorf(codes.Unimplemented, "daemon: file mode not supported")
 	ErrGRPCNoLocalFS        = grpc.Errorf(codes.PermissionDenied, "daemon: local filesystem access not allowed")
+	ErrGRPCCanceled         = grpc.Errorf(codes.Canceled, "daemon: operation was cancelled")
+	ErrGRPCDeadlineExceeded = grpc.Errorf(codes.DeadlineExceeded, "daemon: operation deadline exceeded")
+	ErrGRPCNilUploadID      = grpc.Errorf(codes.InvalidArgument, "daemon: upload ID is not provided")
+	ErrGRPCInvalidUploadID  = grpc.Errorf(codes.InvalidArgument, "daemon: invalid upload ID")
+	ErrGRPCInvalidPart      = grpc.Errorf(codes.InvalidArgument, "daemon: invalid part number (has to be 0 or higher)")
+	ErrGRPCUploadNotFound   = grpc.Errorf(codes.NotFound, "daemon: upload is not found")
+	ErrGRPCEmptyUpload      = grpc.Errorf(codes.FailedPrecondition, "daemon: upload has no parts")
+	ErrGRPCAppendConflict   = grpc.Errorf(codes.Aborted, "daemon: key was modified while appending to it")
 )
 
 // string to (daemon) server error mapping
@@ -57,6 +65,14 @@ var errStringToError = map[string]error{
 	grpc.ErrorDesc(ErrGRPCNotSupported):     ErrGRPCNotSupported,
 	grpc.ErrorDesc(ErrGRPCInvalidFileMode):  ErrGRPCInvalidFileMode,
 	grpc.ErrorDesc(ErrGRPCNoLocalFS):        ErrGRPCNoLocalFS,
+	grpc.ErrorDesc(ErrGRPCCanceled):         ErrGRPCCanceled,
+	grpc.ErrorDesc(ErrGRPCDeadlineExceeded): ErrGRPCDeadlineExceeded,
+	grpc.ErrorDesc(ErrGRPCNilUploadID):      ErrGRPCNilUploadID,
+	grpc.ErrorDesc(ErrGRPCInvalidUploadID):  ErrGRPCInvalidUploadID,
+	grpc.ErrorDesc(ErrGRPCInvalidPart):      ErrGRPCInvalidPart,
+	grpc.ErrorDesc(ErrGRPCUploadNotFound):   ErrGRPCUploadNotFound,
+	grpc.ErrorDesc(ErrGRPCEmptyUpload):      ErrGRPCEmptyUpload,
+	grpc.ErrorDesc(ErrGRPCAppendConflict):   ErrGRPCAppendConflict,
 }
 
 // (daemon) client-side error
@@ -76,6 +92,14 @@ var (
 	ErrNotSupported     = Error(ErrGRPCNotSupported)
 	ErrInvalidFileMode  = Error(ErrGRPCInvalidFileMode)
 	ErrNoLocalFS        = Error(ErrGRPCNoLocalFS)
+	ErrCanceled         = Error(ErrGRPCCanceled)
+	ErrDeadlineExceeded = Error(ErrGRPCDeadlineExceeded)
+	ErrNilUploadID      = Error(ErrGRPCNilUploadID)
+	ErrInvalidUploadID  = Error(ErrGRPCInvalidUploadID)
+	ErrInvalidPart      = Error(ErrGRPCInvalidPart)
+	ErrUploadNotFound   = Error(ErrGRPCUploadNotFound)
+	ErrEmptyUpload      = Error(ErrGRPCEmptyUpload)
+	ErrAppendConflict   = Error(ErrGRPCAppendConflict)
 )
 
 // DaemonError defines gRPC server errors.
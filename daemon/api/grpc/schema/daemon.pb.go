@@ -81,6 +81,30 @@ func (FileMode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{1}
 }
 
+type ShardState int32
+
+const (
+	ShardStateHealthy   ShardState = 0
+	ShardStateDegraded  ShardState = 1
+	ShardStateUnhealthy ShardState = 2
+)
+
+var ShardState_name = map[int32]string{
+	0: "ShardStateHealthy",
+	1: "ShardStateDegraded",
+	2: "ShardStateUnhealthy",
+}
+
+var ShardState_value = map[string]int32{
+	"ShardStateHealthy":   0,
+	"ShardStateDegraded":  1,
+	"ShardStateUnhealthy": 2,
+}
+
+func (ShardState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{2}
+}
+
 type Metadata struct {
 	// key defines the key of the data,
 	// and is chosen by the owner of this data.
@@ -687,6 +711,100 @@ func (m *WriteStreamResponse) GetMetadata() *Metadata {
 	return nil
 }
 
+type AppendRequest struct {
+	Key  []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *AppendRequest) Reset()      { *m = AppendRequest{} }
+func (*AppendRequest) ProtoMessage() {}
+func (*AppendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{9}
+}
+func (m *AppendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppendRequest.Merge(m, src)
+}
+func (m *AppendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppendRequest proto.InternalMessageInfo
+
+func (m *AppendRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *AppendRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type AppendResponse struct {
+	Metadata *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *AppendResponse) Reset()      { *m = AppendResponse{} }
+func (*AppendResponse) ProtoMessage() {}
+func (*AppendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{10}
+}
+func (m *AppendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppendResponse.Merge(m, src)
+}
+func (m *AppendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppendResponse proto.InternalMessageInfo
+
+func (m *AppendResponse) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type ReadRequest struct {
 	// Types that are valid to be assigned to Input:
 	//	*ReadRequest_Key
@@ -697,7 +815,7 @@ type ReadRequest struct {
 func (m *ReadRequest) Reset()      { *m = ReadRequest{} }
 func (*ReadRequest) ProtoMessage() {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{9}
+	return fileDescriptor_79298d76542483a2, []int{11}
 }
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +897,7 @@ type ReadResponse struct {
 func (m *ReadResponse) Reset()      { *m = ReadResponse{} }
 func (*ReadResponse) ProtoMessage() {}
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{10}
+	return fileDescriptor_79298d76542483a2, []int{12}
 }
 func (m *ReadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -829,7 +947,7 @@ type ReadFileRequest struct {
 func (m *ReadFileRequest) Reset()      { *m = ReadFileRequest{} }
 func (*ReadFileRequest) ProtoMessage() {}
 func (*ReadFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{11}
+	return fileDescriptor_79298d76542483a2, []int{13}
 }
 func (m *ReadFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,7 +1049,7 @@ type ReadFileResponse struct {
 func (m *ReadFileResponse) Reset()      { *m = ReadFileResponse{} }
 func (*ReadFileResponse) ProtoMessage() {}
 func (*ReadFileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{12}
+	return fileDescriptor_79298d76542483a2, []int{14}
 }
 func (m *ReadFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1089,7 @@ type ReadStreamRequest struct {
 func (m *ReadStreamRequest) Reset()      { *m = ReadStreamRequest{} }
 func (*ReadStreamRequest) ProtoMessage() {}
 func (*ReadStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{13}
+	return fileDescriptor_79298d76542483a2, []int{15}
 }
 func (m *ReadStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1060,7 +1178,7 @@ type ReadStreamResponse struct {
 func (m *ReadStreamResponse) Reset()      { *m = ReadStreamResponse{} }
 func (*ReadStreamResponse) ProtoMessage() {}
 func (*ReadStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{14}
+	return fileDescriptor_79298d76542483a2, []int{16}
 }
 func (m *ReadStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,7 +1224,7 @@ type DeleteRequest struct {
 func (m *DeleteRequest) Reset()      { *m = DeleteRequest{} }
 func (*DeleteRequest) ProtoMessage() {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{15}
+	return fileDescriptor_79298d76542483a2, []int{17}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1305,7 @@ type DeleteResponse struct {
 func (m *DeleteResponse) Reset()      { *m = DeleteResponse{} }
 func (*DeleteResponse) ProtoMessage() {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{16}
+	return fileDescriptor_79298d76542483a2, []int{18}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1345,7 @@ type CheckRequest struct {
 func (m *CheckRequest) Reset()      { *m = CheckRequest{} }
 func (*CheckRequest) ProtoMessage() {}
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{17}
+	return fileDescriptor_79298d76542483a2, []int{19}
 }
 func (m *CheckRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,7 +1434,7 @@ type CheckResponse struct {
 func (m *CheckResponse) Reset()      { *m = CheckResponse{} }
 func (*CheckResponse) ProtoMessage() {}
 func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{18}
+	return fileDescriptor_79298d76542483a2, []int{20}
 }
 func (m *CheckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1359,7 +1477,7 @@ type RepairRequest struct {
 func (m *RepairRequest) Reset()      { *m = RepairRequest{} }
 func (*RepairRequest) ProtoMessage() {}
 func (*RepairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{19}
+	return fileDescriptor_79298d76542483a2, []int{21}
 }
 func (m *RepairRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,7 +1520,7 @@ type RepairResponse struct {
 func (m *RepairResponse) Reset()      { *m = RepairResponse{} }
 func (*RepairResponse) ProtoMessage() {}
 func (*RepairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{20}
+	return fileDescriptor_79298d76542483a2, []int{22}
 }
 func (m *RepairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1438,21 +1556,28 @@ func (m *RepairResponse) GetMetadata() *Metadata {
 	return nil
 }
 
-type SetMetadataRequest struct {
-	Metadata *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+// UploadedPart describes a part stored as part of an upload session.
+type UploadedPart struct {
+	// number of the part, defining its position within the completed file
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// size of the data of the part, in bytes
+	Size_ int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// lastWriteEpoch defines the time the part was uploaded,
+	// in the Unix epoch format, in nano seconds.
+	LastWriteEpoch int64 `protobuf:"varint,3,opt,name=lastWriteEpoch,proto3" json:"lastWriteEpoch,omitempty"`
 }
 
-func (m *SetMetadataRequest) Reset()      { *m = SetMetadataRequest{} }
-func (*SetMetadataRequest) ProtoMessage() {}
-func (*SetMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{21}
+func (m *UploadedPart) Reset()      { *m = UploadedPart{} }
+func (*UploadedPart) ProtoMessage() {}
+func (*UploadedPart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{23}
 }
-func (m *SetMetadataRequest) XXX_Unmarshal(b []byte) error {
+func (m *UploadedPart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UploadedPart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetMetadataRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UploadedPart.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1462,39 +1587,54 @@ func (m *SetMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SetMetadataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetMetadataRequest.Merge(m, src)
+func (m *UploadedPart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadedPart.Merge(m, src)
 }
-func (m *SetMetadataRequest) XXX_Size() int {
+func (m *UploadedPart) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetMetadataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetMetadataRequest.DiscardUnknown(m)
+func (m *UploadedPart) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadedPart.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetMetadataRequest proto.InternalMessageInfo
+var xxx_messageInfo_UploadedPart proto.InternalMessageInfo
 
-func (m *SetMetadataRequest) GetMetadata() *Metadata {
+func (m *UploadedPart) GetNumber() int64 {
 	if m != nil {
-		return m.Metadata
+		return m.Number
 	}
-	return nil
+	return 0
 }
 
-type SetMetadataResponse struct {
+func (m *UploadedPart) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
 }
 
-func (m *SetMetadataResponse) Reset()      { *m = SetMetadataResponse{} }
-func (*SetMetadataResponse) ProtoMessage() {}
-func (*SetMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{22}
+func (m *UploadedPart) GetLastWriteEpoch() int64 {
+	if m != nil {
+		return m.LastWriteEpoch
+	}
+	return 0
 }
-func (m *SetMetadataResponse) XXX_Unmarshal(b []byte) error {
+
+type CreateUploadRequest struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *CreateUploadRequest) Reset()      { *m = CreateUploadRequest{} }
+func (*CreateUploadRequest) ProtoMessage() {}
+func (*CreateUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{24}
+}
+func (m *CreateUploadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetMetadataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateUploadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1504,33 +1644,40 @@ func (m *SetMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SetMetadataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetMetadataResponse.Merge(m, src)
+func (m *CreateUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUploadRequest.Merge(m, src)
 }
-func (m *SetMetadataResponse) XXX_Size() int {
+func (m *CreateUploadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetMetadataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetMetadataResponse.DiscardUnknown(m)
+func (m *CreateUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetMetadataResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateUploadRequest proto.InternalMessageInfo
 
-type GetMetadataRequest struct {
-	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (m *CreateUploadRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-func (m *GetMetadataRequest) Reset()      { *m = GetMetadataRequest{} }
-func (*GetMetadataRequest) ProtoMessage() {}
-func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{23}
+type CreateUploadResponse struct {
+	UploadID string `protobuf:"bytes,1,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
 }
-func (m *GetMetadataRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *CreateUploadResponse) Reset()      { *m = CreateUploadResponse{} }
+func (*CreateUploadResponse) ProtoMessage() {}
+func (*CreateUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{25}
+}
+func (m *CreateUploadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMetadataRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateUploadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1540,40 +1687,44 @@ func (m *GetMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetMetadataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetadataRequest.Merge(m, src)
+func (m *CreateUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUploadResponse.Merge(m, src)
 }
-func (m *GetMetadataRequest) XXX_Size() int {
+func (m *CreateUploadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetMetadataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetadataRequest.DiscardUnknown(m)
+func (m *CreateUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUploadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetadataRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateUploadResponse proto.InternalMessageInfo
 
-func (m *GetMetadataRequest) GetKey() []byte {
+func (m *CreateUploadResponse) GetUploadID() string {
 	if m != nil {
-		return m.Key
+		return m.UploadID
 	}
-	return nil
+	return ""
 }
 
-type GetMetadataResponse struct {
-	Metadata *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+// upload ID and part number are send as part of header
+type UploadPartRequest struct {
+	// Types that are valid to be assigned to Input:
+	//	*UploadPartRequest_Part_
+	//	*UploadPartRequest_Data_
+	Input isUploadPartRequest_Input `protobuf_oneof:"input"`
 }
 
-func (m *GetMetadataResponse) Reset()      { *m = GetMetadataResponse{} }
-func (*GetMetadataResponse) ProtoMessage() {}
-func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{24}
+func (m *UploadPartRequest) Reset()      { *m = UploadPartRequest{} }
+func (*UploadPartRequest) ProtoMessage() {}
+func (*UploadPartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{26}
 }
-func (m *GetMetadataResponse) XXX_Unmarshal(b []byte) error {
+func (m *UploadPartRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UploadPartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMetadataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UploadPartRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1583,40 +1734,80 @@ func (m *GetMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetMetadataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetadataResponse.Merge(m, src)
+func (m *UploadPartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadPartRequest.Merge(m, src)
 }
-func (m *GetMetadataResponse) XXX_Size() int {
+func (m *UploadPartRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetMetadataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetadataResponse.DiscardUnknown(m)
+func (m *UploadPartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadPartRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetadataResponse proto.InternalMessageInfo
+var xxx_messageInfo_UploadPartRequest proto.InternalMessageInfo
 
-func (m *GetMetadataResponse) GetMetadata() *Metadata {
+type isUploadPartRequest_Input interface {
+	isUploadPartRequest_Input()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type UploadPartRequest_Part_ struct {
+	Part *UploadPartRequest_Part `protobuf:"bytes,1,opt,name=part,proto3,oneof" json:"part,omitempty"`
+}
+type UploadPartRequest_Data_ struct {
+	Data *UploadPartRequest_Data `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
+}
+
+func (*UploadPartRequest_Part_) isUploadPartRequest_Input() {}
+func (*UploadPartRequest_Data_) isUploadPartRequest_Input() {}
+
+func (m *UploadPartRequest) GetInput() isUploadPartRequest_Input {
 	if m != nil {
-		return m.Metadata
+		return m.Input
 	}
 	return nil
 }
 
-type DeleteMetadataRequest struct {
-	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (m *UploadPartRequest) GetPart() *UploadPartRequest_Part {
+	if x, ok := m.GetInput().(*UploadPartRequest_Part_); ok {
+		return x.Part
+	}
+	return nil
 }
 
-func (m *DeleteMetadataRequest) Reset()      { *m = DeleteMetadataRequest{} }
-func (*DeleteMetadataRequest) ProtoMessage() {}
-func (*DeleteMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{25}
+func (m *UploadPartRequest) GetData() *UploadPartRequest_Data {
+	if x, ok := m.GetInput().(*UploadPartRequest_Data_); ok {
+		return x.Data
+	}
+	return nil
 }
-func (m *DeleteMetadataRequest) XXX_Unmarshal(b []byte) error {
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UploadPartRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UploadPartRequest_Part_)(nil),
+		(*UploadPartRequest_Data_)(nil),
+	}
+}
+
+type UploadPartRequest_Part struct {
+	UploadID string `protobuf:"bytes,1,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
+	Number   int64  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *UploadPartRequest_Part) Reset()      { *m = UploadPartRequest_Part{} }
+func (*UploadPartRequest_Part) ProtoMessage() {}
+func (*UploadPartRequest_Part) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{26, 0}
+}
+func (m *UploadPartRequest_Part) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UploadPartRequest_Part) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteMetadataRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UploadPartRequest_Part.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1626,39 +1817,47 @@ func (m *DeleteMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DeleteMetadataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteMetadataRequest.Merge(m, src)
+func (m *UploadPartRequest_Part) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadPartRequest_Part.Merge(m, src)
 }
-func (m *DeleteMetadataRequest) XXX_Size() int {
+func (m *UploadPartRequest_Part) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteMetadataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteMetadataRequest.DiscardUnknown(m)
+func (m *UploadPartRequest_Part) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadPartRequest_Part.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteMetadataRequest proto.InternalMessageInfo
+var xxx_messageInfo_UploadPartRequest_Part proto.InternalMessageInfo
 
-func (m *DeleteMetadataRequest) GetKey() []byte {
+func (m *UploadPartRequest_Part) GetUploadID() string {
 	if m != nil {
-		return m.Key
+		return m.UploadID
 	}
-	return nil
+	return ""
 }
 
-type DeleteMetadataResponse struct {
+func (m *UploadPartRequest_Part) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
 }
 
-func (m *DeleteMetadataResponse) Reset()      { *m = DeleteMetadataResponse{} }
-func (*DeleteMetadataResponse) ProtoMessage() {}
-func (*DeleteMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{26}
+type UploadPartRequest_Data struct {
+	DataChunk []byte `protobuf:"bytes,1,opt,name=dataChunk,proto3" json:"dataChunk,omitempty"`
 }
-func (m *DeleteMetadataResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *UploadPartRequest_Data) Reset()      { *m = UploadPartRequest_Data{} }
+func (*UploadPartRequest_Data) ProtoMessage() {}
+func (*UploadPartRequest_Data) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{26, 1}
+}
+func (m *UploadPartRequest_Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UploadPartRequest_Data) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteMetadataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UploadPartRequest_Data.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1668,32 +1867,40 @@ func (m *DeleteMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *DeleteMetadataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteMetadataResponse.Merge(m, src)
+func (m *UploadPartRequest_Data) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadPartRequest_Data.Merge(m, src)
 }
-func (m *DeleteMetadataResponse) XXX_Size() int {
+func (m *UploadPartRequest_Data) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteMetadataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteMetadataResponse.DiscardUnknown(m)
+func (m *UploadPartRequest_Data) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadPartRequest_Data.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteMetadataResponse proto.InternalMessageInfo
+var xxx_messageInfo_UploadPartRequest_Data proto.InternalMessageInfo
 
-type ListMetadataKeysRequest struct {
+func (m *UploadPartRequest_Data) GetDataChunk() []byte {
+	if m != nil {
+		return m.DataChunk
+	}
+	return nil
 }
 
-func (m *ListMetadataKeysRequest) Reset()      { *m = ListMetadataKeysRequest{} }
-func (*ListMetadataKeysRequest) ProtoMessage() {}
-func (*ListMetadataKeysRequest) Descriptor() ([]byte, []int) {
+type UploadPartResponse struct {
+	Part *UploadedPart `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+}
+
+func (m *UploadPartResponse) Reset()      { *m = UploadPartResponse{} }
+func (*UploadPartResponse) ProtoMessage() {}
+func (*UploadPartResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{27}
 }
-func (m *ListMetadataKeysRequest) XXX_Unmarshal(b []byte) error {
+func (m *UploadPartResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListMetadataKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UploadPartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListMetadataKeysRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UploadPartResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1703,33 +1910,40 @@ func (m *ListMetadataKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ListMetadataKeysRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListMetadataKeysRequest.Merge(m, src)
+func (m *UploadPartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadPartResponse.Merge(m, src)
 }
-func (m *ListMetadataKeysRequest) XXX_Size() int {
+func (m *UploadPartResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListMetadataKeysRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListMetadataKeysRequest.DiscardUnknown(m)
+func (m *UploadPartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadPartResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListMetadataKeysRequest proto.InternalMessageInfo
+var xxx_messageInfo_UploadPartResponse proto.InternalMessageInfo
 
-type ListMetadataKeysResponse struct {
-	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (m *UploadPartResponse) GetPart() *UploadedPart {
+	if m != nil {
+		return m.Part
+	}
+	return nil
 }
 
-func (m *ListMetadataKeysResponse) Reset()      { *m = ListMetadataKeysResponse{} }
-func (*ListMetadataKeysResponse) ProtoMessage() {}
-func (*ListMetadataKeysResponse) Descriptor() ([]byte, []int) {
+type ListUploadPartsRequest struct {
+	UploadID string `protobuf:"bytes,1,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
+}
+
+func (m *ListUploadPartsRequest) Reset()      { *m = ListUploadPartsRequest{} }
+func (*ListUploadPartsRequest) ProtoMessage() {}
+func (*ListUploadPartsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{28}
 }
-func (m *ListMetadataKeysResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListUploadPartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListMetadataKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListUploadPartsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListMetadataKeysResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListUploadPartsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1739,40 +1953,40 @@ func (m *ListMetadataKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ListMetadataKeysResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListMetadataKeysResponse.Merge(m, src)
+func (m *ListUploadPartsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUploadPartsRequest.Merge(m, src)
 }
-func (m *ListMetadataKeysResponse) XXX_Size() int {
+func (m *ListUploadPartsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListMetadataKeysResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListMetadataKeysResponse.DiscardUnknown(m)
+func (m *ListUploadPartsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUploadPartsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListMetadataKeysResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListUploadPartsRequest proto.InternalMessageInfo
 
-func (m *ListMetadataKeysResponse) GetKey() []byte {
+func (m *ListUploadPartsRequest) GetUploadID() string {
 	if m != nil {
-		return m.Key
+		return m.UploadID
 	}
-	return nil
+	return ""
 }
 
-type DataWriteRequest struct {
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+type ListUploadPartsResponse struct {
+	Parts []*UploadedPart `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
 }
 
-func (m *DataWriteRequest) Reset()      { *m = DataWriteRequest{} }
-func (*DataWriteRequest) ProtoMessage() {}
-func (*DataWriteRequest) Descriptor() ([]byte, []int) {
+func (m *ListUploadPartsResponse) Reset()      { *m = ListUploadPartsResponse{} }
+func (*ListUploadPartsResponse) ProtoMessage() {}
+func (*ListUploadPartsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{29}
 }
-func (m *DataWriteRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListUploadPartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListUploadPartsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataWriteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListUploadPartsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1782,40 +1996,40 @@ func (m *DataWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DataWriteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataWriteRequest.Merge(m, src)
+func (m *ListUploadPartsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUploadPartsResponse.Merge(m, src)
 }
-func (m *DataWriteRequest) XXX_Size() int {
+func (m *ListUploadPartsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataWriteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataWriteRequest.DiscardUnknown(m)
+func (m *ListUploadPartsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUploadPartsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataWriteRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListUploadPartsResponse proto.InternalMessageInfo
 
-func (m *DataWriteRequest) GetData() []byte {
+func (m *ListUploadPartsResponse) GetParts() []*UploadedPart {
 	if m != nil {
-		return m.Data
+		return m.Parts
 	}
 	return nil
 }
 
-type DataWriteResponse struct {
-	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+type CompleteUploadRequest struct {
+	UploadID string `protobuf:"bytes,1,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
 }
 
-func (m *DataWriteResponse) Reset()      { *m = DataWriteResponse{} }
-func (*DataWriteResponse) ProtoMessage() {}
-func (*DataWriteResponse) Descriptor() ([]byte, []int) {
+func (m *CompleteUploadRequest) Reset()      { *m = CompleteUploadRequest{} }
+func (*CompleteUploadRequest) ProtoMessage() {}
+func (*CompleteUploadRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{30}
 }
-func (m *DataWriteResponse) XXX_Unmarshal(b []byte) error {
+func (m *CompleteUploadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompleteUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataWriteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompleteUploadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1825,40 +2039,40 @@ func (m *DataWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DataWriteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataWriteResponse.Merge(m, src)
+func (m *CompleteUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteUploadRequest.Merge(m, src)
 }
-func (m *DataWriteResponse) XXX_Size() int {
+func (m *CompleteUploadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataWriteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataWriteResponse.DiscardUnknown(m)
+func (m *CompleteUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataWriteResponse proto.InternalMessageInfo
+var xxx_messageInfo_CompleteUploadRequest proto.InternalMessageInfo
 
-func (m *DataWriteResponse) GetChunks() []*Chunk {
+func (m *CompleteUploadRequest) GetUploadID() string {
 	if m != nil {
-		return m.Chunks
+		return m.UploadID
 	}
-	return nil
+	return ""
 }
 
-type DataWriteFileRequest struct {
-	FilePath string `protobuf:"bytes,1,opt,name=filePath,proto3" json:"filePath,omitempty"`
+type CompleteUploadResponse struct {
+	Metadata *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (m *DataWriteFileRequest) Reset()      { *m = DataWriteFileRequest{} }
-func (*DataWriteFileRequest) ProtoMessage() {}
-func (*DataWriteFileRequest) Descriptor() ([]byte, []int) {
+func (m *CompleteUploadResponse) Reset()      { *m = CompleteUploadResponse{} }
+func (*CompleteUploadResponse) ProtoMessage() {}
+func (*CompleteUploadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{31}
 }
-func (m *DataWriteFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *CompleteUploadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataWriteFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompleteUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataWriteFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompleteUploadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1868,40 +2082,40 @@ func (m *DataWriteFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DataWriteFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataWriteFileRequest.Merge(m, src)
+func (m *CompleteUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteUploadResponse.Merge(m, src)
 }
-func (m *DataWriteFileRequest) XXX_Size() int {
+func (m *CompleteUploadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataWriteFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataWriteFileRequest.DiscardUnknown(m)
+func (m *CompleteUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteUploadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataWriteFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_CompleteUploadResponse proto.InternalMessageInfo
 
-func (m *DataWriteFileRequest) GetFilePath() string {
+func (m *CompleteUploadResponse) GetMetadata() *Metadata {
 	if m != nil {
-		return m.FilePath
+		return m.Metadata
 	}
-	return ""
+	return nil
 }
 
-type DataWriteFileResponse struct {
-	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+type AbortUploadRequest struct {
+	UploadID string `protobuf:"bytes,1,opt,name=uploadID,proto3" json:"uploadID,omitempty"`
 }
 
-func (m *DataWriteFileResponse) Reset()      { *m = DataWriteFileResponse{} }
-func (*DataWriteFileResponse) ProtoMessage() {}
-func (*DataWriteFileResponse) Descriptor() ([]byte, []int) {
+func (m *AbortUploadRequest) Reset()      { *m = AbortUploadRequest{} }
+func (*AbortUploadRequest) ProtoMessage() {}
+func (*AbortUploadRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{32}
 }
-func (m *DataWriteFileResponse) XXX_Unmarshal(b []byte) error {
+func (m *AbortUploadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataWriteFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AbortUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataWriteFileResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AbortUploadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1911,40 +2125,39 @@ func (m *DataWriteFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DataWriteFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataWriteFileResponse.Merge(m, src)
+func (m *AbortUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortUploadRequest.Merge(m, src)
 }
-func (m *DataWriteFileResponse) XXX_Size() int {
+func (m *AbortUploadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataWriteFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataWriteFileResponse.DiscardUnknown(m)
+func (m *AbortUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataWriteFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_AbortUploadRequest proto.InternalMessageInfo
 
-func (m *DataWriteFileResponse) GetChunks() []*Chunk {
+func (m *AbortUploadRequest) GetUploadID() string {
 	if m != nil {
-		return m.Chunks
+		return m.UploadID
 	}
-	return nil
+	return ""
 }
 
-type DataWriteStreamRequest struct {
-	DataChunk []byte `protobuf:"bytes,1,opt,name=dataChunk,proto3" json:"dataChunk,omitempty"`
+type AbortUploadResponse struct {
 }
 
-func (m *DataWriteStreamRequest) Reset()      { *m = DataWriteStreamRequest{} }
-func (*DataWriteStreamRequest) ProtoMessage() {}
-func (*DataWriteStreamRequest) Descriptor() ([]byte, []int) {
+func (m *AbortUploadResponse) Reset()      { *m = AbortUploadResponse{} }
+func (*AbortUploadResponse) ProtoMessage() {}
+func (*AbortUploadResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{33}
 }
-func (m *DataWriteStreamRequest) XXX_Unmarshal(b []byte) error {
+func (m *AbortUploadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataWriteStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AbortUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataWriteStreamRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AbortUploadResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1954,40 +2167,33 @@ func (m *DataWriteStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *DataWriteStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataWriteStreamRequest.Merge(m, src)
+func (m *AbortUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortUploadResponse.Merge(m, src)
 }
-func (m *DataWriteStreamRequest) XXX_Size() int {
+func (m *AbortUploadResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataWriteStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataWriteStreamRequest.DiscardUnknown(m)
+func (m *AbortUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortUploadResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataWriteStreamRequest proto.InternalMessageInfo
-
-func (m *DataWriteStreamRequest) GetDataChunk() []byte {
-	if m != nil {
-		return m.DataChunk
-	}
-	return nil
-}
+var xxx_messageInfo_AbortUploadResponse proto.InternalMessageInfo
 
-type DataWriteStreamResponse struct {
-	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+type SetMetadataRequest struct {
+	Metadata *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (m *DataWriteStreamResponse) Reset()      { *m = DataWriteStreamResponse{} }
-func (*DataWriteStreamResponse) ProtoMessage() {}
-func (*DataWriteStreamResponse) Descriptor() ([]byte, []int) {
+func (m *SetMetadataRequest) Reset()      { *m = SetMetadataRequest{} }
+func (*SetMetadataRequest) ProtoMessage() {}
+func (*SetMetadataRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{34}
 }
-func (m *DataWriteStreamResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataWriteStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataWriteStreamResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1997,40 +2203,39 @@ func (m *DataWriteStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *DataWriteStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataWriteStreamResponse.Merge(m, src)
+func (m *SetMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMetadataRequest.Merge(m, src)
 }
-func (m *DataWriteStreamResponse) XXX_Size() int {
+func (m *SetMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataWriteStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataWriteStreamResponse.DiscardUnknown(m)
+func (m *SetMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataWriteStreamResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetMetadataRequest proto.InternalMessageInfo
 
-func (m *DataWriteStreamResponse) GetChunks() []*Chunk {
+func (m *SetMetadataRequest) GetMetadata() *Metadata {
 	if m != nil {
-		return m.Chunks
+		return m.Metadata
 	}
 	return nil
 }
 
-type DataReadRequest struct {
-	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+type SetMetadataResponse struct {
 }
 
-func (m *DataReadRequest) Reset()      { *m = DataReadRequest{} }
-func (*DataReadRequest) ProtoMessage() {}
-func (*DataReadRequest) Descriptor() ([]byte, []int) {
+func (m *SetMetadataResponse) Reset()      { *m = SetMetadataResponse{} }
+func (*SetMetadataResponse) ProtoMessage() {}
+func (*SetMetadataResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{35}
 }
-func (m *DataReadRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataReadRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetMetadataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2040,40 +2245,33 @@ func (m *DataReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DataReadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataReadRequest.Merge(m, src)
+func (m *SetMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMetadataResponse.Merge(m, src)
 }
-func (m *DataReadRequest) XXX_Size() int {
+func (m *SetMetadataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataReadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataReadRequest.DiscardUnknown(m)
+func (m *SetMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMetadataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataReadRequest proto.InternalMessageInfo
-
-func (m *DataReadRequest) GetChunks() []*Chunk {
-	if m != nil {
-		return m.Chunks
-	}
-	return nil
-}
+var xxx_messageInfo_SetMetadataResponse proto.InternalMessageInfo
 
-type DataReadResponse struct {
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+type GetMetadataRequest struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *DataReadResponse) Reset()      { *m = DataReadResponse{} }
-func (*DataReadResponse) ProtoMessage() {}
-func (*DataReadResponse) Descriptor() ([]byte, []int) {
+func (m *GetMetadataRequest) Reset()      { *m = GetMetadataRequest{} }
+func (*GetMetadataRequest) ProtoMessage() {}
+func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{36}
 }
-func (m *DataReadResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataReadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataReadResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2083,44 +2281,40 @@ func (m *DataReadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DataReadResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataReadResponse.Merge(m, src)
+func (m *GetMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataRequest.Merge(m, src)
 }
-func (m *DataReadResponse) XXX_Size() int {
+func (m *GetMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataReadResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataReadResponse.DiscardUnknown(m)
+func (m *GetMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataReadResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetMetadataRequest proto.InternalMessageInfo
 
-func (m *DataReadResponse) GetData() []byte {
+func (m *GetMetadataRequest) GetKey() []byte {
 	if m != nil {
-		return m.Data
+		return m.Key
 	}
 	return nil
 }
 
-type DataReadFileRequest struct {
-	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
-	// destination file and its configuration
-	FilePath      string   `protobuf:"bytes,2,opt,name=filePath,proto3" json:"filePath,omitempty"`
-	FileMode      FileMode `protobuf:"varint,3,opt,name=fileMode,proto3,enum=schema.FileMode" json:"fileMode,omitempty"`
-	SynchronousIO bool     `protobuf:"varint,4,opt,name=synchronousIO,proto3" json:"synchronousIO,omitempty"`
+type GetMetadataResponse struct {
+	Metadata *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (m *DataReadFileRequest) Reset()      { *m = DataReadFileRequest{} }
-func (*DataReadFileRequest) ProtoMessage() {}
-func (*DataReadFileRequest) Descriptor() ([]byte, []int) {
+func (m *GetMetadataResponse) Reset()      { *m = GetMetadataResponse{} }
+func (*GetMetadataResponse) ProtoMessage() {}
+func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{37}
 }
-func (m *DataReadFileRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataReadFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataReadFileRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetMetadataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2130,60 +2324,40 @@ func (m *DataReadFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DataReadFileRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataReadFileRequest.Merge(m, src)
-}
-func (m *DataReadFileRequest) XXX_Size() int {
+func (m *GetMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataResponse.Merge(m, src)
+}
+func (m *GetMetadataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataReadFileRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataReadFileRequest.DiscardUnknown(m)
+func (m *GetMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataReadFileRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetMetadataResponse proto.InternalMessageInfo
 
-func (m *DataReadFileRequest) GetChunks() []*Chunk {
+func (m *GetMetadataResponse) GetMetadata() *Metadata {
 	if m != nil {
-		return m.Chunks
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *DataReadFileRequest) GetFilePath() string {
-	if m != nil {
-		return m.FilePath
-	}
-	return ""
-}
-
-func (m *DataReadFileRequest) GetFileMode() FileMode {
-	if m != nil {
-		return m.FileMode
-	}
-	return FileModeTruncate
-}
-
-func (m *DataReadFileRequest) GetSynchronousIO() bool {
-	if m != nil {
-		return m.SynchronousIO
-	}
-	return false
-}
-
-type DataReadFileResponse struct {
+type DeleteMetadataRequest struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *DataReadFileResponse) Reset()      { *m = DataReadFileResponse{} }
-func (*DataReadFileResponse) ProtoMessage() {}
-func (*DataReadFileResponse) Descriptor() ([]byte, []int) {
+func (m *DeleteMetadataRequest) Reset()      { *m = DeleteMetadataRequest{} }
+func (*DeleteMetadataRequest) ProtoMessage() {}
+func (*DeleteMetadataRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{38}
 }
-func (m *DataReadFileResponse) XXX_Unmarshal(b []byte) error {
+func (m *DeleteMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataReadFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataReadFileResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2193,34 +2367,39 @@ func (m *DataReadFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DataReadFileResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataReadFileResponse.Merge(m, src)
+func (m *DeleteMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMetadataRequest.Merge(m, src)
 }
-func (m *DataReadFileResponse) XXX_Size() int {
+func (m *DeleteMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataReadFileResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataReadFileResponse.DiscardUnknown(m)
+func (m *DeleteMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataReadFileResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteMetadataRequest proto.InternalMessageInfo
 
-type DataReadStreamRequest struct {
-	Chunks    []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
-	ChunkSize int64    `protobuf:"varint,2,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+func (m *DeleteMetadataRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-func (m *DataReadStreamRequest) Reset()      { *m = DataReadStreamRequest{} }
-func (*DataReadStreamRequest) ProtoMessage() {}
-func (*DataReadStreamRequest) Descriptor() ([]byte, []int) {
+type DeleteMetadataResponse struct {
+}
+
+func (m *DeleteMetadataResponse) Reset()      { *m = DeleteMetadataResponse{} }
+func (*DeleteMetadataResponse) ProtoMessage() {}
+func (*DeleteMetadataResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{39}
 }
-func (m *DataReadStreamRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeleteMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataReadStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataReadStreamRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteMetadataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2230,47 +2409,32 @@ func (m *DataReadStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DataReadStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataReadStreamRequest.Merge(m, src)
+func (m *DeleteMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMetadataResponse.Merge(m, src)
 }
-func (m *DataReadStreamRequest) XXX_Size() int {
+func (m *DeleteMetadataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataReadStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataReadStreamRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DataReadStreamRequest proto.InternalMessageInfo
-
-func (m *DataReadStreamRequest) GetChunks() []*Chunk {
-	if m != nil {
-		return m.Chunks
-	}
-	return nil
+func (m *DeleteMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMetadataResponse.DiscardUnknown(m)
 }
 
-func (m *DataReadStreamRequest) GetChunkSize() int64 {
-	if m != nil {
-		return m.ChunkSize
-	}
-	return 0
-}
+var xxx_messageInfo_DeleteMetadataResponse proto.InternalMessageInfo
 
-type DataReadStreamResponse struct {
-	DataChunk []byte `protobuf:"bytes,1,opt,name=dataChunk,proto3" json:"dataChunk,omitempty"`
+type ListMetadataKeysRequest struct {
 }
 
-func (m *DataReadStreamResponse) Reset()      { *m = DataReadStreamResponse{} }
-func (*DataReadStreamResponse) ProtoMessage() {}
-func (*DataReadStreamResponse) Descriptor() ([]byte, []int) {
+func (m *ListMetadataKeysRequest) Reset()      { *m = ListMetadataKeysRequest{} }
+func (*ListMetadataKeysRequest) ProtoMessage() {}
+func (*ListMetadataKeysRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{40}
 }
-func (m *DataReadStreamResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListMetadataKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataReadStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListMetadataKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataReadStreamResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListMetadataKeysRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2280,40 +2444,33 @@ func (m *DataReadStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *DataReadStreamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataReadStreamResponse.Merge(m, src)
+func (m *ListMetadataKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataKeysRequest.Merge(m, src)
 }
-func (m *DataReadStreamResponse) XXX_Size() int {
+func (m *ListMetadataKeysRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataReadStreamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataReadStreamResponse.DiscardUnknown(m)
+func (m *ListMetadataKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataKeysRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataReadStreamResponse proto.InternalMessageInfo
-
-func (m *DataReadStreamResponse) GetDataChunk() []byte {
-	if m != nil {
-		return m.DataChunk
-	}
-	return nil
-}
+var xxx_messageInfo_ListMetadataKeysRequest proto.InternalMessageInfo
 
-type DataDeleteRequest struct {
-	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+type ListMetadataKeysResponse struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *DataDeleteRequest) Reset()      { *m = DataDeleteRequest{} }
-func (*DataDeleteRequest) ProtoMessage() {}
-func (*DataDeleteRequest) Descriptor() ([]byte, []int) {
+func (m *ListMetadataKeysResponse) Reset()      { *m = ListMetadataKeysResponse{} }
+func (*ListMetadataKeysResponse) ProtoMessage() {}
+func (*ListMetadataKeysResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{41}
 }
-func (m *DataDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListMetadataKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListMetadataKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListMetadataKeysResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2323,39 +2480,40 @@ func (m *DataDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DataDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataDeleteRequest.Merge(m, src)
+func (m *ListMetadataKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataKeysResponse.Merge(m, src)
 }
-func (m *DataDeleteRequest) XXX_Size() int {
+func (m *ListMetadataKeysResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataDeleteRequest.DiscardUnknown(m)
+func (m *ListMetadataKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataKeysResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListMetadataKeysResponse proto.InternalMessageInfo
 
-func (m *DataDeleteRequest) GetChunks() []*Chunk {
+func (m *ListMetadataKeysResponse) GetKey() []byte {
 	if m != nil {
-		return m.Chunks
+		return m.Key
 	}
 	return nil
 }
 
-type DataDeleteResponse struct {
+type DataWriteRequest struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *DataDeleteResponse) Reset()      { *m = DataDeleteResponse{} }
-func (*DataDeleteResponse) ProtoMessage() {}
-func (*DataDeleteResponse) Descriptor() ([]byte, []int) {
+func (m *DataWriteRequest) Reset()      { *m = DataWriteRequest{} }
+func (*DataWriteRequest) ProtoMessage() {}
+func (*DataWriteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{42}
 }
-func (m *DataDeleteResponse) XXX_Unmarshal(b []byte) error {
+func (m *DataWriteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataWriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataDeleteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataWriteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2365,34 +2523,40 @@ func (m *DataDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DataDeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataDeleteResponse.Merge(m, src)
+func (m *DataWriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataWriteRequest.Merge(m, src)
 }
-func (m *DataDeleteResponse) XXX_Size() int {
+func (m *DataWriteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataDeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataDeleteResponse.DiscardUnknown(m)
+func (m *DataWriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataWriteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataDeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_DataWriteRequest proto.InternalMessageInfo
 
-type DataCheckRequest struct {
+func (m *DataWriteRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type DataWriteResponse struct {
 	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
-	Fast   bool     `protobuf:"varint,2,opt,name=fast,proto3" json:"fast,omitempty"`
 }
 
-func (m *DataCheckRequest) Reset()      { *m = DataCheckRequest{} }
-func (*DataCheckRequest) ProtoMessage() {}
-func (*DataCheckRequest) Descriptor() ([]byte, []int) {
+func (m *DataWriteResponse) Reset()      { *m = DataWriteResponse{} }
+func (*DataWriteResponse) ProtoMessage() {}
+func (*DataWriteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{43}
 }
-func (m *DataCheckRequest) XXX_Unmarshal(b []byte) error {
+func (m *DataWriteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataCheckRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataWriteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2402,47 +2566,40 @@ func (m *DataCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DataCheckRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataCheckRequest.Merge(m, src)
+func (m *DataWriteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataWriteResponse.Merge(m, src)
 }
-func (m *DataCheckRequest) XXX_Size() int {
+func (m *DataWriteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataCheckRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataCheckRequest.DiscardUnknown(m)
+func (m *DataWriteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataWriteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataCheckRequest proto.InternalMessageInfo
+var xxx_messageInfo_DataWriteResponse proto.InternalMessageInfo
 
-func (m *DataCheckRequest) GetChunks() []*Chunk {
+func (m *DataWriteResponse) GetChunks() []*Chunk {
 	if m != nil {
 		return m.Chunks
 	}
 	return nil
 }
 
-func (m *DataCheckRequest) GetFast() bool {
-	if m != nil {
-		return m.Fast
-	}
-	return false
+type DataWriteFileRequest struct {
+	FilePath string `protobuf:"bytes,1,opt,name=filePath,proto3" json:"filePath,omitempty"`
 }
 
-type DataCheckResponse struct {
-	Status CheckStatus `protobuf:"varint,1,opt,name=status,proto3,enum=schema.CheckStatus" json:"status,omitempty"`
+func (m *DataWriteFileRequest) Reset()      { *m = DataWriteFileRequest{} }
+func (*DataWriteFileRequest) ProtoMessage() {}
+func (*DataWriteFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{44}
 }
-
-func (m *DataCheckResponse) Reset()      { *m = DataCheckResponse{} }
-func (*DataCheckResponse) ProtoMessage() {}
-func (*DataCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79298d76542483a2, []int{44}
-}
-func (m *DataCheckResponse) XXX_Unmarshal(b []byte) error {
+func (m *DataWriteFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataWriteFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataCheckResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataWriteFileRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2452,40 +2609,40 @@ func (m *DataCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DataCheckResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataCheckResponse.Merge(m, src)
+func (m *DataWriteFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataWriteFileRequest.Merge(m, src)
 }
-func (m *DataCheckResponse) XXX_Size() int {
+func (m *DataWriteFileRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataCheckResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataCheckResponse.DiscardUnknown(m)
+func (m *DataWriteFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataWriteFileRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataCheckResponse proto.InternalMessageInfo
+var xxx_messageInfo_DataWriteFileRequest proto.InternalMessageInfo
 
-func (m *DataCheckResponse) GetStatus() CheckStatus {
+func (m *DataWriteFileRequest) GetFilePath() string {
 	if m != nil {
-		return m.Status
+		return m.FilePath
 	}
-	return CheckStatusInvalid
+	return ""
 }
 
-type DataRepairRequest struct {
+type DataWriteFileResponse struct {
 	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
 }
 
-func (m *DataRepairRequest) Reset()      { *m = DataRepairRequest{} }
-func (*DataRepairRequest) ProtoMessage() {}
-func (*DataRepairRequest) Descriptor() ([]byte, []int) {
+func (m *DataWriteFileResponse) Reset()      { *m = DataWriteFileResponse{} }
+func (*DataWriteFileResponse) ProtoMessage() {}
+func (*DataWriteFileResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{45}
 }
-func (m *DataRepairRequest) XXX_Unmarshal(b []byte) error {
+func (m *DataWriteFileResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataRepairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataWriteFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataRepairRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataWriteFileResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2495,40 +2652,40 @@ func (m *DataRepairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DataRepairRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataRepairRequest.Merge(m, src)
+func (m *DataWriteFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataWriteFileResponse.Merge(m, src)
 }
-func (m *DataRepairRequest) XXX_Size() int {
+func (m *DataWriteFileResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataRepairRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataRepairRequest.DiscardUnknown(m)
+func (m *DataWriteFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataWriteFileResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataRepairRequest proto.InternalMessageInfo
+var xxx_messageInfo_DataWriteFileResponse proto.InternalMessageInfo
 
-func (m *DataRepairRequest) GetChunks() []*Chunk {
+func (m *DataWriteFileResponse) GetChunks() []*Chunk {
 	if m != nil {
 		return m.Chunks
 	}
 	return nil
 }
 
-type DataRepairResponse struct {
-	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+type DataWriteStreamRequest struct {
+	DataChunk []byte `protobuf:"bytes,1,opt,name=dataChunk,proto3" json:"dataChunk,omitempty"`
 }
 
-func (m *DataRepairResponse) Reset()      { *m = DataRepairResponse{} }
-func (*DataRepairResponse) ProtoMessage() {}
-func (*DataRepairResponse) Descriptor() ([]byte, []int) {
+func (m *DataWriteStreamRequest) Reset()      { *m = DataWriteStreamRequest{} }
+func (*DataWriteStreamRequest) ProtoMessage() {}
+func (*DataWriteStreamRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_79298d76542483a2, []int{46}
 }
-func (m *DataRepairResponse) XXX_Unmarshal(b []byte) error {
+func (m *DataWriteStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataRepairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataWriteStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataRepairResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataWriteStreamRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2538,816 +2695,1110 @@ func (m *DataRepairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DataRepairResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataRepairResponse.Merge(m, src)
+func (m *DataWriteStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataWriteStreamRequest.Merge(m, src)
 }
-func (m *DataRepairResponse) XXX_Size() int {
+func (m *DataWriteStreamRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataRepairResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataRepairResponse.DiscardUnknown(m)
+func (m *DataWriteStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataWriteStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataRepairResponse proto.InternalMessageInfo
+var xxx_messageInfo_DataWriteStreamRequest proto.InternalMessageInfo
 
-func (m *DataRepairResponse) GetChunks() []*Chunk {
+func (m *DataWriteStreamRequest) GetDataChunk() []byte {
 	if m != nil {
-		return m.Chunks
+		return m.DataChunk
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("schema.CheckStatus", CheckStatus_name, CheckStatus_value)
-	proto.RegisterEnum("schema.FileMode", FileMode_name, FileMode_value)
-	proto.RegisterType((*Metadata)(nil), "schema.Metadata")
-	proto.RegisterType((*Chunk)(nil), "schema.Chunk")
-	proto.RegisterType((*Object)(nil), "schema.Object")
-	proto.RegisterType((*WriteRequest)(nil), "schema.WriteRequest")
-	proto.RegisterType((*WriteResponse)(nil), "schema.WriteResponse")
-	proto.RegisterType((*WriteFileRequest)(nil), "schema.WriteFileRequest")
-	proto.RegisterType((*WriteFileResponse)(nil), "schema.WriteFileResponse")
-	proto.RegisterType((*WriteStreamRequest)(nil), "schema.WriteStreamRequest")
-	proto.RegisterType((*WriteStreamRequest_Metadata)(nil), "schema.WriteStreamRequest.Metadata")
-	proto.RegisterType((*WriteStreamRequest_Data)(nil), "schema.WriteStreamRequest.Data")
-	proto.RegisterType((*WriteStreamResponse)(nil), "schema.WriteStreamResponse")
-	proto.RegisterType((*ReadRequest)(nil), "schema.ReadRequest")
-	proto.RegisterType((*ReadResponse)(nil), "schema.ReadResponse")
-	proto.RegisterType((*ReadFileRequest)(nil), "schema.ReadFileRequest")
-	proto.RegisterType((*ReadFileResponse)(nil), "schema.ReadFileResponse")
-	proto.RegisterType((*ReadStreamRequest)(nil), "schema.ReadStreamRequest")
-	proto.RegisterType((*ReadStreamResponse)(nil), "schema.ReadStreamResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "schema.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "schema.DeleteResponse")
-	proto.RegisterType((*CheckRequest)(nil), "schema.CheckRequest")
-	proto.RegisterType((*CheckResponse)(nil), "schema.CheckResponse")
-	proto.RegisterType((*RepairRequest)(nil), "schema.RepairRequest")
-	proto.RegisterType((*RepairResponse)(nil), "schema.RepairResponse")
-	proto.RegisterType((*SetMetadataRequest)(nil), "schema.SetMetadataRequest")
-	proto.RegisterType((*SetMetadataResponse)(nil), "schema.SetMetadataResponse")
-	proto.RegisterType((*GetMetadataRequest)(nil), "schema.GetMetadataRequest")
-	proto.RegisterType((*GetMetadataResponse)(nil), "schema.GetMetadataResponse")
-	proto.RegisterType((*DeleteMetadataRequest)(nil), "schema.DeleteMetadataRequest")
-	proto.RegisterType((*DeleteMetadataResponse)(nil), "schema.DeleteMetadataResponse")
-	proto.RegisterType((*ListMetadataKeysRequest)(nil), "schema.ListMetadataKeysRequest")
-	proto.RegisterType((*ListMetadataKeysResponse)(nil), "schema.ListMetadataKeysResponse")
-	proto.RegisterType((*DataWriteRequest)(nil), "schema.DataWriteRequest")
-	proto.RegisterType((*DataWriteResponse)(nil), "schema.DataWriteResponse")
-	proto.RegisterType((*DataWriteFileRequest)(nil), "schema.DataWriteFileRequest")
-	proto.RegisterType((*DataWriteFileResponse)(nil), "schema.DataWriteFileResponse")
-	proto.RegisterType((*DataWriteStreamRequest)(nil), "schema.DataWriteStreamRequest")
-	proto.RegisterType((*DataWriteStreamResponse)(nil), "schema.DataWriteStreamResponse")
-	proto.RegisterType((*DataReadRequest)(nil), "schema.DataReadRequest")
-	proto.RegisterType((*DataReadResponse)(nil), "schema.DataReadResponse")
-	proto.RegisterType((*DataReadFileRequest)(nil), "schema.DataReadFileRequest")
-	proto.RegisterType((*DataReadFileResponse)(nil), "schema.DataReadFileResponse")
-	proto.RegisterType((*DataReadStreamRequest)(nil), "schema.DataReadStreamRequest")
-	proto.RegisterType((*DataReadStreamResponse)(nil), "schema.DataReadStreamResponse")
-	proto.RegisterType((*DataDeleteRequest)(nil), "schema.DataDeleteRequest")
-	proto.RegisterType((*DataDeleteResponse)(nil), "schema.DataDeleteResponse")
-	proto.RegisterType((*DataCheckRequest)(nil), "schema.DataCheckRequest")
-	proto.RegisterType((*DataCheckResponse)(nil), "schema.DataCheckResponse")
-	proto.RegisterType((*DataRepairRequest)(nil), "schema.DataRepairRequest")
-	proto.RegisterType((*DataRepairResponse)(nil), "schema.DataRepairResponse")
+type DataWriteStreamResponse struct {
+	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
 }
 
-func init() { proto.RegisterFile("schema/daemon.proto", fileDescriptor_79298d76542483a2) }
-
-var fileDescriptor_79298d76542483a2 = []byte{
-	// 1379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x8f, 0xdb, 0xc4,
-	0x1b, 0xb7, 0x93, 0x6c, 0x9a, 0x3e, 0x79, 0x69, 0x76, 0xb2, 0x2f, 0x5e, 0x77, 0xeb, 0xee, 0xdf,
-	0xff, 0x52, 0x85, 0x52, 0x2d, 0x28, 0x2d, 0x15, 0x50, 0x58, 0xba, 0x2f, 0x6d, 0x5a, 0x4a, 0xb5,
-	0xe0, 0x20, 0x90, 0x50, 0x2f, 0xae, 0x33, 0x55, 0xcc, 0x66, 0xe3, 0x10, 0x3b, 0x15, 0xe5, 0x80,
-	0x38, 0x73, 0xe2, 0x63, 0x70, 0x40, 0xe2, 0x3b, 0x70, 0x42, 0x42, 0x42, 0x3d, 0x56, 0xe2, 0x42,
-	0xd3, 0x0b, 0xc7, 0x7e, 0x04, 0xe4, 0xf1, 0xcc, 0x78, 0xc6, 0x76, 0xd2, 0xa4, 0x2c, 0x37, 0xe7,
-	0x79, 0xf9, 0xcd, 0xf3, 0xf6, 0x7b, 0x66, 0x76, 0xa1, 0xe1, 0x3b, 0x3d, 0x7c, 0x6c, 0xbf, 0xd9,
-	0xb5, 0xf1, 0xb1, 0x37, 0xd8, 0x1e, 0x8e, 0xbc, 0xc0, 0x43, 0xc5, 0x48, 0x68, 0xfe, 0xa2, 0x42,
-	0xe9, 0x1e, 0x0e, 0xec, 0xae, 0x1d, 0xd8, 0xa8, 0x0e, 0xf9, 0x23, 0xfc, 0x58, 0x53, 0xb7, 0xd4,
-	0x66, 0xc5, 0x0a, 0x3f, 0xd1, 0x26, 0x9c, 0x0e, 0xbc, 0xc0, 0xee, 0x77, 0xdc, 0x6f, 0xb1, 0x96,
-	0xdb, 0x52, 0x9b, 0x79, 0x2b, 0x16, 0xa0, 0x0b, 0x50, 0x75, 0x46, 0xd8, 0x0e, 0x5c, 0x6f, 0x70,
-	0x73, 0xe8, 0x39, 0x3d, 0x2d, 0x4f, 0x2c, 0x64, 0x21, 0xba, 0x08, 0xb5, 0xbe, 0xed, 0x07, 0x5f,
-	0x8c, 0xdc, 0x00, 0x47, 0x66, 0x05, 0x62, 0x96, 0x90, 0xa2, 0xd7, 0xa0, 0xe8, 0xf4, 0xc6, 0x83,
-	0x23, 0x5f, 0x5b, 0xda, 0xca, 0x37, 0xcb, 0xad, 0xea, 0x76, 0x14, 0xe3, 0xf6, 0x7e, 0x28, 0xb5,
-	0xa8, 0xd2, 0x74, 0x60, 0x89, 0x08, 0xc2, 0xd8, 0x88, 0x88, 0xc4, 0xa6, 0x46, 0xb1, 0x71, 0x01,
-	0x6a, 0xc2, 0x29, 0xef, 0xc1, 0x57, 0xd8, 0x09, 0x7c, 0x2d, 0x47, 0xe0, 0x6a, 0x0c, 0xee, 0x90,
-	0x88, 0x2d, 0xa6, 0x46, 0x08, 0x0a, 0x3d, 0xdb, 0x8f, 0x82, 0xaf, 0x58, 0xe4, 0xdb, 0xbc, 0x0a,
-	0xc5, 0xc8, 0x2c, 0xa3, 0x26, 0x1a, 0x9c, 0xf2, 0x7b, 0xf6, 0xa8, 0x7b, 0xe7, 0x80, 0x54, 0xe4,
-	0xb4, 0xc5, 0x7e, 0x9a, 0x57, 0xa1, 0x42, 0xf2, 0xb1, 0xf0, 0xd7, 0x63, 0xec, 0x67, 0xf9, 0x22,
-	0x28, 0x84, 0x95, 0x26, 0x8e, 0x15, 0x8b, 0x7c, 0x9b, 0x1f, 0x40, 0x95, 0x7a, 0xf9, 0x43, 0x6f,
-	0xe0, 0x63, 0x74, 0x19, 0x4a, 0xc7, 0xb4, 0x25, 0xc4, 0xb7, 0xdc, 0xaa, 0xb3, 0xd8, 0x59, 0xab,
-	0x2c, 0x6e, 0x61, 0xde, 0x80, 0x3a, 0x71, 0xbf, 0xe5, 0xf6, 0x67, 0x1c, 0xac, 0x43, 0xe9, 0xa1,
-	0xdb, 0xc7, 0x9f, 0xd8, 0x41, 0x8f, 0x46, 0xcd, 0x7f, 0x9b, 0xbb, 0xb0, 0x2c, 0x20, 0xbc, 0x52,
-	0x10, 0x7f, 0xaa, 0x80, 0x08, 0x46, 0x27, 0x18, 0x61, 0xfb, 0x98, 0xc5, 0xb1, 0x9b, 0x02, 0xf9,
-	0x3f, 0x03, 0x49, 0x5b, 0x73, 0xdc, 0xdb, 0x4a, 0x8c, 0x8c, 0xde, 0x16, 0x2a, 0x56, 0x6e, 0x9d,
-	0x9f, 0xe1, 0x7e, 0x10, 0xb9, 0x12, 0x73, 0x7d, 0x73, 0xd6, 0x58, 0xeb, 0x17, 0xa0, 0x10, 0x5a,
-	0x87, 0x23, 0x14, 0x5a, 0x90, 0x79, 0xa2, 0x3d, 0x89, 0x05, 0x7b, 0xa7, 0x60, 0xc9, 0x1d, 0x0c,
-	0xc7, 0x81, 0xb9, 0x0f, 0x0d, 0xe9, 0xbc, 0x57, 0x2a, 0xd1, 0x97, 0x50, 0xb6, 0xb0, 0xdd, 0x65,
-	0xa5, 0x41, 0x42, 0x50, 0xb7, 0x95, 0xa8, 0x49, 0xdb, 0x02, 0x60, 0x2e, 0x1b, 0x50, 0xac, 0x4d,
-	0x1c, 0xa0, 0x09, 0x95, 0x08, 0x9b, 0x46, 0xc6, 0xc6, 0x4c, 0x15, 0xc6, 0xec, 0x0f, 0x15, 0xce,
-	0x84, 0x46, 0xe2, 0x9c, 0x9c, 0x40, 0x10, 0xd2, 0x64, 0xe5, 0xe5, 0xc9, 0x0a, 0x2b, 0x14, 0x7e,
-	0xdf, 0xf3, 0xba, 0x98, 0x90, 0xbe, 0x16, 0x63, 0xdd, 0xa2, 0x72, 0x8b, 0x5b, 0x84, 0xeb, 0xc4,
-	0x7f, 0x3c, 0x70, 0x7a, 0x23, 0x6f, 0xe0, 0x8d, 0xfd, 0x3b, 0x87, 0xda, 0xd2, 0x96, 0xda, 0x2c,
-	0x59, 0xb2, 0x30, 0x4e, 0x1a, 0x41, 0x3d, 0xce, 0x27, 0x4a, 0xdc, 0xfc, 0x0e, 0x96, 0x43, 0x99,
-	0x3c, 0x85, 0x27, 0x91, 0xa5, 0xb4, 0x6c, 0xf2, 0x89, 0x65, 0x13, 0xc7, 0xd4, 0x02, 0x24, 0x9e,
-	0x4f, 0xdb, 0x21, 0x8d, 0x99, 0x9a, 0x18, 0x33, 0xf3, 0x3e, 0x54, 0x0f, 0x70, 0x1f, 0x07, 0xf8,
-	0x3f, 0x19, 0x8d, 0x3a, 0xd4, 0x18, 0x3a, 0xad, 0x91, 0x07, 0x95, 0xfd, 0x1e, 0x76, 0x8e, 0x4e,
-	0xb2, 0x3c, 0x08, 0x0a, 0x0f, 0x6d, 0x3f, 0x20, 0x95, 0x29, 0x59, 0xe4, 0x3b, 0x0e, 0xe1, 0x7d,
-	0xa8, 0xd2, 0x03, 0x69, 0x3d, 0xde, 0x80, 0xa2, 0x1f, 0xd8, 0xc1, 0xd8, 0x27, 0x87, 0xd6, 0x5a,
-	0x8d, 0x78, 0xd3, 0x63, 0xe7, 0xa8, 0x43, 0x54, 0x16, 0x35, 0x31, 0xff, 0x07, 0x55, 0x0b, 0x0f,
-	0x6d, 0x77, 0x34, 0x75, 0xb9, 0x99, 0x3b, 0x50, 0x63, 0x26, 0xaf, 0x44, 0xcd, 0x3d, 0x40, 0x1d,
-	0x1c, 0x70, 0x05, 0x3d, 0x67, 0x31, 0x8c, 0x55, 0x68, 0x48, 0x18, 0xb4, 0xd8, 0x17, 0x01, 0xb5,
-	0xd3, 0xd0, 0xe9, 0x14, 0xf6, 0xa1, 0xd1, 0x4e, 0xbb, 0x2f, 0x18, 0xc3, 0xeb, 0xb0, 0x1a, 0xf5,
-	0xfa, 0xe5, 0xe7, 0x69, 0xb0, 0x96, 0x34, 0xa5, 0x11, 0x6f, 0xc0, 0xfa, 0xc7, 0xae, 0xcf, 0x43,
-	0xb9, 0x8b, 0x1f, 0xfb, 0x14, 0xc6, 0xbc, 0x0c, 0x5a, 0x5a, 0x45, 0x23, 0x4d, 0x1f, 0x71, 0x11,
-	0xea, 0xe1, 0x92, 0x95, 0x6e, 0xc4, 0xac, 0xc5, 0xf4, 0x1e, 0x2c, 0x0b, 0x76, 0x14, 0x2e, 0x7e,
-	0x0c, 0xa8, 0xb3, 0x1e, 0x03, 0x2d, 0x58, 0xe1, 0xbe, 0xe2, 0x62, 0x13, 0x97, 0x92, 0x9a, 0xb8,
-	0xee, 0x76, 0x60, 0x35, 0xe1, 0xb3, 0xd8, 0x99, 0xd7, 0x60, 0x8d, 0xfb, 0xcb, 0x8b, 0x66, 0x36,
-	0xcf, 0x6f, 0xc0, 0x7a, 0xca, 0x6f, 0xb1, 0x93, 0xdf, 0x81, 0x33, 0x07, 0xa4, 0x55, 0xf1, 0x35,
-	0x32, 0xa7, 0x27, 0xed, 0xc5, 0x4b, 0x2f, 0x89, 0x9f, 0x55, 0x68, 0x30, 0x43, 0xb1, 0x9e, 0xf3,
-	0x1d, 0x33, 0xeb, 0x95, 0x21, 0xdd, 0x05, 0xf9, 0xc5, 0xef, 0x82, 0x42, 0xc6, 0x5d, 0x60, 0xae,
-	0x45, 0xed, 0x4f, 0x5d, 0x03, 0xf7, 0xa3, 0x16, 0xa7, 0xaf, 0x82, 0x39, 0xf3, 0x90, 0xb6, 0x7d,
-	0x2e, 0xb1, 0xed, 0xd9, 0x00, 0x2c, 0xbc, 0xe8, 0xe9, 0xa0, 0xcb, 0xcb, 0x7e, 0xce, 0x06, 0xae,
-	0x00, 0x12, 0x7d, 0x69, 0x9e, 0xf7, 0xa2, 0xb6, 0x4a, 0xeb, 0x7c, 0xce, 0x14, 0xd9, 0xc6, 0xce,
-	0xc5, 0x1b, 0xdb, 0xbc, 0x11, 0x05, 0xf8, 0x2f, 0x96, 0x35, 0x4d, 0x51, 0x5e, 0xd8, 0x73, 0xa6,
-	0x78, 0x3d, 0x4a, 0x31, 0xb1, 0xc9, 0xe7, 0x73, 0xbe, 0xd4, 0x81, 0xb2, 0x10, 0x0f, 0x5a, 0x03,
-	0x24, 0xfc, 0xbc, 0x33, 0x78, 0x64, 0xf7, 0xdd, 0x6e, 0x5d, 0x41, 0x2b, 0x50, 0x17, 0xe4, 0x9f,
-	0x13, 0xa9, 0x9a, 0xb0, 0x3e, 0x1c, 0x06, 0xee, 0xb1, 0xdd, 0xaf, 0xe7, 0x2e, 0xdd, 0x85, 0x12,
-	0x1b, 0xcd, 0xd0, 0x93, 0x7d, 0x7f, 0x36, 0x1a, 0x0f, 0x1c, 0x3b, 0xc0, 0x75, 0x05, 0x21, 0xa8,
-	0x31, 0xe9, 0xee, 0x70, 0x88, 0x07, 0x21, 0xda, 0x2a, 0x2c, 0x33, 0xd9, 0xcd, 0x6f, 0x9c, 0xfe,
-	0xd8, 0x77, 0x1f, 0xe1, 0x7a, 0xae, 0xf5, 0x6b, 0x01, 0xca, 0xa1, 0xbc, 0x83, 0x47, 0x8f, 0x5c,
-	0x07, 0xa3, 0x6b, 0xb0, 0x44, 0x56, 0x01, 0x5a, 0x91, 0xde, 0xb4, 0xb4, 0x68, 0xfa, 0x6a, 0x42,
-	0x4a, 0x3b, 0xae, 0xa0, 0x3d, 0x38, 0xcd, 0x57, 0x17, 0xd2, 0x24, 0x2b, 0x81, 0xb1, 0xfa, 0x46,
-	0x86, 0x86, 0x63, 0x7c, 0x04, 0x65, 0x61, 0x0d, 0x21, 0x7d, 0xfa, 0xab, 0x5a, 0x3f, 0x9b, 0xa9,
-	0x63, 0x48, 0x4d, 0x15, 0x5d, 0x81, 0x42, 0xc8, 0x04, 0xc4, 0xe7, 0x42, 0x58, 0x4f, 0xfa, 0x8a,
-	0x2c, 0xe4, 0x01, 0x7c, 0x08, 0x25, 0x46, 0x5a, 0xb4, 0x2e, 0xda, 0x88, 0x29, 0x68, 0x69, 0x05,
-	0x07, 0x68, 0x03, 0xc4, 0xfc, 0x43, 0x1b, 0xa2, 0xa5, 0x1c, 0xbf, 0x9e, 0xa5, 0x62, 0x30, 0x6f,
-	0xa9, 0xe8, 0x5d, 0x28, 0x46, 0xa4, 0x42, 0xbc, 0xe2, 0x12, 0x41, 0xf5, 0xb5, 0xa4, 0x98, 0xc7,
-	0x70, 0x2d, 0xfc, 0x4b, 0x14, 0x3b, 0x47, 0x71, 0x07, 0x45, 0x22, 0xc6, 0x1d, 0x94, 0xf8, 0x64,
-	0x2a, 0xe1, 0x91, 0xd1, 0x90, 0xc7, 0x47, 0x4a, 0x84, 0x89, 0x8f, 0x94, 0xb9, 0x60, 0x2a, 0xad,
-	0xdf, 0x73, 0x70, 0x86, 0x5d, 0xbf, 0x6c, 0x90, 0x6e, 0x43, 0x59, 0x78, 0x79, 0xc4, 0xcd, 0x4c,
-	0x3f, 0x69, 0xe2, 0x66, 0x66, 0x3d, 0x55, 0x94, 0x10, 0xa9, 0x9d, 0x85, 0xd4, 0x9e, 0x81, 0xd4,
-	0xce, 0x44, 0xfa, 0x94, 0xbd, 0x3a, 0x39, 0xd8, 0x39, 0xb9, 0x8c, 0x49, 0x3c, 0x63, 0x9a, 0x5a,
-	0x80, 0x2c, 0x85, 0x8f, 0x8f, 0xf0, 0xd1, 0x81, 0xf8, 0x9f, 0x81, 0x53, 0x5e, 0x2a, 0xfa, 0xd6,
-	0x74, 0x83, 0xb8, 0xf7, 0xad, 0x1f, 0x96, 0xa0, 0x7c, 0x20, 0x54, 0x72, 0x87, 0x51, 0x92, 0x4f,
-	0x5e, 0xf2, 0x01, 0x13, 0xd3, 0x2a, 0xf5, 0x64, 0x21, 0xb4, 0x12, 0xa8, 0xb9, 0x99, 0xb2, 0x14,
-	0x67, 0xfb, 0xdc, 0x14, 0x2d, 0xc7, 0xb2, 0x64, 0x8a, 0x1a, 0x29, 0x7b, 0x79, 0xcc, 0xcf, 0x4f,
-	0xd5, 0x0b, 0x54, 0xbd, 0x4e, 0xa9, 0xba, 0x2e, 0x1a, 0x8b, 0x74, 0xd5, 0xd2, 0x0a, 0x81, 0x71,
-	0x31, 0x65, 0xcf, 0x26, 0xed, 0xc4, 0xd4, 0x36, 0xb3, 0x95, 0x1c, 0xe8, 0x50, 0xa2, 0xee, 0xb9,
-	0xa4, 0xb5, 0x9c, 0x97, 0x31, 0x4d, 0x2d, 0x50, 0x78, 0x97, 0x53, 0x58, 0xea, 0x8e, 0x4c, 0x63,
-	0x3d, 0x4b, 0xc5, 0x63, 0xda, 0x61, 0x54, 0x96, 0x2a, 0x20, 0xd1, 0x79, 0x23, 0x43, 0xc3, 0xfd,
-	0x77, 0x39, 0xa5, 0x37, 0xe4, 0x80, 0x45, 0x5a, 0xeb, 0x59, 0x2a, 0x06, 0xb1, 0x77, 0xf5, 0xc9,
-	0x33, 0x43, 0x79, 0xfa, 0xcc, 0x50, 0x5e, 0x3c, 0x33, 0xd4, 0xef, 0x27, 0x86, 0xfa, 0xd3, 0xc4,
-	0x50, 0x7f, 0x9b, 0x18, 0xea, 0x93, 0x89, 0xa1, 0xfe, 0x35, 0x31, 0xd4, 0xbf, 0x27, 0x86, 0xf2,
-	0x62, 0x62, 0xa8, 0x3f, 0x3e, 0x37, 0x94, 0x27, 0xcf, 0x0d, 0xe5, 0xe9, 0x73, 0x43, 0x79, 0x50,
-	0x24, 0xff, 0xce, 0xbb, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x30, 0x05, 0xf6, 0xdd, 0xe5,
-	0x13, 0x00, 0x00,
+func (m *DataWriteStreamResponse) Reset()      { *m = DataWriteStreamResponse{} }
+func (*DataWriteStreamResponse) ProtoMessage() {}
+func (*DataWriteStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{47}
 }
-
-func (x CheckStatus) String() string {
-	s, ok := CheckStatus_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *DataWriteStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (x FileMode) String() string {
-	s, ok := FileMode_name[int32(x)]
-	if ok {
-		return s
+func (m *DataWriteStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataWriteStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (this *Metadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DataWriteStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataWriteStreamResponse.Merge(m, src)
+}
+func (m *DataWriteStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataWriteStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataWriteStreamResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Metadata)
-	if !ok {
-		that2, ok := that.(Metadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
-	}
-	if this.TotalSize != that1.TotalSize {
-		return false
-	}
-	if this.CreationEpoch != that1.CreationEpoch {
-		return false
-	}
-	if this.LastWriteEpoch != that1.LastWriteEpoch {
-		return false
-	}
-	if len(this.Chunks) != len(that1.Chunks) {
-		return false
-	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
-			return false
-		}
+var xxx_messageInfo_DataWriteStreamResponse proto.InternalMessageInfo
+
+func (m *DataWriteStreamResponse) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
 	}
-	return true
+	return nil
 }
-func (this *Chunk) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Chunk)
-	if !ok {
-		that2, ok := that.(Chunk)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DataReadRequest struct {
+	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (m *DataReadRequest) Reset()      { *m = DataReadRequest{} }
+func (*DataReadRequest) ProtoMessage() {}
+func (*DataReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{48}
+}
+func (m *DataReadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataReadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ChunkSize != that1.ChunkSize {
-		return false
-	}
-	if len(this.Objects) != len(that1.Objects) {
-		return false
-	}
-	for i := range this.Objects {
-		if !this.Objects[i].Equal(that1.Objects[i]) {
-			return false
-		}
-	}
-	if !bytes.Equal(this.Hash, that1.Hash) {
-		return false
-	}
-	return true
 }
-func (this *Object) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DataReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataReadRequest.Merge(m, src)
+}
+func (m *DataReadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataReadRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Object)
-	if !ok {
-		that2, ok := that.(Object)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
-	}
-	if this.ShardID != that1.ShardID {
-		return false
+var xxx_messageInfo_DataReadRequest proto.InternalMessageInfo
+
+func (m *DataReadRequest) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
 	}
-	return true
+	return nil
 }
-func (this *WriteRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*WriteRequest)
-	if !ok {
-		that2, ok := that.(WriteRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
-	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
-	}
-	return true
+type DataReadResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
-func (this *WriteResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*WriteResponse)
-	if !ok {
-		that2, ok := that.(WriteResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DataReadResponse) Reset()      { *m = DataReadResponse{} }
+func (*DataReadResponse) ProtoMessage() {}
+func (*DataReadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{49}
+}
+func (m *DataReadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataReadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataReadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Metadata.Equal(that1.Metadata) {
-		return false
-	}
-	return true
 }
-func (this *WriteFileRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DataReadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataReadResponse.Merge(m, src)
+}
+func (m *DataReadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataReadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataReadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataReadResponse proto.InternalMessageInfo
+
+func (m *DataReadResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
+	return nil
+}
 
-	that1, ok := that.(*WriteFileRequest)
-	if !ok {
-		that2, ok := that.(WriteFileRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DataReadFileRequest struct {
+	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	// destination file and its configuration
+	FilePath      string   `protobuf:"bytes,2,opt,name=filePath,proto3" json:"filePath,omitempty"`
+	FileMode      FileMode `protobuf:"varint,3,opt,name=fileMode,proto3,enum=schema.FileMode" json:"fileMode,omitempty"`
+	SynchronousIO bool     `protobuf:"varint,4,opt,name=synchronousIO,proto3" json:"synchronousIO,omitempty"`
+}
+
+func (m *DataReadFileRequest) Reset()      { *m = DataReadFileRequest{} }
+func (*DataReadFileRequest) ProtoMessage() {}
+func (*DataReadFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{50}
+}
+func (m *DataReadFileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataReadFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataReadFileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DataReadFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataReadFileRequest.Merge(m, src)
+}
+func (m *DataReadFileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataReadFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataReadFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataReadFileRequest proto.InternalMessageInfo
+
+func (m *DataReadFileRequest) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
+	return nil
+}
+
+func (m *DataReadFileRequest) GetFilePath() string {
+	if m != nil {
+		return m.FilePath
 	}
-	if this.FilePath != that1.FilePath {
-		return false
+	return ""
+}
+
+func (m *DataReadFileRequest) GetFileMode() FileMode {
+	if m != nil {
+		return m.FileMode
 	}
-	return true
+	return FileModeTruncate
 }
-func (this *WriteFileResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DataReadFileRequest) GetSynchronousIO() bool {
+	if m != nil {
+		return m.SynchronousIO
 	}
+	return false
+}
 
-	that1, ok := that.(*WriteFileResponse)
-	if !ok {
-		that2, ok := that.(WriteFileResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DataReadFileResponse struct {
+}
+
+func (m *DataReadFileResponse) Reset()      { *m = DataReadFileResponse{} }
+func (*DataReadFileResponse) ProtoMessage() {}
+func (*DataReadFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{51}
+}
+func (m *DataReadFileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataReadFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataReadFileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Metadata.Equal(that1.Metadata) {
-		return false
-	}
-	return true
 }
-func (this *WriteStreamRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DataReadFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataReadFileResponse.Merge(m, src)
+}
+func (m *DataReadFileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataReadFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataReadFileResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*WriteStreamRequest)
-	if !ok {
-		that2, ok := that.(WriteStreamRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.Input == nil {
-		if this.Input != nil {
-			return false
-		}
-	} else if this.Input == nil {
-		return false
-	} else if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	return true
+var xxx_messageInfo_DataReadFileResponse proto.InternalMessageInfo
+
+type DataReadStreamRequest struct {
+	Chunks    []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	ChunkSize int64    `protobuf:"varint,2,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
 }
-func (this *WriteStreamRequest_Metadata_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*WriteStreamRequest_Metadata_)
-	if !ok {
-		that2, ok := that.(WriteStreamRequest_Metadata_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DataReadStreamRequest) Reset()      { *m = DataReadStreamRequest{} }
+func (*DataReadStreamRequest) ProtoMessage() {}
+func (*DataReadStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{52}
+}
+func (m *DataReadStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataReadStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataReadStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Metadata.Equal(that1.Metadata) {
-		return false
-	}
-	return true
 }
-func (this *WriteStreamRequest_Data_) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DataReadStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataReadStreamRequest.Merge(m, src)
+}
+func (m *DataReadStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataReadStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataReadStreamRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*WriteStreamRequest_Data_)
-	if !ok {
-		that2, ok := that.(WriteStreamRequest_Data_)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Data.Equal(that1.Data) {
-		return false
+var xxx_messageInfo_DataReadStreamRequest proto.InternalMessageInfo
+
+func (m *DataReadStreamRequest) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
 	}
-	return true
+	return nil
 }
-func (this *WriteStreamRequest_Metadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*WriteStreamRequest_Metadata)
-	if !ok {
-		that2, ok := that.(WriteStreamRequest_Metadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
+func (m *DataReadStreamRequest) GetChunkSize() int64 {
+	if m != nil {
+		return m.ChunkSize
 	}
-	return true
+	return 0
 }
-func (this *WriteStreamRequest_Data) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*WriteStreamRequest_Data)
-	if !ok {
-		that2, ok := that.(WriteStreamRequest_Data)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DataReadStreamResponse struct {
+	DataChunk []byte `protobuf:"bytes,1,opt,name=dataChunk,proto3" json:"dataChunk,omitempty"`
+}
+
+func (m *DataReadStreamResponse) Reset()      { *m = DataReadStreamResponse{} }
+func (*DataReadStreamResponse) ProtoMessage() {}
+func (*DataReadStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{53}
+}
+func (m *DataReadStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataReadStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataReadStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
-		return false
-	}
-	return true
 }
-func (this *WriteStreamResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DataReadStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataReadStreamResponse.Merge(m, src)
+}
+func (m *DataReadStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataReadStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataReadStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataReadStreamResponse proto.InternalMessageInfo
+
+func (m *DataReadStreamResponse) GetDataChunk() []byte {
+	if m != nil {
+		return m.DataChunk
 	}
+	return nil
+}
 
-	that1, ok := that.(*WriteStreamResponse)
-	if !ok {
-		that2, ok := that.(WriteStreamResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DataDeleteRequest struct {
+	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (m *DataDeleteRequest) Reset()      { *m = DataDeleteRequest{} }
+func (*DataDeleteRequest) ProtoMessage() {}
+func (*DataDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{54}
+}
+func (m *DataDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Metadata.Equal(that1.Metadata) {
-		return false
-	}
-	return true
 }
-func (this *ReadRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DataDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataDeleteRequest.Merge(m, src)
+}
+func (m *DataDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataDeleteRequest proto.InternalMessageInfo
+
+func (m *DataDeleteRequest) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
 	}
+	return nil
+}
 
-	that1, ok := that.(*ReadRequest)
-	if !ok {
-		that2, ok := that.(ReadRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.Input == nil {
-		if this.Input != nil {
-			return false
+type DataDeleteResponse struct {
+}
+
+func (m *DataDeleteResponse) Reset()      { *m = DataDeleteResponse{} }
+func (*DataDeleteResponse) ProtoMessage() {}
+func (*DataDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{55}
+}
+func (m *DataDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataDeleteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Input == nil {
-		return false
-	} else if !this.Input.Equal(that1.Input) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ReadRequest_Key) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DataDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataDeleteResponse.Merge(m, src)
+}
+func (m *DataDeleteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataDeleteResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ReadRequest_Key)
-	if !ok {
-		that2, ok := that.(ReadRequest_Key)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_DataDeleteResponse proto.InternalMessageInfo
+
+type DataCheckRequest struct {
+	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	Fast   bool     `protobuf:"varint,2,opt,name=fast,proto3" json:"fast,omitempty"`
+}
+
+func (m *DataCheckRequest) Reset()      { *m = DataCheckRequest{} }
+func (*DataCheckRequest) ProtoMessage() {}
+func (*DataCheckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{56}
+}
+func (m *DataCheckRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataCheckRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
+}
+func (m *DataCheckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataCheckRequest.Merge(m, src)
+}
+func (m *DataCheckRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataCheckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataCheckRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataCheckRequest proto.InternalMessageInfo
+
+func (m *DataCheckRequest) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
 	}
-	return true
+	return nil
 }
-func (this *ReadRequest_Metadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DataCheckRequest) GetFast() bool {
+	if m != nil {
+		return m.Fast
 	}
+	return false
+}
 
-	that1, ok := that.(*ReadRequest_Metadata)
-	if !ok {
-		that2, ok := that.(ReadRequest_Metadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DataCheckResponse struct {
+	Status CheckStatus `protobuf:"varint,1,opt,name=status,proto3,enum=schema.CheckStatus" json:"status,omitempty"`
+}
+
+func (m *DataCheckResponse) Reset()      { *m = DataCheckResponse{} }
+func (*DataCheckResponse) ProtoMessage() {}
+func (*DataCheckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{57}
+}
+func (m *DataCheckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataCheckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Metadata.Equal(that1.Metadata) {
-		return false
-	}
-	return true
 }
-func (this *ReadResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DataCheckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataCheckResponse.Merge(m, src)
+}
+func (m *DataCheckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataCheckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataCheckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataCheckResponse proto.InternalMessageInfo
+
+func (m *DataCheckResponse) GetStatus() CheckStatus {
+	if m != nil {
+		return m.Status
 	}
+	return CheckStatusInvalid
+}
 
-	that1, ok := that.(*ReadResponse)
-	if !ok {
-		that2, ok := that.(ReadResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DataRepairRequest struct {
+	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (m *DataRepairRequest) Reset()      { *m = DataRepairRequest{} }
+func (*DataRepairRequest) ProtoMessage() {}
+func (*DataRepairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{58}
+}
+func (m *DataRepairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRepairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRepairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
-	}
-	return true
 }
-func (this *ReadFileRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DataRepairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRepairRequest.Merge(m, src)
+}
+func (m *DataRepairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRepairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRepairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRepairRequest proto.InternalMessageInfo
+
+func (m *DataRepairRequest) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
 	}
+	return nil
+}
 
-	that1, ok := that.(*ReadFileRequest)
-	if !ok {
-		that2, ok := that.(ReadFileRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DataRepairResponse struct {
+	Chunks []*Chunk `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (m *DataRepairResponse) Reset()      { *m = DataRepairResponse{} }
+func (*DataRepairResponse) ProtoMessage() {}
+func (*DataRepairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{59}
+}
+func (m *DataRepairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRepairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRepairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DataRepairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRepairResponse.Merge(m, src)
+}
+func (m *DataRepairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRepairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRepairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRepairResponse proto.InternalMessageInfo
+
+func (m *DataRepairResponse) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
 	}
-	if that1.Input == nil {
-		if this.Input != nil {
-			return false
+	return nil
+}
+
+type DataShardHealthRequest struct {
+}
+
+func (m *DataShardHealthRequest) Reset()      { *m = DataShardHealthRequest{} }
+func (*DataShardHealthRequest) ProtoMessage() {}
+func (*DataShardHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{60}
+}
+func (m *DataShardHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataShardHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataShardHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Input == nil {
-		return false
-	} else if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	if this.FilePath != that1.FilePath {
-		return false
-	}
-	if this.FileMode != that1.FileMode {
-		return false
-	}
-	if this.SynchronousIO != that1.SynchronousIO {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ReadFileRequest_Key) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DataShardHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataShardHealthRequest.Merge(m, src)
+}
+func (m *DataShardHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataShardHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataShardHealthRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ReadFileRequest_Key)
-	if !ok {
-		that2, ok := that.(ReadFileRequest_Key)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_DataShardHealthRequest proto.InternalMessageInfo
+
+type DataShardHealthResponse struct {
+	Shards []*ShardHealth `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+}
+
+func (m *DataShardHealthResponse) Reset()      { *m = DataShardHealthResponse{} }
+func (*DataShardHealthResponse) ProtoMessage() {}
+func (*DataShardHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{61}
+}
+func (m *DataShardHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataShardHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataShardHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
-	}
-	return true
 }
-func (this *ReadFileRequest_Metadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DataShardHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataShardHealthResponse.Merge(m, src)
+}
+func (m *DataShardHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataShardHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataShardHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataShardHealthResponse proto.InternalMessageInfo
+
+func (m *DataShardHealthResponse) GetShards() []*ShardHealth {
+	if m != nil {
+		return m.Shards
 	}
+	return nil
+}
 
-	that1, ok := that.(*ReadFileRequest_Metadata)
-	if !ok {
-		that2, ok := that.(ReadFileRequest_Metadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ShardHealth struct {
+	// identifier of the shard
+	Shard string     `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	State ShardState `protobuf:"varint,2,opt,name=state,proto3,enum=schema.ShardState" json:"state,omitempty"`
+	// moment the shard got into its current state,
+	// in the Unix epoch format, in nano seconds.
+	Since int64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	// amount of consecutive failed calls
+	Failures int64 `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	// error of the last failed call, if any
+	LastError string `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	// moment of the last failed call, if any,
+	// in the Unix epoch format, in nano seconds.
+	LastErrorTime int64 `protobuf:"varint,6,opt,name=lastErrorTime,proto3" json:"lastErrorTime,omitempty"`
+	// (moving) average latency of successful calls, in nano seconds.
+	Latency int64 `protobuf:"varint,7,opt,name=latency,proto3" json:"latency,omitempty"`
+	// health counters of the namespace, as collected by the last probe, if any
+	Namespace *NamespaceHealth `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *ShardHealth) Reset()      { *m = ShardHealth{} }
+func (*ShardHealth) ProtoMessage() {}
+func (*ShardHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{62}
+}
+func (m *ShardHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ShardHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardHealth.Merge(m, src)
+}
+func (m *ShardHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardHealth proto.InternalMessageInfo
+
+func (m *ShardHealth) GetShard() string {
+	if m != nil {
+		return m.Shard
 	}
-	if !this.Metadata.Equal(that1.Metadata) {
-		return false
+	return ""
+}
+
+func (m *ShardHealth) GetState() ShardState {
+	if m != nil {
+		return m.State
 	}
-	return true
+	return ShardStateHealthy
 }
-func (this *ReadFileResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ShardHealth) GetSince() int64 {
+	if m != nil {
+		return m.Since
 	}
+	return 0
+}
 
-	that1, ok := that.(*ReadFileResponse)
-	if !ok {
-		that2, ok := that.(ReadFileResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ShardHealth) GetFailures() int64 {
+	if m != nil {
+		return m.Failures
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ShardHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	return true
+	return ""
 }
-func (this *ReadStreamRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ShardHealth) GetLastErrorTime() int64 {
+	if m != nil {
+		return m.LastErrorTime
 	}
+	return 0
+}
 
-	that1, ok := that.(*ReadStreamRequest)
-	if !ok {
-		that2, ok := that.(ReadStreamRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ShardHealth) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ShardHealth) GetNamespace() *NamespaceHealth {
+	if m != nil {
+		return m.Namespace
 	}
-	if that1.Input == nil {
-		if this.Input != nil {
-			return false
+	return nil
+}
+
+type NamespaceHealth struct {
+	DataIOErrors     int64 `protobuf:"varint,1,opt,name=dataIOErrors,proto3" json:"dataIOErrors,omitempty"`
+	IndexIOErrors    int64 `protobuf:"varint,2,opt,name=indexIOErrors,proto3" json:"indexIOErrors,omitempty"`
+	DataFaults       int64 `protobuf:"varint,3,opt,name=dataFaults,proto3" json:"dataFaults,omitempty"`
+	IndexFaults      int64 `protobuf:"varint,4,opt,name=indexFaults,proto3" json:"indexFaults,omitempty"`
+	DataIOErrorLast  int64 `protobuf:"varint,5,opt,name=dataIOErrorLast,proto3" json:"dataIOErrorLast,omitempty"`
+	IndexIOErrorLast int64 `protobuf:"varint,6,opt,name=indexIOErrorLast,proto3" json:"indexIOErrorLast,omitempty"`
+}
+
+func (m *NamespaceHealth) Reset()      { *m = NamespaceHealth{} }
+func (*NamespaceHealth) ProtoMessage() {}
+func (*NamespaceHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79298d76542483a2, []int{63}
+}
+func (m *NamespaceHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.Input == nil {
-		return false
-	} else if !this.Input.Equal(that1.Input) {
-		return false
+		return b[:n], nil
 	}
-	if this.ChunkSize != that1.ChunkSize {
-		return false
+}
+func (m *NamespaceHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceHealth.Merge(m, src)
+}
+func (m *NamespaceHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceHealth proto.InternalMessageInfo
+
+func (m *NamespaceHealth) GetDataIOErrors() int64 {
+	if m != nil {
+		return m.DataIOErrors
 	}
-	return true
+	return 0
 }
-func (this *ReadStreamRequest_Key) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *NamespaceHealth) GetIndexIOErrors() int64 {
+	if m != nil {
+		return m.IndexIOErrors
 	}
+	return 0
+}
 
-	that1, ok := that.(*ReadStreamRequest_Key)
-	if !ok {
-		that2, ok := that.(ReadStreamRequest_Key)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *NamespaceHealth) GetDataFaults() int64 {
+	if m != nil {
+		return m.DataFaults
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *NamespaceHealth) GetIndexFaults() int64 {
+	if m != nil {
+		return m.IndexFaults
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
+	return 0
+}
+
+func (m *NamespaceHealth) GetDataIOErrorLast() int64 {
+	if m != nil {
+		return m.DataIOErrorLast
 	}
-	return true
+	return 0
 }
-func (this *ReadStreamRequest_Metadata) Equal(that interface{}) bool {
+
+func (m *NamespaceHealth) GetIndexIOErrorLast() int64 {
+	if m != nil {
+		return m.IndexIOErrorLast
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("schema.CheckStatus", CheckStatus_name, CheckStatus_value)
+	proto.RegisterEnum("schema.FileMode", FileMode_name, FileMode_value)
+	proto.RegisterEnum("schema.ShardState", ShardState_name, ShardState_value)
+	proto.RegisterType((*Metadata)(nil), "schema.Metadata")
+	proto.RegisterType((*Chunk)(nil), "schema.Chunk")
+	proto.RegisterType((*Object)(nil), "schema.Object")
+	proto.RegisterType((*WriteRequest)(nil), "schema.WriteRequest")
+	proto.RegisterType((*WriteResponse)(nil), "schema.WriteResponse")
+	proto.RegisterType((*WriteFileRequest)(nil), "schema.WriteFileRequest")
+	proto.RegisterType((*WriteFileResponse)(nil), "schema.WriteFileResponse")
+	proto.RegisterType((*WriteStreamRequest)(nil), "schema.WriteStreamRequest")
+	proto.RegisterType((*WriteStreamRequest_Metadata)(nil), "schema.WriteStreamRequest.Metadata")
+	proto.RegisterType((*WriteStreamRequest_Data)(nil), "schema.WriteStreamRequest.Data")
+	proto.RegisterType((*WriteStreamResponse)(nil), "schema.WriteStreamResponse")
+	proto.RegisterType((*AppendRequest)(nil), "schema.AppendRequest")
+	proto.RegisterType((*AppendResponse)(nil), "schema.AppendResponse")
+	proto.RegisterType((*ReadRequest)(nil), "schema.ReadRequest")
+	proto.RegisterType((*ReadResponse)(nil), "schema.ReadResponse")
+	proto.RegisterType((*ReadFileRequest)(nil), "schema.ReadFileRequest")
+	proto.RegisterType((*ReadFileResponse)(nil), "schema.ReadFileResponse")
+	proto.RegisterType((*ReadStreamRequest)(nil), "schema.ReadStreamRequest")
+	proto.RegisterType((*ReadStreamResponse)(nil), "schema.ReadStreamResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "schema.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "schema.DeleteResponse")
+	proto.RegisterType((*CheckRequest)(nil), "schema.CheckRequest")
+	proto.RegisterType((*CheckResponse)(nil), "schema.CheckResponse")
+	proto.RegisterType((*RepairRequest)(nil), "schema.RepairRequest")
+	proto.RegisterType((*RepairResponse)(nil), "schema.RepairResponse")
+	proto.RegisterType((*UploadedPart)(nil), "schema.UploadedPart")
+	proto.RegisterType((*CreateUploadRequest)(nil), "schema.CreateUploadRequest")
+	proto.RegisterType((*CreateUploadResponse)(nil), "schema.CreateUploadResponse")
+	proto.RegisterType((*UploadPartRequest)(nil), "schema.UploadPartRequest")
+	proto.RegisterType((*UploadPartRequest_Part)(nil), "schema.UploadPartRequest.Part")
+	proto.RegisterType((*UploadPartRequest_Data)(nil), "schema.UploadPartRequest.Data")
+	proto.RegisterType((*UploadPartResponse)(nil), "schema.UploadPartResponse")
+	proto.RegisterType((*ListUploadPartsRequest)(nil), "schema.ListUploadPartsRequest")
+	proto.RegisterType((*ListUploadPartsResponse)(nil), "schema.ListUploadPartsResponse")
+	proto.RegisterType((*CompleteUploadRequest)(nil), "schema.CompleteUploadRequest")
+	proto.RegisterType((*CompleteUploadResponse)(nil), "schema.CompleteUploadResponse")
+	proto.RegisterType((*AbortUploadRequest)(nil), "schema.AbortUploadRequest")
+	proto.RegisterType((*AbortUploadResponse)(nil), "schema.AbortUploadResponse")
+	proto.RegisterType((*SetMetadataRequest)(nil), "schema.SetMetadataRequest")
+	proto.RegisterType((*SetMetadataResponse)(nil), "schema.SetMetadataResponse")
+	proto.RegisterType((*GetMetadataRequest)(nil), "schema.GetMetadataRequest")
+	proto.RegisterType((*GetMetadataResponse)(nil), "schema.GetMetadataResponse")
+	proto.RegisterType((*DeleteMetadataRequest)(nil), "schema.DeleteMetadataRequest")
+	proto.RegisterType((*DeleteMetadataResponse)(nil), "schema.DeleteMetadataResponse")
+	proto.RegisterType((*ListMetadataKeysRequest)(nil), "schema.ListMetadataKeysRequest")
+	proto.RegisterType((*ListMetadataKeysResponse)(nil), "schema.ListMetadataKeysResponse")
+	proto.RegisterType((*DataWriteRequest)(nil), "schema.DataWriteRequest")
+	proto.RegisterType((*DataWriteResponse)(nil), "schema.DataWriteResponse")
+	proto.RegisterType((*DataWriteFileRequest)(nil), "schema.DataWriteFileRequest")
+	proto.RegisterType((*DataWriteFileResponse)(nil), "schema.DataWriteFileResponse")
+	proto.RegisterType((*DataWriteStreamRequest)(nil), "schema.DataWriteStreamRequest")
+	proto.RegisterType((*DataWriteStreamResponse)(nil), "schema.DataWriteStreamResponse")
+	proto.RegisterType((*DataReadRequest)(nil), "schema.DataReadRequest")
+	proto.RegisterType((*DataReadResponse)(nil), "schema.DataReadResponse")
+	proto.RegisterType((*DataReadFileRequest)(nil), "schema.DataReadFileRequest")
+	proto.RegisterType((*DataReadFileResponse)(nil), "schema.DataReadFileResponse")
+	proto.RegisterType((*DataReadStreamRequest)(nil), "schema.DataReadStreamRequest")
+	proto.RegisterType((*DataReadStreamResponse)(nil), "schema.DataReadStreamResponse")
+	proto.RegisterType((*DataDeleteRequest)(nil), "schema.DataDeleteRequest")
+	proto.RegisterType((*DataDeleteResponse)(nil), "schema.DataDeleteResponse")
+	proto.RegisterType((*DataCheckRequest)(nil), "schema.DataCheckRequest")
+	proto.RegisterType((*DataCheckResponse)(nil), "schema.DataCheckResponse")
+	proto.RegisterType((*DataRepairRequest)(nil), "schema.DataRepairRequest")
+	proto.RegisterType((*DataRepairResponse)(nil), "schema.DataRepairResponse")
+	proto.RegisterType((*DataShardHealthRequest)(nil), "schema.DataShardHealthRequest")
+	proto.RegisterType((*DataShardHealthResponse)(nil), "schema.DataShardHealthResponse")
+	proto.RegisterType((*ShardHealth)(nil), "schema.ShardHealth")
+	proto.RegisterType((*NamespaceHealth)(nil), "schema.NamespaceHealth")
+}
+
+func init() { proto.RegisterFile("schema/daemon.proto", fileDescriptor_79298d76542483a2) }
+
+var fileDescriptor_79298d76542483a2 = []byte{
+	// 1966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x19, 0x5b, 0x6f, 0x1b, 0x59,
+	0xd9, 0xe3, 0x5b, 0x9d, 0xcf, 0x8e, 0xe3, 0x1c, 0x5f, 0xe2, 0x4c, 0xd3, 0x69, 0x18, 0x96, 0x62,
+	0xc2, 0x2a, 0xac, 0xdc, 0xb4, 0x62, 0x77, 0x21, 0x34, 0x4d, 0x9a, 0x0b, 0xdd, 0x92, 0xee, 0xa4,
+	0x0b, 0x12, 0xda, 0x97, 0x89, 0x7d, 0x16, 0x9b, 0xf8, 0xc6, 0xcc, 0xb8, 0xda, 0xf0, 0x80, 0x78,
+	0xe2, 0x99, 0x07, 0x7e, 0x04, 0x0f, 0x48, 0xfc, 0x0d, 0x24, 0x24, 0xd4, 0xc7, 0x95, 0xe0, 0x81,
+	0xa6, 0x2f, 0x88, 0xa7, 0x95, 0xf8, 0x03, 0xe8, 0x5c, 0xe7, 0x9c, 0x99, 0xb1, 0x1b, 0x47, 0xdd,
+	0x37, 0x9f, 0xef, 0xfe, 0x7d, 0xe7, 0x3b, 0xdf, 0x65, 0x0c, 0x55, 0xbf, 0xd3, 0xc3, 0x43, 0xf7,
+	0x07, 0x5d, 0x17, 0x0f, 0xc7, 0xa3, 0xed, 0x89, 0x37, 0x0e, 0xc6, 0x28, 0xcf, 0x80, 0xf6, 0x5f,
+	0x0d, 0x28, 0x3c, 0xc3, 0x81, 0xdb, 0x75, 0x03, 0x17, 0x55, 0x20, 0x73, 0x81, 0x2f, 0x9b, 0xc6,
+	0xa6, 0xd1, 0x2a, 0x39, 0xe4, 0x27, 0xda, 0x80, 0xa5, 0x60, 0x1c, 0xb8, 0x83, 0xb3, 0xfe, 0x6f,
+	0x71, 0x33, 0xbd, 0x69, 0xb4, 0x32, 0x4e, 0x08, 0x40, 0xef, 0xc1, 0x72, 0xc7, 0xc3, 0x6e, 0xd0,
+	0x1f, 0x8f, 0x9e, 0x4c, 0xc6, 0x9d, 0x5e, 0x33, 0x43, 0x29, 0x74, 0x20, 0xba, 0x07, 0xe5, 0x81,
+	0xeb, 0x07, 0xbf, 0xf0, 0xfa, 0x01, 0x66, 0x64, 0x59, 0x4a, 0x16, 0x81, 0xa2, 0xef, 0x40, 0xbe,
+	0xd3, 0x9b, 0x8e, 0x2e, 0xfc, 0x66, 0x6e, 0x33, 0xd3, 0x2a, 0xb6, 0x97, 0xb7, 0x99, 0x8d, 0xdb,
+	0xfb, 0x04, 0xea, 0x70, 0xa4, 0xdd, 0x81, 0x1c, 0x05, 0x10, 0xdb, 0x28, 0x88, 0xda, 0x66, 0x30,
+	0xdb, 0x24, 0x00, 0xb5, 0xe0, 0xd6, 0xf8, 0xfc, 0xd7, 0xb8, 0x13, 0xf8, 0xcd, 0x34, 0x15, 0x57,
+	0x16, 0xe2, 0x4e, 0x29, 0xd8, 0x11, 0x68, 0x84, 0x20, 0xdb, 0x73, 0x7d, 0x66, 0x7c, 0xc9, 0xa1,
+	0xbf, 0xed, 0x1d, 0xc8, 0x33, 0xb2, 0x84, 0x98, 0x34, 0xe1, 0x96, 0xdf, 0x73, 0xbd, 0xee, 0xc9,
+	0x01, 0x8d, 0xc8, 0x92, 0x23, 0x8e, 0xf6, 0x0e, 0x94, 0xa8, 0x3f, 0x0e, 0xfe, 0xcd, 0x14, 0xfb,
+	0x49, 0xbc, 0x08, 0xb2, 0x24, 0xd2, 0x94, 0xb1, 0xe4, 0xd0, 0xdf, 0xf6, 0x8f, 0x61, 0x99, 0x73,
+	0xf9, 0x93, 0xf1, 0xc8, 0xc7, 0xe8, 0x7d, 0x28, 0x0c, 0xf9, 0x95, 0x50, 0xde, 0x62, 0xbb, 0x22,
+	0x6c, 0x17, 0x57, 0xe5, 0x48, 0x0a, 0xfb, 0x11, 0x54, 0x28, 0xfb, 0x61, 0x7f, 0x30, 0x47, 0xb1,
+	0x09, 0x85, 0x2f, 0xfa, 0x03, 0xfc, 0xdc, 0x0d, 0x7a, 0xdc, 0x6a, 0x79, 0xb6, 0xf7, 0x60, 0x55,
+	0x91, 0x70, 0x23, 0x23, 0xfe, 0x69, 0x00, 0xa2, 0x32, 0xce, 0x02, 0x0f, 0xbb, 0x43, 0x61, 0xc7,
+	0x5e, 0x4c, 0xc8, 0xb7, 0x85, 0x90, 0x38, 0xb5, 0x94, 0x7b, 0x9c, 0x0a, 0x25, 0xa3, 0x07, 0x4a,
+	0xc4, 0x8a, 0xed, 0xbb, 0x73, 0xd8, 0x0f, 0x18, 0x2b, 0x25, 0x37, 0x37, 0xe6, 0xa5, 0xb5, 0xf9,
+	0x1e, 0x64, 0x09, 0x35, 0x49, 0x21, 0x42, 0x41, 0xf3, 0x89, 0xdf, 0x49, 0x08, 0x78, 0x7c, 0x0b,
+	0x72, 0xfd, 0xd1, 0x64, 0x1a, 0xd8, 0xfb, 0x50, 0xd5, 0xf4, 0xdd, 0x28, 0x44, 0x0f, 0x60, 0x79,
+	0x6f, 0x32, 0xc1, 0xa3, 0xee, 0x62, 0xd9, 0xb1, 0x0b, 0x65, 0xc1, 0x76, 0x23, 0xb5, 0xbf, 0x84,
+	0xa2, 0x83, 0x5d, 0xa9, 0x14, 0x29, 0x4a, 0x8f, 0x53, 0x4c, 0xed, 0xb6, 0x22, 0x30, 0x9d, 0x2c,
+	0x50, 0xbd, 0x92, 0x30, 0x2e, 0x36, 0x94, 0x98, 0x6c, 0x6e, 0x99, 0xb0, 0xdf, 0x50, 0xec, 0xff,
+	0x87, 0x01, 0x2b, 0x84, 0x48, 0x4d, 0xcf, 0x77, 0x60, 0x84, 0x96, 0xd0, 0x19, 0x3d, 0xa1, 0x49,
+	0x84, 0xc8, 0xef, 0x67, 0xe3, 0x2e, 0xa6, 0xb5, 0xa6, 0x1c, 0xca, 0x3a, 0xe4, 0x70, 0x47, 0x52,
+	0x90, 0x2a, 0xe6, 0x5f, 0x8e, 0x3a, 0x3d, 0x6f, 0x3c, 0x1a, 0x4f, 0xfd, 0x93, 0xd3, 0x66, 0x6e,
+	0xd3, 0x68, 0x15, 0x1c, 0x1d, 0x18, 0x3a, 0x8d, 0xa0, 0x12, 0xfa, 0xc3, 0x1c, 0xb7, 0x7f, 0x07,
+	0xab, 0x04, 0xa6, 0x27, 0xff, 0xbb, 0xf0, 0x52, 0xab, 0x71, 0x99, 0x48, 0x8d, 0x0b, 0x6d, 0x6a,
+	0x03, 0x52, 0xf5, 0xf3, 0xeb, 0xd0, 0xb2, 0xdb, 0x88, 0x64, 0xb7, 0xfd, 0x39, 0x2c, 0x1f, 0xe0,
+	0x01, 0x0e, 0xf0, 0x37, 0x92, 0x1a, 0x15, 0x28, 0x0b, 0xe9, 0x3c, 0x46, 0x63, 0x28, 0xed, 0xf7,
+	0x70, 0xe7, 0xe2, 0x5d, 0x86, 0x07, 0x41, 0xf6, 0x0b, 0xd7, 0x0f, 0x68, 0x64, 0x0a, 0x0e, 0xfd,
+	0x1d, 0x9a, 0xf0, 0x23, 0x58, 0xe6, 0x0a, 0x79, 0x3c, 0xbe, 0x0f, 0x79, 0x3f, 0x70, 0x83, 0xa9,
+	0x4f, 0x95, 0x96, 0xdb, 0xd5, 0xb0, 0xc1, 0xe0, 0xce, 0xc5, 0x19, 0x45, 0x39, 0x9c, 0xc4, 0xfe,
+	0x16, 0x2c, 0x3b, 0x78, 0xe2, 0xf6, 0xbd, 0x99, 0xcf, 0x95, 0x3c, 0x4d, 0x41, 0x72, 0xa3, 0xa7,
+	0x79, 0x0e, 0xa5, 0xcf, 0x26, 0x83, 0xb1, 0xdb, 0xc5, 0xdd, 0xe7, 0xae, 0x17, 0xa0, 0x06, 0xe4,
+	0x47, 0xd3, 0xe1, 0x39, 0xf6, 0x78, 0x37, 0xe3, 0x27, 0xe2, 0xa5, 0x1f, 0xf6, 0x5f, 0xfa, 0x3b,
+	0xa1, 0xa9, 0x66, 0x92, 0x9a, 0xaa, 0xfd, 0x5d, 0xa8, 0xee, 0x7b, 0xd8, 0x0d, 0x30, 0xd3, 0x34,
+	0xdb, 0x99, 0x36, 0xd4, 0x74, 0x42, 0xee, 0x92, 0x09, 0x85, 0x29, 0x85, 0x9c, 0x1c, 0x50, 0xf2,
+	0x25, 0x47, 0x9e, 0xed, 0xff, 0x1a, 0xb0, 0xca, 0xc8, 0x89, 0xfd, 0x42, 0xf6, 0x0e, 0x64, 0x27,
+	0xae, 0x17, 0xf0, 0x00, 0x58, 0x22, 0x00, 0x31, 0xc2, 0x6d, 0xf2, 0x9b, 0x14, 0x6c, 0x42, 0x4d,
+	0xb8, 0x94, 0x6b, 0x9f, 0xc3, 0xa5, 0x95, 0xf9, 0x8f, 0x20, 0x4b, 0x43, 0x37, 0xc7, 0x4a, 0x25,
+	0xac, 0x69, 0x35, 0xac, 0xc9, 0x4d, 0xc0, 0x98, 0xd9, 0x04, 0x76, 0x01, 0xa9, 0xc6, 0xf0, 0xf0,
+	0xb4, 0x34, 0x67, 0x6b, 0xba, 0xd9, 0xec, 0x5e, 0x99, 0x83, 0xf6, 0x0e, 0x34, 0x3e, 0xe9, 0xfb,
+	0x41, 0x28, 0xc3, 0x17, 0x01, 0x9b, 0x17, 0xe2, 0x27, 0xb0, 0x16, 0xe3, 0xe2, 0xaa, 0xb7, 0x20,
+	0x47, 0x04, 0x93, 0x6c, 0xce, 0xcc, 0xd4, 0xcd, 0x48, 0xec, 0xfb, 0x50, 0xdf, 0x1f, 0x0f, 0x27,
+	0xe4, 0x41, 0xea, 0x89, 0x30, 0x4f, 0xf7, 0x21, 0x34, 0xa2, 0x4c, 0x37, 0xca, 0xf3, 0x0f, 0x00,
+	0xed, 0x9d, 0x8f, 0xbd, 0xe0, 0xfa, 0x9a, 0xeb, 0x50, 0xd5, 0x38, 0x78, 0x09, 0x79, 0x0c, 0xe8,
+	0x0c, 0x07, 0x52, 0x03, 0x17, 0xb4, 0x98, 0x31, 0x75, 0xa8, 0x6a, 0x32, 0xb8, 0xe8, 0x7b, 0x80,
+	0x8e, 0xe2, 0xa2, 0xe3, 0xcf, 0x64, 0x1f, 0xaa, 0x47, 0x71, 0xf6, 0x05, 0x6d, 0xf8, 0x1e, 0xd4,
+	0x59, 0x71, 0x7c, 0xbb, 0xbe, 0x26, 0x34, 0xa2, 0xa4, 0xdc, 0xe2, 0x75, 0x96, 0x19, 0x02, 0xfe,
+	0x14, 0x5f, 0x8a, 0x84, 0xb2, 0xdf, 0x87, 0x66, 0x1c, 0xc5, 0x2d, 0x8d, 0xab, 0xb8, 0x07, 0x15,
+	0xf2, 0x0e, 0xb4, 0xc9, 0x35, 0xa9, 0x93, 0x7f, 0x04, 0xab, 0x0a, 0x1d, 0x17, 0x17, 0x0e, 0xed,
+	0xc6, 0xbc, 0xa1, 0xbd, 0x0d, 0x35, 0xc9, 0xab, 0x4e, 0x02, 0x6a, 0x17, 0x37, 0x22, 0x63, 0xe9,
+	0x2e, 0xd4, 0x23, 0x3c, 0x8b, 0xe9, 0x7c, 0x08, 0x0d, 0xc9, 0xaf, 0x77, 0xe6, 0xf9, 0x8d, 0xf1,
+	0x11, 0xac, 0xc5, 0xf8, 0x16, 0xd3, 0xfc, 0x43, 0x58, 0x39, 0xa0, 0x57, 0x15, 0x66, 0xfb, 0x35,
+	0x39, 0xf9, 0x5d, 0xbc, 0x75, 0xaa, 0xfa, 0x8b, 0x01, 0x55, 0x41, 0xa8, 0xc6, 0xf3, 0x7a, 0x6a,
+	0xe6, 0x6d, 0x03, 0xda, 0xf0, 0x94, 0x59, 0x7c, 0x78, 0xca, 0x26, 0x0c, 0x4f, 0x76, 0x03, 0x6a,
+	0xba, 0xb5, 0x3c, 0x87, 0x3f, 0x87, 0xba, 0x80, 0xeb, 0x37, 0x74, 0x4d, 0x3f, 0xb4, 0xf1, 0x28,
+	0x1d, 0x19, 0x8f, 0x44, 0x02, 0x2c, 0x3c, 0x19, 0xf1, 0x44, 0xd7, 0xa7, 0xa3, 0x6b, 0x5e, 0x60,
+	0x0d, 0x90, 0xca, 0xcb, 0xfd, 0x7c, 0xc6, 0xae, 0x55, 0x9b, 0x7f, 0xae, 0xe9, 0xa2, 0x18, 0x71,
+	0xd2, 0xe1, 0x88, 0x63, 0x3f, 0x82, 0x55, 0x45, 0xdc, 0x4d, 0xa6, 0x1b, 0xee, 0xa2, 0x3e, 0xe1,
+	0x5c, 0xd3, 0xc5, 0x8f, 0x01, 0xa9, 0xbc, 0x8b, 0x3d, 0x8d, 0x26, 0xbb, 0x93, 0x33, 0xb2, 0x31,
+	0x1f, 0x63, 0x77, 0x10, 0xf4, 0x44, 0xd1, 0x3a, 0x84, 0xb5, 0x18, 0x46, 0x71, 0x8d, 0x80, 0x85,
+	0x6c, 0xe9, 0x9a, 0x4a, 0xcc, 0x49, 0xec, 0x3f, 0xa5, 0xa1, 0xa8, 0xc0, 0x51, 0x0d, 0x72, 0x14,
+	0xc3, 0xeb, 0x0b, 0x3b, 0xa0, 0x16, 0xe4, 0x48, 0x28, 0x58, 0xd6, 0x94, 0xdb, 0x48, 0x93, 0x48,
+	0x82, 0x85, 0x1d, 0x46, 0x40, 0xf9, 0xfb, 0xa3, 0x8e, 0x18, 0xbf, 0xd9, 0x81, 0xbe, 0x20, 0xb7,
+	0x3f, 0x98, 0x7a, 0xd8, 0xe7, 0x9f, 0x33, 0xe4, 0x99, 0x64, 0x17, 0x99, 0xc2, 0x9e, 0x78, 0xde,
+	0xd8, 0xa3, 0xcb, 0xc4, 0x92, 0x13, 0x02, 0xc8, 0x8b, 0x91, 0x87, 0x17, 0xfd, 0x21, 0x6e, 0xe6,
+	0xd9, 0x47, 0x13, 0x0d, 0x48, 0x3e, 0x32, 0x0c, 0xdc, 0x00, 0x8f, 0x3a, 0x97, 0xcd, 0x5b, 0x14,
+	0x2f, 0x8e, 0xe8, 0x01, 0x2c, 0x8d, 0xdc, 0x21, 0xf6, 0x27, 0x6e, 0x07, 0x37, 0x0b, 0xb4, 0xd7,
+	0xac, 0x09, 0xeb, 0x7f, 0x26, 0x10, 0x3c, 0x26, 0x21, 0xa5, 0xfd, 0x3f, 0x03, 0x56, 0x22, 0x68,
+	0x64, 0x43, 0x89, 0x64, 0xfd, 0xc9, 0x29, 0xd5, 0xeb, 0xf3, 0xb1, 0x53, 0x83, 0x11, 0x73, 0xfb,
+	0xa3, 0x2e, 0xfe, 0x52, 0x12, 0xb1, 0x67, 0xa6, 0x03, 0x91, 0x05, 0x40, 0xb8, 0x0e, 0xdd, 0xe9,
+	0x20, 0xf0, 0x79, 0xa4, 0x14, 0x08, 0xda, 0x84, 0x22, 0x65, 0xe0, 0x04, 0x2c, 0x62, 0x2a, 0x08,
+	0xb5, 0x60, 0x45, 0xd1, 0xfb, 0x09, 0x49, 0xf9, 0x1c, 0xa5, 0x8a, 0x82, 0xd1, 0x16, 0x54, 0x54,
+	0xe5, 0x94, 0x94, 0xc5, 0x30, 0x06, 0xdf, 0x3a, 0x83, 0xa2, 0x92, 0xfe, 0xa8, 0x01, 0x48, 0x39,
+	0x9e, 0x8c, 0x5e, 0xba, 0x83, 0x7e, 0xb7, 0x92, 0x42, 0x35, 0xa8, 0x28, 0xf0, 0x9f, 0x53, 0xa8,
+	0x11, 0xa1, 0x3e, 0x9d, 0x04, 0xfd, 0xa1, 0x3b, 0xa8, 0xa4, 0xb7, 0x9e, 0x42, 0x41, 0x54, 0x42,
+	0xc2, 0x29, 0x7e, 0xbf, 0xf0, 0xa6, 0xa3, 0x8e, 0x1b, 0xe0, 0x4a, 0x0a, 0x21, 0x28, 0x0b, 0x28,
+	0x5b, 0xde, 0x2b, 0x06, 0xaa, 0xc3, 0xaa, 0x80, 0x3d, 0xf9, 0xb2, 0x33, 0x98, 0xfa, 0xfd, 0x97,
+	0xb8, 0x92, 0xde, 0x7a, 0x01, 0x10, 0xe6, 0x1c, 0x21, 0x0a, 0x4f, 0xec, 0x96, 0x2e, 0x2b, 0x29,
+	0x62, 0x49, 0x08, 0x3e, 0xc0, 0xbf, 0xf2, 0xdc, 0x2e, 0x26, 0x32, 0xd7, 0xa0, 0x1a, 0xc2, 0x3f,
+	0x1b, 0xf5, 0x38, 0x43, 0xba, 0xfd, 0x87, 0x02, 0x14, 0x89, 0xb6, 0x33, 0xec, 0xbd, 0xec, 0x77,
+	0x30, 0x7a, 0x08, 0x39, 0xda, 0xcf, 0x50, 0x4d, 0xfb, 0x80, 0xc2, 0xdf, 0x9e, 0x59, 0x8f, 0x40,
+	0x79, 0xd9, 0x4a, 0xa1, 0xc7, 0xb0, 0x24, 0xfb, 0x2f, 0x6a, 0x6a, 0x54, 0x4a, 0xdb, 0x31, 0xd7,
+	0x13, 0x30, 0x52, 0xc6, 0x4f, 0xa1, 0xa8, 0xf4, 0x52, 0x64, 0xce, 0xfe, 0x84, 0x63, 0xde, 0x4e,
+	0xc4, 0x09, 0x49, 0x2d, 0x03, 0x7d, 0x08, 0x79, 0x16, 0x50, 0x24, 0x4d, 0xd6, 0x3e, 0xaa, 0x98,
+	0x8d, 0x28, 0x58, 0x9a, 0x71, 0x1f, 0xb2, 0xa4, 0x13, 0x20, 0x59, 0x3c, 0x94, 0xf6, 0x6c, 0xd6,
+	0x74, 0xa0, 0x64, 0xfa, 0x09, 0x14, 0x44, 0xd3, 0x42, 0x6b, 0x2a, 0x8d, 0xea, 0x7d, 0x33, 0x8e,
+	0x90, 0x02, 0x8e, 0x00, 0xc2, 0xfe, 0x83, 0xd6, 0x55, 0x4a, 0xdd, 0x75, 0x33, 0x09, 0x25, 0xc4,
+	0x7c, 0x40, 0x3d, 0x67, 0x4d, 0x25, 0xf4, 0x5c, 0x6b, 0x50, 0x66, 0x23, 0x0a, 0x96, 0x36, 0x3c,
+	0x24, 0x5f, 0x4c, 0x71, 0xe7, 0x22, 0xbc, 0x7c, 0xb5, 0x11, 0x99, 0xf5, 0x08, 0x54, 0xf2, 0x7d,
+	0x08, 0x79, 0x56, 0xe4, 0x43, 0x95, 0x5a, 0xc3, 0x30, 0x1b, 0x51, 0xb0, 0x64, 0x7d, 0x0a, 0x25,
+	0x75, 0x9b, 0x44, 0xf2, 0x62, 0x13, 0x96, 0x51, 0x73, 0x23, 0x19, 0xa9, 0xc6, 0x30, 0xdc, 0x7f,
+	0xc2, 0x18, 0xc6, 0x56, 0x43, 0xd3, 0x4c, 0x42, 0x29, 0xd9, 0xf3, 0x02, 0x56, 0x22, 0xcb, 0x14,
+	0x92, 0x8b, 0x66, 0xf2, 0x6e, 0x66, 0xde, 0x9d, 0x89, 0x97, 0xe6, 0x7d, 0x0a, 0x65, 0x7d, 0x4d,
+	0x42, 0x77, 0xa4, 0x43, 0x49, 0x3b, 0x97, 0x69, 0xcd, 0x42, 0x4b, 0x91, 0xc7, 0x50, 0x54, 0xf6,
+	0x9f, 0xf0, 0xc9, 0xc4, 0xd7, 0x28, 0xf3, 0x76, 0x22, 0x4e, 0x48, 0x6a, 0xff, 0x3d, 0x0d, 0x2b,
+	0x62, 0x0f, 0x10, 0xc5, 0xe0, 0x18, 0x8a, 0xca, 0x0a, 0x14, 0x4a, 0x8f, 0xef, 0x56, 0xe6, 0xed,
+	0x44, 0x9c, 0x6a, 0xe7, 0x51, 0x92, 0xa4, 0xa3, 0x39, 0x92, 0x8e, 0x12, 0x25, 0x7d, 0x2a, 0xbe,
+	0x17, 0x49, 0x61, 0x77, 0xf4, 0x7c, 0x8e, 0xca, 0xb3, 0x66, 0xa1, 0x15, 0x91, 0x05, 0x72, 0x69,
+	0x64, 0xfb, 0x41, 0xda, 0x35, 0x26, 0xac, 0x4c, 0xe6, 0xe6, 0x6c, 0x82, 0xf0, 0x11, 0xb6, 0xff,
+	0x95, 0x83, 0xe2, 0x81, 0x12, 0xc9, 0x5d, 0x51, 0x56, 0x65, 0x09, 0x88, 0x6e, 0x52, 0xe6, 0x7a,
+	0x02, 0x46, 0x29, 0x8d, 0x4a, 0x79, 0xdd, 0x88, 0x51, 0xaa, 0x45, 0xe6, 0xce, 0x0c, 0xac, 0x94,
+	0xe5, 0xe8, 0x65, 0xd6, 0x8a, 0xd1, 0xeb, 0xf5, 0xe6, 0xee, 0x4c, 0xbc, 0xf2, 0x60, 0x3e, 0xe6,
+	0x35, 0x73, 0x4d, 0x25, 0x56, 0xeb, 0x66, 0x33, 0x8e, 0x50, 0x9e, 0x6d, 0x58, 0x3b, 0x6f, 0x47,
+	0xe9, 0x54, 0xd7, 0x36, 0x92, 0x91, 0x52, 0xd0, 0xa9, 0x56, 0x43, 0xef, 0x44, 0xa9, 0x75, 0xbf,
+	0xac, 0x59, 0x68, 0xa5, 0x96, 0xee, 0xc9, 0x5a, 0xaa, 0xdd, 0x8e, 0x5e, 0x4f, 0xcd, 0x24, 0x94,
+	0xb4, 0x69, 0x57, 0xd4, 0x54, 0x2d, 0x02, 0x5a, 0x5d, 0x5d, 0x4f, 0xc0, 0x48, 0xfe, 0x3d, 0x59,
+	0x5b, 0xd7, 0x75, 0x83, 0xd5, 0xfa, 0x6a, 0x26, 0xa1, 0xa4, 0x88, 0xe7, 0xfa, 0x9c, 0xab, 0x39,
+	0x1e, 0x9f, 0xaf, 0xcd, 0xbb, 0x33, 0xf1, 0x42, 0xe2, 0xe3, 0x9d, 0x57, 0xaf, 0xad, 0xd4, 0x57,
+	0xaf, 0xad, 0xd4, 0xd7, 0xaf, 0x2d, 0xe3, 0xf7, 0x57, 0x96, 0xf1, 0xe7, 0x2b, 0xcb, 0xf8, 0xdb,
+	0x95, 0x65, 0xbc, 0xba, 0xb2, 0x8c, 0x7f, 0x5f, 0x59, 0xc6, 0x7f, 0xae, 0xac, 0xd4, 0xd7, 0x57,
+	0x96, 0xf1, 0xc7, 0x37, 0x56, 0xea, 0xd5, 0x1b, 0x2b, 0xf5, 0xd5, 0x1b, 0x2b, 0x75, 0x9e, 0xa7,
+	0xff, 0x28, 0xde, 0xff, 0xff, 0x00, 0xb9, 0x9f, 0x9b, 0x66, 0x68, 0x1c, 0x00, 0x00,
+}
+
+func (x CheckStatus) String() string {
+	s, ok := CheckStatus_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x FileMode) String() string {
+	s, ok := FileMode_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ShardState) String() string {
+	s, ok := ShardState_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Metadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReadStreamRequest_Metadata)
+	that1, ok := that.(*Metadata)
 	if !ok {
-		that2, ok := that.(ReadStreamRequest_Metadata)
+		that2, ok := that.(Metadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3359,19 +3810,36 @@ func (this *ReadStreamRequest_Metadata) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Metadata.Equal(that1.Metadata) {
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if this.TotalSize != that1.TotalSize {
+		return false
+	}
+	if this.CreationEpoch != that1.CreationEpoch {
+		return false
+	}
+	if this.LastWriteEpoch != that1.LastWriteEpoch {
+		return false
+	}
+	if len(this.Chunks) != len(that1.Chunks) {
 		return false
 	}
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *ReadStreamResponse) Equal(that interface{}) bool {
+func (this *Chunk) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReadStreamResponse)
+	that1, ok := that.(*Chunk)
 	if !ok {
-		that2, ok := that.(ReadStreamResponse)
+		that2, ok := that.(Chunk)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3383,19 +3851,30 @@ func (this *ReadStreamResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
+	if this.ChunkSize != that1.ChunkSize {
+		return false
+	}
+	if len(this.Objects) != len(that1.Objects) {
+		return false
+	}
+	for i := range this.Objects {
+		if !this.Objects[i].Equal(that1.Objects[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
 		return false
 	}
 	return true
 }
-func (this *DeleteRequest) Equal(that interface{}) bool {
+func (this *Object) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteRequest)
+	that1, ok := that.(*Object)
 	if !ok {
-		that2, ok := that.(DeleteRequest)
+		that2, ok := that.(Object)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3407,25 +3886,22 @@ func (this *DeleteRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Input == nil {
-		if this.Input != nil {
-			return false
-		}
-	} else if this.Input == nil {
+	if !bytes.Equal(this.Key, that1.Key) {
 		return false
-	} else if !this.Input.Equal(that1.Input) {
+	}
+	if this.ShardID != that1.ShardID {
 		return false
 	}
 	return true
 }
-func (this *DeleteRequest_Key) Equal(that interface{}) bool {
+func (this *WriteRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteRequest_Key)
+	that1, ok := that.(*WriteRequest)
 	if !ok {
-		that2, ok := that.(DeleteRequest_Key)
+		that2, ok := that.(WriteRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3440,16 +3916,19 @@ func (this *DeleteRequest_Key) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Key, that1.Key) {
 		return false
 	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
 	return true
 }
-func (this *DeleteRequest_Metadata) Equal(that interface{}) bool {
+func (this *WriteResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteRequest_Metadata)
+	that1, ok := that.(*WriteResponse)
 	if !ok {
-		that2, ok := that.(DeleteRequest_Metadata)
+		that2, ok := that.(WriteResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3466,14 +3945,14 @@ func (this *DeleteRequest_Metadata) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DeleteResponse) Equal(that interface{}) bool {
+func (this *WriteFileRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteResponse)
+	that1, ok := that.(*WriteFileRequest)
 	if !ok {
-		that2, ok := that.(DeleteResponse)
+		that2, ok := that.(WriteFileRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3485,16 +3964,22 @@ func (this *DeleteResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if this.FilePath != that1.FilePath {
+		return false
+	}
 	return true
 }
-func (this *CheckRequest) Equal(that interface{}) bool {
+func (this *WriteFileResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CheckRequest)
+	that1, ok := that.(*WriteFileResponse)
 	if !ok {
-		that2, ok := that.(CheckRequest)
+		that2, ok := that.(WriteFileResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3506,28 +3991,19 @@ func (this *CheckRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Input == nil {
-		if this.Input != nil {
-			return false
-		}
-	} else if this.Input == nil {
-		return false
-	} else if !this.Input.Equal(that1.Input) {
-		return false
-	}
-	if this.Fast != that1.Fast {
+	if !this.Metadata.Equal(that1.Metadata) {
 		return false
 	}
 	return true
 }
-func (this *CheckRequest_Key) Equal(that interface{}) bool {
+func (this *WriteStreamRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CheckRequest_Key)
+	that1, ok := that.(*WriteStreamRequest)
 	if !ok {
-		that2, ok := that.(CheckRequest_Key)
+		that2, ok := that.(WriteStreamRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3539,19 +4015,25 @@ func (this *CheckRequest_Key) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
+	if that1.Input == nil {
+		if this.Input != nil {
+			return false
+		}
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
 		return false
 	}
 	return true
 }
-func (this *CheckRequest_Metadata) Equal(that interface{}) bool {
+func (this *WriteStreamRequest_Metadata_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CheckRequest_Metadata)
+	that1, ok := that.(*WriteStreamRequest_Metadata_)
 	if !ok {
-		that2, ok := that.(CheckRequest_Metadata)
+		that2, ok := that.(WriteStreamRequest_Metadata_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3568,14 +4050,14 @@ func (this *CheckRequest_Metadata) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *CheckResponse) Equal(that interface{}) bool {
+func (this *WriteStreamRequest_Data_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CheckResponse)
+	that1, ok := that.(*WriteStreamRequest_Data_)
 	if !ok {
-		that2, ok := that.(CheckResponse)
+		that2, ok := that.(WriteStreamRequest_Data_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3587,19 +4069,19 @@ func (this *CheckResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Status != that1.Status {
+	if !this.Data.Equal(that1.Data) {
 		return false
 	}
 	return true
 }
-func (this *RepairRequest) Equal(that interface{}) bool {
+func (this *WriteStreamRequest_Metadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RepairRequest)
+	that1, ok := that.(*WriteStreamRequest_Metadata)
 	if !ok {
-		that2, ok := that.(RepairRequest)
+		that2, ok := that.(WriteStreamRequest_Metadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3616,14 +4098,14 @@ func (this *RepairRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RepairResponse) Equal(that interface{}) bool {
+func (this *WriteStreamRequest_Data) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RepairResponse)
+	that1, ok := that.(*WriteStreamRequest_Data)
 	if !ok {
-		that2, ok := that.(RepairResponse)
+		that2, ok := that.(WriteStreamRequest_Data)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3635,19 +4117,19 @@ func (this *RepairResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Metadata.Equal(that1.Metadata) {
+	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
 		return false
 	}
 	return true
 }
-func (this *SetMetadataRequest) Equal(that interface{}) bool {
+func (this *WriteStreamResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SetMetadataRequest)
+	that1, ok := that.(*WriteStreamResponse)
 	if !ok {
-		that2, ok := that.(SetMetadataRequest)
+		that2, ok := that.(WriteStreamResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3664,14 +4146,14 @@ func (this *SetMetadataRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SetMetadataResponse) Equal(that interface{}) bool {
+func (this *AppendRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SetMetadataResponse)
+	that1, ok := that.(*AppendRequest)
 	if !ok {
-		that2, ok := that.(SetMetadataResponse)
+		that2, ok := that.(AppendRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3683,16 +4165,22 @@ func (this *SetMetadataResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
 	return true
 }
-func (this *GetMetadataRequest) Equal(that interface{}) bool {
+func (this *AppendResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetMetadataRequest)
+	that1, ok := that.(*AppendResponse)
 	if !ok {
-		that2, ok := that.(GetMetadataRequest)
+		that2, ok := that.(AppendResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3704,19 +4192,19 @@ func (this *GetMetadataRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
+	if !this.Metadata.Equal(that1.Metadata) {
 		return false
 	}
 	return true
 }
-func (this *GetMetadataResponse) Equal(that interface{}) bool {
+func (this *ReadRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetMetadataResponse)
+	that1, ok := that.(*ReadRequest)
 	if !ok {
-		that2, ok := that.(GetMetadataResponse)
+		that2, ok := that.(ReadRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3728,19 +4216,25 @@ func (this *GetMetadataResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Metadata.Equal(that1.Metadata) {
+	if that1.Input == nil {
+		if this.Input != nil {
+			return false
+		}
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
 		return false
 	}
 	return true
 }
-func (this *DeleteMetadataRequest) Equal(that interface{}) bool {
+func (this *ReadRequest_Key) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteMetadataRequest)
+	that1, ok := that.(*ReadRequest_Key)
 	if !ok {
-		that2, ok := that.(DeleteMetadataRequest)
+		that2, ok := that.(ReadRequest_Key)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3757,14 +4251,14 @@ func (this *DeleteMetadataRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DeleteMetadataResponse) Equal(that interface{}) bool {
+func (this *ReadRequest_Metadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteMetadataResponse)
+	that1, ok := that.(*ReadRequest_Metadata)
 	if !ok {
-		that2, ok := that.(DeleteMetadataResponse)
+		that2, ok := that.(ReadRequest_Metadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3776,16 +4270,19 @@ func (this *DeleteMetadataResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
+	}
 	return true
 }
-func (this *ListMetadataKeysRequest) Equal(that interface{}) bool {
+func (this *ReadResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListMetadataKeysRequest)
+	that1, ok := that.(*ReadResponse)
 	if !ok {
-		that2, ok := that.(ListMetadataKeysRequest)
+		that2, ok := that.(ReadResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3797,16 +4294,19 @@ func (this *ListMetadataKeysRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
 	return true
 }
-func (this *ListMetadataKeysResponse) Equal(that interface{}) bool {
+func (this *ReadFileRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListMetadataKeysResponse)
+	that1, ok := that.(*ReadFileRequest)
 	if !ok {
-		that2, ok := that.(ListMetadataKeysResponse)
+		that2, ok := that.(ReadFileRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3818,19 +4318,34 @@ func (this *ListMetadataKeysResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
+	if that1.Input == nil {
+		if this.Input != nil {
+			return false
+		}
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
+		return false
+	}
+	if this.FilePath != that1.FilePath {
+		return false
+	}
+	if this.FileMode != that1.FileMode {
+		return false
+	}
+	if this.SynchronousIO != that1.SynchronousIO {
 		return false
 	}
 	return true
 }
-func (this *DataWriteRequest) Equal(that interface{}) bool {
+func (this *ReadFileRequest_Key) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataWriteRequest)
+	that1, ok := that.(*ReadFileRequest_Key)
 	if !ok {
-		that2, ok := that.(DataWriteRequest)
+		that2, ok := that.(ReadFileRequest_Key)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3842,19 +4357,19 @@ func (this *DataWriteRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
+	if !bytes.Equal(this.Key, that1.Key) {
 		return false
 	}
 	return true
 }
-func (this *DataWriteResponse) Equal(that interface{}) bool {
+func (this *ReadFileRequest_Metadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataWriteResponse)
+	that1, ok := that.(*ReadFileRequest_Metadata)
 	if !ok {
-		that2, ok := that.(DataWriteResponse)
+		that2, ok := that.(ReadFileRequest_Metadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3866,24 +4381,19 @@ func (this *DataWriteResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
+	if !this.Metadata.Equal(that1.Metadata) {
 		return false
 	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *DataWriteFileRequest) Equal(that interface{}) bool {
+func (this *ReadFileResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataWriteFileRequest)
+	that1, ok := that.(*ReadFileResponse)
 	if !ok {
-		that2, ok := that.(DataWriteFileRequest)
+		that2, ok := that.(ReadFileResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3895,19 +4405,16 @@ func (this *DataWriteFileRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FilePath != that1.FilePath {
-		return false
-	}
 	return true
 }
-func (this *DataWriteFileResponse) Equal(that interface{}) bool {
+func (this *ReadStreamRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataWriteFileResponse)
+	that1, ok := that.(*ReadStreamRequest)
 	if !ok {
-		that2, ok := that.(DataWriteFileResponse)
+		that2, ok := that.(ReadStreamRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3919,24 +4426,28 @@ func (this *DataWriteFileResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
-		return false
-	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+	if that1.Input == nil {
+		if this.Input != nil {
 			return false
 		}
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
+		return false
+	}
+	if this.ChunkSize != that1.ChunkSize {
+		return false
 	}
 	return true
 }
-func (this *DataWriteStreamRequest) Equal(that interface{}) bool {
+func (this *ReadStreamRequest_Key) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataWriteStreamRequest)
+	that1, ok := that.(*ReadStreamRequest_Key)
 	if !ok {
-		that2, ok := that.(DataWriteStreamRequest)
+		that2, ok := that.(ReadStreamRequest_Key)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3948,19 +4459,19 @@ func (this *DataWriteStreamRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
+	if !bytes.Equal(this.Key, that1.Key) {
 		return false
 	}
 	return true
 }
-func (this *DataWriteStreamResponse) Equal(that interface{}) bool {
+func (this *ReadStreamRequest_Metadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataWriteStreamResponse)
+	that1, ok := that.(*ReadStreamRequest_Metadata)
 	if !ok {
-		that2, ok := that.(DataWriteStreamResponse)
+		that2, ok := that.(ReadStreamRequest_Metadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3972,24 +4483,19 @@ func (this *DataWriteStreamResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
+	if !this.Metadata.Equal(that1.Metadata) {
 		return false
 	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *DataReadRequest) Equal(that interface{}) bool {
+func (this *ReadStreamResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataReadRequest)
+	that1, ok := that.(*ReadStreamResponse)
 	if !ok {
-		that2, ok := that.(DataReadRequest)
+		that2, ok := that.(ReadStreamResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4001,24 +4507,19 @@ func (this *DataReadRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
+	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
 		return false
 	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *DataReadResponse) Equal(that interface{}) bool {
+func (this *DeleteRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataReadResponse)
+	that1, ok := that.(*DeleteRequest)
 	if !ok {
-		that2, ok := that.(DataReadResponse)
+		that2, ok := that.(DeleteRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4030,19 +4531,25 @@ func (this *DataReadResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
+	if that1.Input == nil {
+		if this.Input != nil {
+			return false
+		}
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
 		return false
 	}
 	return true
 }
-func (this *DataReadFileRequest) Equal(that interface{}) bool {
+func (this *DeleteRequest_Key) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataReadFileRequest)
+	that1, ok := that.(*DeleteRequest_Key)
 	if !ok {
-		that2, ok := that.(DataReadFileRequest)
+		that2, ok := that.(DeleteRequest_Key)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4054,33 +4561,43 @@ func (this *DataReadFileRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
+	if !bytes.Equal(this.Key, that1.Key) {
 		return false
 	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+	return true
+}
+func (this *DeleteRequest_Metadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteRequest_Metadata)
+	if !ok {
+		that2, ok := that.(DeleteRequest_Metadata)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.FilePath != that1.FilePath {
-		return false
-	}
-	if this.FileMode != that1.FileMode {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.SynchronousIO != that1.SynchronousIO {
+	if !this.Metadata.Equal(that1.Metadata) {
 		return false
 	}
 	return true
 }
-func (this *DataReadFileResponse) Equal(that interface{}) bool {
+func (this *DeleteResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataReadFileResponse)
+	that1, ok := that.(*DeleteResponse)
 	if !ok {
-		that2, ok := that.(DataReadFileResponse)
+		that2, ok := that.(DeleteResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4094,14 +4611,14 @@ func (this *DataReadFileResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DataReadStreamRequest) Equal(that interface{}) bool {
+func (this *CheckRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataReadStreamRequest)
+	that1, ok := that.(*CheckRequest)
 	if !ok {
-		that2, ok := that.(DataReadStreamRequest)
+		that2, ok := that.(CheckRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4113,27 +4630,28 @@ func (this *DataReadStreamRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
-		return false
-	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+	if that1.Input == nil {
+		if this.Input != nil {
 			return false
 		}
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	if this.ChunkSize != that1.ChunkSize {
+	if this.Fast != that1.Fast {
 		return false
 	}
 	return true
 }
-func (this *DataReadStreamResponse) Equal(that interface{}) bool {
+func (this *CheckRequest_Key) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataReadStreamResponse)
+	that1, ok := that.(*CheckRequest_Key)
 	if !ok {
-		that2, ok := that.(DataReadStreamResponse)
+		that2, ok := that.(CheckRequest_Key)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4145,19 +4663,19 @@ func (this *DataReadStreamResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
+	if !bytes.Equal(this.Key, that1.Key) {
 		return false
 	}
 	return true
 }
-func (this *DataDeleteRequest) Equal(that interface{}) bool {
+func (this *CheckRequest_Metadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataDeleteRequest)
+	that1, ok := that.(*CheckRequest_Metadata)
 	if !ok {
-		that2, ok := that.(DataDeleteRequest)
+		that2, ok := that.(CheckRequest_Metadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4169,24 +4687,19 @@ func (this *DataDeleteRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
+	if !this.Metadata.Equal(that1.Metadata) {
 		return false
 	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (this *DataDeleteResponse) Equal(that interface{}) bool {
+func (this *CheckResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataDeleteResponse)
+	that1, ok := that.(*CheckResponse)
 	if !ok {
-		that2, ok := that.(DataDeleteResponse)
+		that2, ok := that.(CheckResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4198,16 +4711,19 @@ func (this *DataDeleteResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Status != that1.Status {
+		return false
+	}
 	return true
 }
-func (this *DataCheckRequest) Equal(that interface{}) bool {
+func (this *RepairRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataCheckRequest)
+	that1, ok := that.(*RepairRequest)
 	if !ok {
-		that2, ok := that.(DataCheckRequest)
+		that2, ok := that.(RepairRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4219,27 +4735,19 @@ func (this *DataCheckRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
-		return false
-	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
-			return false
-		}
-	}
-	if this.Fast != that1.Fast {
+	if !bytes.Equal(this.Key, that1.Key) {
 		return false
 	}
 	return true
 }
-func (this *DataCheckResponse) Equal(that interface{}) bool {
+func (this *RepairResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataCheckResponse)
+	that1, ok := that.(*RepairResponse)
 	if !ok {
-		that2, ok := that.(DataCheckResponse)
+		that2, ok := that.(RepairResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4251,19 +4759,19 @@ func (this *DataCheckResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Status != that1.Status {
+	if !this.Metadata.Equal(that1.Metadata) {
 		return false
 	}
 	return true
 }
-func (this *DataRepairRequest) Equal(that interface{}) bool {
+func (this *UploadedPart) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRepairRequest)
+	that1, ok := that.(*UploadedPart)
 	if !ok {
-		that2, ok := that.(DataRepairRequest)
+		that2, ok := that.(UploadedPart)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4275,24 +4783,25 @@ func (this *DataRepairRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
+	if this.Number != that1.Number {
 		return false
 	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
-			return false
-		}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	if this.LastWriteEpoch != that1.LastWriteEpoch {
+		return false
 	}
 	return true
 }
-func (this *DataRepairResponse) Equal(that interface{}) bool {
+func (this *CreateUploadRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRepairResponse)
+	that1, ok := that.(*CreateUploadRequest)
 	if !ok {
-		that2, ok := that.(DataRepairResponse)
+		that2, ok := that.(CreateUploadRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4304,5171 +4813,9741 @@ func (this *DataRepairResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Chunks) != len(that1.Chunks) {
+	if !bytes.Equal(this.Key, that1.Key) {
 		return false
 	}
-	for i := range this.Chunks {
-		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+	return true
+}
+func (this *CreateUploadResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateUploadResponse)
+	if !ok {
+		that2, ok := that.(CreateUploadResponse)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UploadID != that1.UploadID {
+		return false
+	}
 	return true
 }
-func (this *Metadata) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UploadPartRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&schema.Metadata{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "TotalSize: "+fmt.Sprintf("%#v", this.TotalSize)+",\n")
-	s = append(s, "CreationEpoch: "+fmt.Sprintf("%#v", this.CreationEpoch)+",\n")
-	s = append(s, "LastWriteEpoch: "+fmt.Sprintf("%#v", this.LastWriteEpoch)+",\n")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+
+	that1, ok := that.(*UploadPartRequest)
+	if !ok {
+		that2, ok := that.(UploadPartRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Chunk) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&schema.Chunk{")
-	s = append(s, "ChunkSize: "+fmt.Sprintf("%#v", this.ChunkSize)+",\n")
-	if this.Objects != nil {
-		s = append(s, "Objects: "+fmt.Sprintf("%#v", this.Objects)+",\n")
+	if that1.Input == nil {
+		if this.Input != nil {
+			return false
+		}
+	} else if this.Input == nil {
+		return false
+	} else if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *Object) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UploadPartRequest_Part_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&schema.Object{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *WriteRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*UploadPartRequest_Part_)
+	if !ok {
+		that2, ok := that.(UploadPartRequest_Part_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&schema.WriteRequest{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *WriteResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.WriteResponse{")
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if !this.Part.Equal(that1.Part) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *WriteFileRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UploadPartRequest_Data_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&schema.WriteFileRequest{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "FilePath: "+fmt.Sprintf("%#v", this.FilePath)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *WriteFileResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*UploadPartRequest_Data_)
+	if !ok {
+		that2, ok := that.(UploadPartRequest_Data_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.WriteFileResponse{")
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	return true
 }
-func (this *WriteStreamRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UploadPartRequest_Part) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&schema.WriteStreamRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+
+	that1, ok := that.(*UploadPartRequest_Part)
+	if !ok {
+		that2, ok := that.(UploadPartRequest_Part)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *WriteStreamRequest_Metadata_) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&schema.WriteStreamRequest_Metadata_{` +
-		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
-	return s
-}
-func (this *WriteStreamRequest_Data_) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.UploadID != that1.UploadID {
+		return false
 	}
-	s := strings.Join([]string{`&schema.WriteStreamRequest_Data_{` +
-		`Data:` + fmt.Sprintf("%#v", this.Data) + `}`}, ", ")
-	return s
-}
-func (this *WriteStreamRequest_Metadata) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Number != that1.Number {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.WriteStreamRequest_Metadata{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *WriteStreamRequest_Data) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UploadPartRequest_Data) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.WriteStreamRequest_Data{")
-	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *WriteStreamResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*UploadPartRequest_Data)
+	if !ok {
+		that2, ok := that.(UploadPartRequest_Data)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.WriteStreamResponse{")
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReadRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&schema.ReadRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ReadRequest_Key) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UploadPartResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&schema.ReadRequest_Key{` +
-		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
-	return s
-}
-func (this *ReadRequest_Metadata) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*UploadPartResponse)
+	if !ok {
+		that2, ok := that.(UploadPartResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&schema.ReadRequest_Metadata{` +
-		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
-	return s
-}
-func (this *ReadResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.ReadResponse{")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.Part.Equal(that1.Part) {
+		return false
+	}
+	return true
 }
-func (this *ReadFileRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ListUploadPartsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&schema.ReadFileRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+
+	that1, ok := that.(*ListUploadPartsRequest)
+	if !ok {
+		that2, ok := that.(ListUploadPartsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "FilePath: "+fmt.Sprintf("%#v", this.FilePath)+",\n")
-	s = append(s, "FileMode: "+fmt.Sprintf("%#v", this.FileMode)+",\n")
-	s = append(s, "SynchronousIO: "+fmt.Sprintf("%#v", this.SynchronousIO)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReadFileRequest_Key) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&schema.ReadFileRequest_Key{` +
-		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
-	return s
-}
-func (this *ReadFileRequest_Metadata) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.UploadID != that1.UploadID {
+		return false
 	}
-	s := strings.Join([]string{`&schema.ReadFileRequest_Metadata{` +
-		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
-	return s
+	return true
 }
-func (this *ReadFileResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ListUploadPartsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&schema.ReadFileResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReadStreamRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ListUploadPartsResponse)
+	if !ok {
+		that2, ok := that.(ListUploadPartsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&schema.ReadStreamRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ChunkSize: "+fmt.Sprintf("%#v", this.ChunkSize)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReadStreamRequest_Key) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Parts) != len(that1.Parts) {
+		return false
 	}
-	s := strings.Join([]string{`&schema.ReadStreamRequest_Key{` +
-		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
-	return s
-}
-func (this *ReadStreamRequest_Metadata) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Parts {
+		if !this.Parts[i].Equal(that1.Parts[i]) {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&schema.ReadStreamRequest_Metadata{` +
-		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
-	return s
+	return true
 }
-func (this *ReadStreamResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *CompleteUploadRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.ReadStreamResponse{")
-	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeleteRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*CompleteUploadRequest)
+	if !ok {
+		that2, ok := that.(CompleteUploadRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&schema.DeleteRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeleteRequest_Key) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.UploadID != that1.UploadID {
+		return false
 	}
-	s := strings.Join([]string{`&schema.DeleteRequest_Key{` +
-		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
-	return s
+	return true
 }
-func (this *DeleteRequest_Metadata) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *CompleteUploadResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&schema.DeleteRequest_Metadata{` +
-		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
-	return s
-}
-func (this *DeleteResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*CompleteUploadResponse)
+	if !ok {
+		that2, ok := that.(CompleteUploadResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&schema.DeleteResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CheckRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&schema.CheckRequest{")
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
 	}
-	s = append(s, "Fast: "+fmt.Sprintf("%#v", this.Fast)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *CheckRequest_Key) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *AbortUploadRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&schema.CheckRequest_Key{` +
-		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
-	return s
-}
-func (this *CheckRequest_Metadata) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*AbortUploadRequest)
+	if !ok {
+		that2, ok := that.(AbortUploadRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&schema.CheckRequest_Metadata{` +
-		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
-	return s
-}
-func (this *CheckResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.CheckResponse{")
-	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RepairRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.UploadID != that1.UploadID {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.RepairRequest{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RepairResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.RepairResponse{")
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+func (this *AbortUploadResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SetMetadataRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*AbortUploadResponse)
+	if !ok {
+		that2, ok := that.(AbortUploadResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.SetMetadataRequest{")
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *SetMetadataResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SetMetadataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&schema.SetMetadataResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetMetadataRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*SetMetadataRequest)
+	if !ok {
+		that2, ok := that.(SetMetadataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.GetMetadataRequest{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetMetadataResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.GetMetadataResponse{")
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DeleteMetadataRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *SetMetadataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DeleteMetadataRequest{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeleteMetadataResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*SetMetadataResponse)
+	if !ok {
+		that2, ok := that.(SetMetadataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&schema.DeleteMetadataResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListMetadataKeysRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&schema.ListMetadataKeysRequest{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ListMetadataKeysResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetMetadataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.ListMetadataKeysResponse{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataWriteRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*GetMetadataRequest)
+	if !ok {
+		that2, ok := that.(GetMetadataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataWriteRequest{")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataWriteResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataWriteResponse{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DataWriteFileRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetMetadataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataWriteFileRequest{")
-	s = append(s, "FilePath: "+fmt.Sprintf("%#v", this.FilePath)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataWriteFileResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*GetMetadataResponse)
+	if !ok {
+		that2, ok := that.(GetMetadataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataWriteFileResponse{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataWriteStreamRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataWriteStreamRequest{")
-	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DataWriteStreamResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DeleteMetadataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataWriteStreamResponse{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+
+	that1, ok := that.(*DeleteMetadataRequest)
+	if !ok {
+		that2, ok := that.(DeleteMetadataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataReadRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataReadRequest{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DataReadResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DeleteMetadataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataReadResponse{")
-	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataReadFileRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*DeleteMetadataResponse)
+	if !ok {
+		that2, ok := that.(DeleteMetadataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&schema.DataReadFileRequest{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "FilePath: "+fmt.Sprintf("%#v", this.FilePath)+",\n")
-	s = append(s, "FileMode: "+fmt.Sprintf("%#v", this.FileMode)+",\n")
-	s = append(s, "SynchronousIO: "+fmt.Sprintf("%#v", this.SynchronousIO)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DataReadFileResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ListMetadataKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&schema.DataReadFileResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataReadStreamRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ListMetadataKeysRequest)
+	if !ok {
+		that2, ok := that.(ListMetadataKeysRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&schema.DataReadStreamRequest{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ChunkSize: "+fmt.Sprintf("%#v", this.ChunkSize)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DataReadStreamResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ListMetadataKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataReadStreamResponse{")
-	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataDeleteRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ListMetadataKeysResponse)
+	if !ok {
+		that2, ok := that.(ListMetadataKeysResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataDeleteRequest{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataDeleteResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&schema.DataDeleteResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DataCheckRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DataWriteRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&schema.DataCheckRequest{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+
+	that1, ok := that.(*DataWriteRequest)
+	if !ok {
+		that2, ok := that.(DataWriteRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "Fast: "+fmt.Sprintf("%#v", this.Fast)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataCheckResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataCheckResponse{")
-	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
 }
-func (this *DataRepairRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DataWriteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataRepairRequest{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+
+	that1, ok := that.(*DataWriteResponse)
+	if !ok {
+		that2, ok := that.(DataWriteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DataRepairResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&schema.DataRepairResponse{")
-	if this.Chunks != nil {
-		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringDaemon(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
 }
+func (this *DataWriteFileRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// FileServiceClient is the client API for FileService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type FileServiceClient interface {
-	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error)
-	WriteFile(ctx context.Context, in *WriteFileRequest, opts ...grpc.CallOption) (*WriteFileResponse, error)
-	WriteStream(ctx context.Context, opts ...grpc.CallOption) (FileService_WriteStreamClient, error)
-	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
-	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (*ReadFileResponse, error)
-	ReadStream(ctx context.Context, in *ReadStreamRequest, opts ...grpc.CallOption) (FileService_ReadStreamClient, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
-	Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*RepairResponse, error)
+	that1, ok := that.(*DataWriteFileRequest)
+	if !ok {
+		that2, ok := that.(DataWriteFileRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FilePath != that1.FilePath {
+		return false
+	}
+	return true
 }
+func (this *DataWriteFileResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type fileServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewFileServiceClient(cc *grpc.ClientConn) FileServiceClient {
-	return &fileServiceClient{cc}
-}
-
-func (c *fileServiceClient) Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
-	out := new(WriteResponse)
-	err := c.cc.Invoke(ctx, "/schema.FileService/Write", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataWriteFileResponse)
+	if !ok {
+		that2, ok := that.(DataWriteFileResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *fileServiceClient) WriteFile(ctx context.Context, in *WriteFileRequest, opts ...grpc.CallOption) (*WriteFileResponse, error) {
-	out := new(WriteFileResponse)
-	err := c.cc.Invoke(ctx, "/schema.FileService/WriteFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *fileServiceClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (FileService_WriteStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_FileService_serviceDesc.Streams[0], "/schema.FileService/WriteStream", opts...)
-	if err != nil {
-		return nil, err
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
 	}
-	x := &fileServiceWriteStreamClient{stream}
-	return x, nil
-}
-
-type FileService_WriteStreamClient interface {
-	Send(*WriteStreamRequest) error
-	CloseAndRecv() (*WriteStreamResponse, error)
-	grpc.ClientStream
-}
-
-type fileServiceWriteStreamClient struct {
-	grpc.ClientStream
-}
-
-func (x *fileServiceWriteStreamClient) Send(m *WriteStreamRequest) error {
-	return x.ClientStream.SendMsg(m)
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *DataWriteStreamRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (x *fileServiceWriteStreamClient) CloseAndRecv() (*WriteStreamResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	that1, ok := that.(*DataWriteStreamRequest)
+	if !ok {
+		that2, ok := that.(DataWriteStreamRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	m := new(WriteStreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return m, nil
-}
-
-func (c *fileServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
-	out := new(ReadResponse)
-	err := c.cc.Invoke(ctx, "/schema.FileService/Read", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
+		return false
 	}
-	return out, nil
+	return true
 }
-
-func (c *fileServiceClient) ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (*ReadFileResponse, error) {
-	out := new(ReadFileResponse)
-	err := c.cc.Invoke(ctx, "/schema.FileService/ReadFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *DataWriteStreamResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *fileServiceClient) ReadStream(ctx context.Context, in *ReadStreamRequest, opts ...grpc.CallOption) (FileService_ReadStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_FileService_serviceDesc.Streams[1], "/schema.FileService/ReadStream", opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataWriteStreamResponse)
+	if !ok {
+		that2, ok := that.(DataWriteStreamResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	x := &fileServiceReadStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
 	}
-	return x, nil
-}
-
-type FileService_ReadStreamClient interface {
-	Recv() (*ReadStreamResponse, error)
-	grpc.ClientStream
-}
-
-type fileServiceReadStreamClient struct {
-	grpc.ClientStream
-}
-
-func (x *fileServiceReadStreamClient) Recv() (*ReadStreamResponse, error) {
-	m := new(ReadStreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
 	}
-	return m, nil
+	return true
 }
+func (this *DataReadRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *fileServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/schema.FileService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataReadRequest)
+	if !ok {
+		that2, ok := that.(DataReadRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
+	}
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *DataReadResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *fileServiceClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
-	out := new(CheckResponse)
-	err := c.cc.Invoke(ctx, "/schema.FileService/Check", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataReadResponse)
+	if !ok {
+		that2, ok := that.(DataReadResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
 }
+func (this *DataReadFileRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *fileServiceClient) Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*RepairResponse, error) {
-	out := new(RepairResponse)
-	err := c.cc.Invoke(ctx, "/schema.FileService/Repair", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataReadFileRequest)
+	if !ok {
+		that2, ok := that.(DataReadFileRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
+	}
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
+	}
+	if this.FilePath != that1.FilePath {
+		return false
+	}
+	if this.FileMode != that1.FileMode {
+		return false
+	}
+	if this.SynchronousIO != that1.SynchronousIO {
+		return false
+	}
+	return true
 }
+func (this *DataReadFileResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// FileServiceServer is the server API for FileService service.
-type FileServiceServer interface {
-	Write(context.Context, *WriteRequest) (*WriteResponse, error)
-	WriteFile(context.Context, *WriteFileRequest) (*WriteFileResponse, error)
-	WriteStream(FileService_WriteStreamServer) error
-	Read(context.Context, *ReadRequest) (*ReadResponse, error)
-	ReadFile(context.Context, *ReadFileRequest) (*ReadFileResponse, error)
-	ReadStream(*ReadStreamRequest, FileService_ReadStreamServer) error
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	Check(context.Context, *CheckRequest) (*CheckResponse, error)
-	Repair(context.Context, *RepairRequest) (*RepairResponse, error)
-}
-
-// UnimplementedFileServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedFileServiceServer struct {
-}
-
-func (*UnimplementedFileServiceServer) Write(ctx context.Context, req *WriteRequest) (*WriteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
-}
-func (*UnimplementedFileServiceServer) WriteFile(ctx context.Context, req *WriteFileRequest) (*WriteFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteFile not implemented")
-}
-func (*UnimplementedFileServiceServer) WriteStream(srv FileService_WriteStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
-}
-func (*UnimplementedFileServiceServer) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
-}
-func (*UnimplementedFileServiceServer) ReadFile(ctx context.Context, req *ReadFileRequest) (*ReadFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
-}
-func (*UnimplementedFileServiceServer) ReadStream(req *ReadStreamRequest, srv FileService_ReadStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method ReadStream not implemented")
-}
-func (*UnimplementedFileServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
-}
-func (*UnimplementedFileServiceServer) Check(ctx context.Context, req *CheckRequest) (*CheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
-}
-func (*UnimplementedFileServiceServer) Repair(ctx context.Context, req *RepairRequest) (*RepairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Repair not implemented")
-}
-
-func RegisterFileServiceServer(s *grpc.Server, srv FileServiceServer) {
-	s.RegisterService(&_FileService_serviceDesc, srv)
-}
-
-func _FileService_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WriteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(FileServiceServer).Write(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.FileService/Write",
+	that1, ok := that.(*DataReadFileResponse)
+	if !ok {
+		that2, ok := that.(DataReadFileResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileServiceServer).Write(ctx, req.(*WriteRequest))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *DataReadStreamRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _FileService_WriteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WriteFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*DataReadStreamRequest)
+	if !ok {
+		that2, ok := that.(DataReadStreamRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(FileServiceServer).WriteFile(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.FileService/WriteFile",
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileServiceServer).WriteFile(ctx, req.(*WriteFileRequest))
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _FileService_WriteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(FileServiceServer).WriteStream(&fileServiceWriteStreamServer{stream})
-}
-
-type FileService_WriteStreamServer interface {
-	SendAndClose(*WriteStreamResponse) error
-	Recv() (*WriteStreamRequest, error)
-	grpc.ServerStream
-}
-
-type fileServiceWriteStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *fileServiceWriteStreamServer) SendAndClose(m *WriteStreamResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *fileServiceWriteStreamServer) Recv() (*WriteStreamRequest, error) {
-	m := new(WriteStreamRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+	if this.ChunkSize != that1.ChunkSize {
+		return false
 	}
-	return m, nil
+	return true
 }
-
-func _FileService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *DataReadStreamResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(FileServiceServer).Read(ctx, in)
+
+	that1, ok := that.(*DataReadStreamResponse)
+	if !ok {
+		that2, ok := that.(DataReadStreamResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.FileService/Read",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileServiceServer).Read(ctx, req.(*ReadRequest))
+	if !bytes.Equal(this.DataChunk, that1.DataChunk) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *DataDeleteRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _FileService_ReadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*DataDeleteRequest)
+	if !ok {
+		that2, ok := that.(DataDeleteRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(FileServiceServer).ReadFile(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.FileService/ReadFile",
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileServiceServer).ReadFile(ctx, req.(*ReadFileRequest))
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _FileService_ReadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ReadStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (this *DataDeleteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return srv.(FileServiceServer).ReadStream(m, &fileServiceReadStreamServer{stream})
-}
-
-type FileService_ReadStreamServer interface {
-	Send(*ReadStreamResponse) error
-	grpc.ServerStream
-}
-
-type fileServiceReadStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *fileServiceReadStreamServer) Send(m *ReadStreamResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
 
-func _FileService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(FileServiceServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.FileService/Delete",
+	that1, ok := that.(*DataDeleteResponse)
+	if !ok {
+		that2, ok := that.(DataDeleteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileServiceServer).Delete(ctx, req.(*DeleteRequest))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _FileService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *DataCheckRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(FileServiceServer).Check(ctx, in)
+
+	that1, ok := that.(*DataCheckRequest)
+	if !ok {
+		that2, ok := that.(DataCheckRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.FileService/Check",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileServiceServer).Check(ctx, req.(*CheckRequest))
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
+	}
+	if this.Fast != that1.Fast {
+		return false
+	}
+	return true
 }
-
-func _FileService_Repair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *DataCheckResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(FileServiceServer).Repair(ctx, in)
+
+	that1, ok := that.(*DataCheckResponse)
+	if !ok {
+		that2, ok := that.(DataCheckResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.FileService/Repair",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FileServiceServer).Repair(ctx, req.(*RepairRequest))
+	if this.Status != that1.Status {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _FileService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "schema.FileService",
-	HandlerType: (*FileServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Write",
-			Handler:    _FileService_Write_Handler,
-		},
-		{
-			MethodName: "WriteFile",
-			Handler:    _FileService_WriteFile_Handler,
-		},
-		{
-			MethodName: "Read",
-			Handler:    _FileService_Read_Handler,
-		},
-		{
-			MethodName: "ReadFile",
-			Handler:    _FileService_ReadFile_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _FileService_Delete_Handler,
-		},
-		{
-			MethodName: "Check",
-			Handler:    _FileService_Check_Handler,
-		},
-		{
-			MethodName: "Repair",
-			Handler:    _FileService_Repair_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WriteStream",
-			Handler:       _FileService_WriteStream_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ReadStream",
-			Handler:       _FileService_ReadStream_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "schema/daemon.proto",
-}
-
-// MetadataServiceClient is the client API for MetadataService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MetadataServiceClient interface {
-	SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error)
-	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
-	DeleteMetadata(ctx context.Context, in *DeleteMetadataRequest, opts ...grpc.CallOption) (*DeleteMetadataResponse, error)
-	ListKeys(ctx context.Context, in *ListMetadataKeysRequest, opts ...grpc.CallOption) (MetadataService_ListKeysClient, error)
-}
-
-type metadataServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewMetadataServiceClient(cc *grpc.ClientConn) MetadataServiceClient {
-	return &metadataServiceClient{cc}
+	return true
 }
+func (this *DataRepairRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *metadataServiceClient) SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error) {
-	out := new(SetMetadataResponse)
-	err := c.cc.Invoke(ctx, "/schema.MetadataService/SetMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataRepairRequest)
+	if !ok {
+		that2, ok := that.(DataRepairRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
+	}
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *DataRepairResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *metadataServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
-	out := new(GetMetadataResponse)
-	err := c.cc.Invoke(ctx, "/schema.MetadataService/GetMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataRepairResponse)
+	if !ok {
+		that2, ok := that.(DataRepairResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Chunks) != len(that1.Chunks) {
+		return false
+	}
+	for i := range this.Chunks {
+		if !this.Chunks[i].Equal(that1.Chunks[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *DataShardHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *metadataServiceClient) DeleteMetadata(ctx context.Context, in *DeleteMetadataRequest, opts ...grpc.CallOption) (*DeleteMetadataResponse, error) {
-	out := new(DeleteMetadataResponse)
-	err := c.cc.Invoke(ctx, "/schema.MetadataService/DeleteMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataShardHealthRequest)
+	if !ok {
+		that2, ok := that.(DataShardHealthRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *DataShardHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *metadataServiceClient) ListKeys(ctx context.Context, in *ListMetadataKeysRequest, opts ...grpc.CallOption) (MetadataService_ListKeysClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_MetadataService_serviceDesc.Streams[0], "/schema.MetadataService/ListKeys", opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DataShardHealthResponse)
+	if !ok {
+		that2, ok := that.(DataShardHealthResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	x := &metadataServiceListKeysClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	if len(this.Shards) != len(that1.Shards) {
+		return false
 	}
-	return x, nil
+	for i := range this.Shards {
+		if !this.Shards[i].Equal(that1.Shards[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *ShardHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type MetadataService_ListKeysClient interface {
-	Recv() (*ListMetadataKeysResponse, error)
-	grpc.ClientStream
-}
-
-type metadataServiceListKeysClient struct {
-	grpc.ClientStream
-}
-
-func (x *metadataServiceListKeysClient) Recv() (*ListMetadataKeysResponse, error) {
-	m := new(ListMetadataKeysResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	that1, ok := that.(*ShardHealth)
+	if !ok {
+		that2, ok := that.(ShardHealth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return m, nil
-}
-
-// MetadataServiceServer is the server API for MetadataService service.
-type MetadataServiceServer interface {
-	SetMetadata(context.Context, *SetMetadataRequest) (*SetMetadataResponse, error)
-	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
-	DeleteMetadata(context.Context, *DeleteMetadataRequest) (*DeleteMetadataResponse, error)
-	ListKeys(*ListMetadataKeysRequest, MetadataService_ListKeysServer) error
-}
-
-// UnimplementedMetadataServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedMetadataServiceServer struct {
-}
-
-func (*UnimplementedMetadataServiceServer) SetMetadata(ctx context.Context, req *SetMetadataRequest) (*SetMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMetadata not implemented")
-}
-func (*UnimplementedMetadataServiceServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
-}
-func (*UnimplementedMetadataServiceServer) DeleteMetadata(ctx context.Context, req *DeleteMetadataRequest) (*DeleteMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadata not implemented")
-}
-func (*UnimplementedMetadataServiceServer) ListKeys(req *ListMetadataKeysRequest, srv MetadataService_ListKeysServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
-}
-
-func RegisterMetadataServiceServer(s *grpc.Server, srv MetadataServiceServer) {
-	s.RegisterService(&_MetadataService_serviceDesc, srv)
-}
-
-func _MetadataService_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(MetadataServiceServer).SetMetadata(ctx, in)
+	if this.Shard != that1.Shard {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.MetadataService/SetMetadata",
+	if this.State != that1.State {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).SetMetadata(ctx, req.(*SetMetadataRequest))
+	if this.Since != that1.Since {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _MetadataService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.Failures != that1.Failures {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(MetadataServiceServer).GetMetadata(ctx, in)
+	if this.LastError != that1.LastError {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.MetadataService/GetMetadata",
+	if this.LastErrorTime != that1.LastErrorTime {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	if this.Latency != that1.Latency {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if !this.Namespace.Equal(that1.Namespace) {
+		return false
+	}
+	return true
 }
+func (this *NamespaceHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _MetadataService_DeleteMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*NamespaceHealth)
+	if !ok {
+		that2, ok := that.(NamespaceHealth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MetadataServiceServer).DeleteMetadata(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.MetadataService/DeleteMetadata",
+	if this.DataIOErrors != that1.DataIOErrors {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).DeleteMetadata(ctx, req.(*DeleteMetadataRequest))
+	if this.IndexIOErrors != that1.IndexIOErrors {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _MetadataService_ListKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListMetadataKeysRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if this.DataFaults != that1.DataFaults {
+		return false
 	}
-	return srv.(MetadataServiceServer).ListKeys(m, &metadataServiceListKeysServer{stream})
-}
-
-type MetadataService_ListKeysServer interface {
-	Send(*ListMetadataKeysResponse) error
-	grpc.ServerStream
-}
-
-type metadataServiceListKeysServer struct {
-	grpc.ServerStream
-}
-
-func (x *metadataServiceListKeysServer) Send(m *ListMetadataKeysResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-var _MetadataService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "schema.MetadataService",
-	HandlerType: (*MetadataServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetMetadata",
-			Handler:    _MetadataService_SetMetadata_Handler,
-		},
-		{
-			MethodName: "GetMetadata",
-			Handler:    _MetadataService_GetMetadata_Handler,
-		},
-		{
-			MethodName: "DeleteMetadata",
-			Handler:    _MetadataService_DeleteMetadata_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "ListKeys",
-			Handler:       _MetadataService_ListKeys_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "schema/daemon.proto",
-}
-
-// DataServiceClient is the client API for DataService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataServiceClient interface {
-	Write(ctx context.Context, in *DataWriteRequest, opts ...grpc.CallOption) (*DataWriteResponse, error)
-	WriteFile(ctx context.Context, in *DataWriteFileRequest, opts ...grpc.CallOption) (*DataWriteFileResponse, error)
-	WriteStream(ctx context.Context, opts ...grpc.CallOption) (DataService_WriteStreamClient, error)
-	Read(ctx context.Context, in *DataReadRequest, opts ...grpc.CallOption) (*DataReadResponse, error)
-	ReadFile(ctx context.Context, in *DataReadFileRequest, opts ...grpc.CallOption) (*DataReadFileResponse, error)
-	ReadStream(ctx context.Context, in *DataReadStreamRequest, opts ...grpc.CallOption) (DataService_ReadStreamClient, error)
-	Delete(ctx context.Context, in *DataDeleteRequest, opts ...grpc.CallOption) (*DataDeleteResponse, error)
-	Check(ctx context.Context, in *DataCheckRequest, opts ...grpc.CallOption) (*DataCheckResponse, error)
-	Repair(ctx context.Context, in *DataRepairRequest, opts ...grpc.CallOption) (*DataRepairResponse, error)
-}
-
-type dataServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewDataServiceClient(cc *grpc.ClientConn) DataServiceClient {
-	return &dataServiceClient{cc}
-}
-
-func (c *dataServiceClient) Write(ctx context.Context, in *DataWriteRequest, opts ...grpc.CallOption) (*DataWriteResponse, error) {
-	out := new(DataWriteResponse)
-	err := c.cc.Invoke(ctx, "/schema.DataService/Write", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.IndexFaults != that1.IndexFaults {
+		return false
 	}
-	return out, nil
-}
-
-func (c *dataServiceClient) WriteFile(ctx context.Context, in *DataWriteFileRequest, opts ...grpc.CallOption) (*DataWriteFileResponse, error) {
-	out := new(DataWriteFileResponse)
-	err := c.cc.Invoke(ctx, "/schema.DataService/WriteFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.DataIOErrorLast != that1.DataIOErrorLast {
+		return false
 	}
-	return out, nil
-}
-
-func (c *dataServiceClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (DataService_WriteStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DataService_serviceDesc.Streams[0], "/schema.DataService/WriteStream", opts...)
-	if err != nil {
-		return nil, err
+	if this.IndexIOErrorLast != that1.IndexIOErrorLast {
+		return false
 	}
-	x := &dataServiceWriteStreamClient{stream}
-	return x, nil
-}
-
-type DataService_WriteStreamClient interface {
-	Send(*DataWriteStreamRequest) error
-	CloseAndRecv() (*DataWriteStreamResponse, error)
-	grpc.ClientStream
-}
-
-type dataServiceWriteStreamClient struct {
-	grpc.ClientStream
-}
-
-func (x *dataServiceWriteStreamClient) Send(m *DataWriteStreamRequest) error {
-	return x.ClientStream.SendMsg(m)
+	return true
 }
-
-func (x *dataServiceWriteStreamClient) CloseAndRecv() (*DataWriteStreamResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (this *Metadata) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	m := new(DataWriteStreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	s := make([]string, 0, 9)
+	s = append(s, "&schema.Metadata{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "TotalSize: "+fmt.Sprintf("%#v", this.TotalSize)+",\n")
+	s = append(s, "CreationEpoch: "+fmt.Sprintf("%#v", this.CreationEpoch)+",\n")
+	s = append(s, "LastWriteEpoch: "+fmt.Sprintf("%#v", this.LastWriteEpoch)+",\n")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
 	}
-	return m, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *dataServiceClient) Read(ctx context.Context, in *DataReadRequest, opts ...grpc.CallOption) (*DataReadResponse, error) {
-	out := new(DataReadResponse)
-	err := c.cc.Invoke(ctx, "/schema.DataService/Read", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (this *Chunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&schema.Chunk{")
+	s = append(s, "ChunkSize: "+fmt.Sprintf("%#v", this.ChunkSize)+",\n")
+	if this.Objects != nil {
+		s = append(s, "Objects: "+fmt.Sprintf("%#v", this.Objects)+",\n")
+	}
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *dataServiceClient) ReadFile(ctx context.Context, in *DataReadFileRequest, opts ...grpc.CallOption) (*DataReadFileResponse, error) {
-	out := new(DataReadFileResponse)
-	err := c.cc.Invoke(ctx, "/schema.DataService/ReadFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *Object) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.Object{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *dataServiceClient) ReadStream(ctx context.Context, in *DataReadStreamRequest, opts ...grpc.CallOption) (DataService_ReadStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DataService_serviceDesc.Streams[1], "/schema.DataService/ReadStream", opts...)
-	if err != nil {
-		return nil, err
+func (this *WriteRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	x := &dataServiceReadStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.WriteRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WriteResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.WriteResponse{")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	return x, nil
-}
-
-type DataService_ReadStreamClient interface {
-	Recv() (*DataReadStreamResponse, error)
-	grpc.ClientStream
-}
-
-type dataServiceReadStreamClient struct {
-	grpc.ClientStream
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (x *dataServiceReadStreamClient) Recv() (*DataReadStreamResponse, error) {
-	m := new(DataReadStreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (this *WriteFileRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return m, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.WriteFileRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "FilePath: "+fmt.Sprintf("%#v", this.FilePath)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *dataServiceClient) Delete(ctx context.Context, in *DataDeleteRequest, opts ...grpc.CallOption) (*DataDeleteResponse, error) {
-	out := new(DataDeleteResponse)
-	err := c.cc.Invoke(ctx, "/schema.DataService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *WriteFileResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
-}
-
-func (c *dataServiceClient) Check(ctx context.Context, in *DataCheckRequest, opts ...grpc.CallOption) (*DataCheckResponse, error) {
-	out := new(DataCheckResponse)
-	err := c.cc.Invoke(ctx, "/schema.DataService/Check", in, out, opts...)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.WriteFileResponse{")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	return out, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (c *dataServiceClient) Repair(ctx context.Context, in *DataRepairRequest, opts ...grpc.CallOption) (*DataRepairResponse, error) {
-	out := new(DataRepairResponse)
-	err := c.cc.Invoke(ctx, "/schema.DataService/Repair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *WriteStreamRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return out, nil
-}
-
-// DataServiceServer is the server API for DataService service.
-type DataServiceServer interface {
-	Write(context.Context, *DataWriteRequest) (*DataWriteResponse, error)
-	WriteFile(context.Context, *DataWriteFileRequest) (*DataWriteFileResponse, error)
-	WriteStream(DataService_WriteStreamServer) error
-	Read(context.Context, *DataReadRequest) (*DataReadResponse, error)
-	ReadFile(context.Context, *DataReadFileRequest) (*DataReadFileResponse, error)
-	ReadStream(*DataReadStreamRequest, DataService_ReadStreamServer) error
-	Delete(context.Context, *DataDeleteRequest) (*DataDeleteResponse, error)
-	Check(context.Context, *DataCheckRequest) (*DataCheckResponse, error)
-	Repair(context.Context, *DataRepairRequest) (*DataRepairResponse, error)
-}
-
-// UnimplementedDataServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedDataServiceServer struct {
-}
-
-func (*UnimplementedDataServiceServer) Write(ctx context.Context, req *DataWriteRequest) (*DataWriteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
-}
-func (*UnimplementedDataServiceServer) WriteFile(ctx context.Context, req *DataWriteFileRequest) (*DataWriteFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WriteFile not implemented")
-}
-func (*UnimplementedDataServiceServer) WriteStream(srv DataService_WriteStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
-}
-func (*UnimplementedDataServiceServer) Read(ctx context.Context, req *DataReadRequest) (*DataReadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.WriteStreamRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (*UnimplementedDataServiceServer) ReadFile(ctx context.Context, req *DataReadFileRequest) (*DataReadFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+func (this *WriteStreamRequest_Metadata_) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&schema.WriteStreamRequest_Metadata_{` +
+		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
+	return s
 }
-func (*UnimplementedDataServiceServer) ReadStream(req *DataReadStreamRequest, srv DataService_ReadStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method ReadStream not implemented")
+func (this *WriteStreamRequest_Data_) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&schema.WriteStreamRequest_Data_{` +
+		`Data:` + fmt.Sprintf("%#v", this.Data) + `}`}, ", ")
+	return s
 }
-func (*UnimplementedDataServiceServer) Delete(ctx context.Context, req *DataDeleteRequest) (*DataDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (this *WriteStreamRequest_Metadata) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.WriteStreamRequest_Metadata{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (*UnimplementedDataServiceServer) Check(ctx context.Context, req *DataCheckRequest) (*DataCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+func (this *WriteStreamRequest_Data) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.WriteStreamRequest_Data{")
+	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (*UnimplementedDataServiceServer) Repair(ctx context.Context, req *DataRepairRequest) (*DataRepairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Repair not implemented")
+func (this *WriteStreamResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.WriteStreamResponse{")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func RegisterDataServiceServer(s *grpc.Server, srv DataServiceServer) {
-	s.RegisterService(&_DataService_serviceDesc, srv)
+func (this *AppendRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.AppendRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _DataService_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataWriteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *AppendResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(DataServiceServer).Write(ctx, in)
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.AppendResponse{")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.DataService/Write",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReadRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServiceServer).Write(ctx, req.(*DataWriteRequest))
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.ReadRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _DataService_WriteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataWriteFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ReadRequest_Key) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(DataServiceServer).WriteFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.DataService/WriteFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServiceServer).WriteFile(ctx, req.(*DataWriteFileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _DataService_WriteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(DataServiceServer).WriteStream(&dataServiceWriteStreamServer{stream})
-}
-
-type DataService_WriteStreamServer interface {
-	SendAndClose(*DataWriteStreamResponse) error
-	Recv() (*DataWriteStreamRequest, error)
-	grpc.ServerStream
-}
-
-type dataServiceWriteStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *dataServiceWriteStreamServer) SendAndClose(m *DataWriteStreamResponse) error {
-	return x.ServerStream.SendMsg(m)
+	s := strings.Join([]string{`&schema.ReadRequest_Key{` +
+		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
+	return s
 }
-
-func (x *dataServiceWriteStreamServer) Recv() (*DataWriteStreamRequest, error) {
-	m := new(DataWriteStreamRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (this *ReadRequest_Metadata) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return m, nil
+	s := strings.Join([]string{`&schema.ReadRequest_Metadata{` +
+		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
+	return s
 }
-
-func _DataService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataReadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ReadResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(DataServiceServer).Read(ctx, in)
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.ReadResponse{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReadFileRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.DataService/Read",
+	s := make([]string, 0, 9)
+	s = append(s, "&schema.ReadFileRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServiceServer).Read(ctx, req.(*DataReadRequest))
+	s = append(s, "FilePath: "+fmt.Sprintf("%#v", this.FilePath)+",\n")
+	s = append(s, "FileMode: "+fmt.Sprintf("%#v", this.FileMode)+",\n")
+	s = append(s, "SynchronousIO: "+fmt.Sprintf("%#v", this.SynchronousIO)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReadFileRequest_Key) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return interceptor(ctx, in, info, handler)
+	s := strings.Join([]string{`&schema.ReadFileRequest_Key{` +
+		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
+	return s
 }
-
-func _DataService_ReadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataReadFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ReadFileRequest_Metadata) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(DataServiceServer).ReadFile(ctx, in)
+	s := strings.Join([]string{`&schema.ReadFileRequest_Metadata{` +
+		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
+	return s
+}
+func (this *ReadFileResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.DataService/ReadFile",
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.ReadFileResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReadStreamRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServiceServer).ReadFile(ctx, req.(*DataReadFileRequest))
+	s := make([]string, 0, 7)
+	s = append(s, "&schema.ReadStreamRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	return interceptor(ctx, in, info, handler)
+	s = append(s, "ChunkSize: "+fmt.Sprintf("%#v", this.ChunkSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _DataService_ReadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DataReadStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (this *ReadStreamRequest_Key) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return srv.(DataServiceServer).ReadStream(m, &dataServiceReadStreamServer{stream})
-}
-
-type DataService_ReadStreamServer interface {
-	Send(*DataReadStreamResponse) error
-	grpc.ServerStream
+	s := strings.Join([]string{`&schema.ReadStreamRequest_Key{` +
+		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
+	return s
 }
-
-type dataServiceReadStreamServer struct {
-	grpc.ServerStream
+func (this *ReadStreamRequest_Metadata) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&schema.ReadStreamRequest_Metadata{` +
+		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
+	return s
 }
-
-func (x *dataServiceReadStreamServer) Send(m *DataReadStreamResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (this *ReadStreamResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.ReadStreamResponse{")
+	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func _DataService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *DeleteRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(DataServiceServer).Delete(ctx, in)
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.DeleteRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.DataService/Delete",
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteRequest_Key) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServiceServer).Delete(ctx, req.(*DataDeleteRequest))
+	s := strings.Join([]string{`&schema.DeleteRequest_Key{` +
+		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
+	return s
+}
+func (this *DeleteRequest_Metadata) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return interceptor(ctx, in, info, handler)
+	s := strings.Join([]string{`&schema.DeleteRequest_Metadata{` +
+		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
+	return s
 }
-
-func _DataService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataCheckRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *DeleteResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(DataServiceServer).Check(ctx, in)
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.DeleteResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.DataService/Check",
+	s := make([]string, 0, 7)
+	s = append(s, "&schema.CheckRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServiceServer).Check(ctx, req.(*DataCheckRequest))
+	s = append(s, "Fast: "+fmt.Sprintf("%#v", this.Fast)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckRequest_Key) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return interceptor(ctx, in, info, handler)
+	s := strings.Join([]string{`&schema.CheckRequest_Key{` +
+		`Key:` + fmt.Sprintf("%#v", this.Key) + `}`}, ", ")
+	return s
 }
-
-func _DataService_Repair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DataRepairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *CheckRequest_Metadata) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if interceptor == nil {
-		return srv.(DataServiceServer).Repair(ctx, in)
+	s := strings.Join([]string{`&schema.CheckRequest_Metadata{` +
+		`Metadata:` + fmt.Sprintf("%#v", this.Metadata) + `}`}, ", ")
+	return s
+}
+func (this *CheckResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/schema.DataService/Repair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServiceServer).Repair(ctx, req.(*DataRepairRequest))
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.CheckResponse{")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RepairRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return interceptor(ctx, in, info, handler)
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.RepairRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-var _DataService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "schema.DataService",
-	HandlerType: (*DataServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Write",
-			Handler:    _DataService_Write_Handler,
-		},
-		{
-			MethodName: "WriteFile",
-			Handler:    _DataService_WriteFile_Handler,
-		},
-		{
-			MethodName: "Read",
-			Handler:    _DataService_Read_Handler,
-		},
-		{
-			MethodName: "ReadFile",
-			Handler:    _DataService_ReadFile_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _DataService_Delete_Handler,
-		},
-		{
-			MethodName: "Check",
-			Handler:    _DataService_Check_Handler,
-		},
-		{
-			MethodName: "Repair",
-			Handler:    _DataService_Repair_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WriteStream",
-			Handler:       _DataService_WriteStream_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ReadStream",
-			Handler:       _DataService_ReadStream_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "schema/daemon.proto",
+func (this *RepairResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.RepairResponse{")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UploadedPart) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 7)
+	s = append(s, "&schema.UploadedPart{")
+	s = append(s, "Number: "+fmt.Sprintf("%#v", this.Number)+",\n")
+	s = append(s, "Size_: "+fmt.Sprintf("%#v", this.Size_)+",\n")
+	s = append(s, "LastWriteEpoch: "+fmt.Sprintf("%#v", this.LastWriteEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *CreateUploadRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.CreateUploadRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+func (this *CreateUploadResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastWriteEpoch != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.LastWriteEpoch))
-		i--
-		dAtA[i] = 0x20
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.CreateUploadResponse{")
+	s = append(s, "UploadID: "+fmt.Sprintf("%#v", this.UploadID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UploadPartRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.CreationEpoch != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.CreationEpoch))
-		i--
-		dAtA[i] = 0x18
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.UploadPartRequest{")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	if m.TotalSize != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.TotalSize))
-		i--
-		dAtA[i] = 0x10
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UploadPartRequest_Part_) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	s := strings.Join([]string{`&schema.UploadPartRequest_Part_{` +
+		`Part:` + fmt.Sprintf("%#v", this.Part) + `}`}, ", ")
+	return s
+}
+func (this *UploadPartRequest_Data_) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := strings.Join([]string{`&schema.UploadPartRequest_Data_{` +
+		`Data:` + fmt.Sprintf("%#v", this.Data) + `}`}, ", ")
+	return s
 }
-
-func (m *Chunk) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UploadPartRequest_Part) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.UploadPartRequest_Part{")
+	s = append(s, "UploadID: "+fmt.Sprintf("%#v", this.UploadID)+",\n")
+	s = append(s, "Number: "+fmt.Sprintf("%#v", this.Number)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Chunk) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *UploadPartRequest_Data) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.UploadPartRequest_Data{")
+	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Chunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
+func (this *UploadPartResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Objects) > 0 {
-		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Objects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.UploadPartResponse{")
+	if this.Part != nil {
+		s = append(s, "Part: "+fmt.Sprintf("%#v", this.Part)+",\n")
 	}
-	if m.ChunkSize != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.ChunkSize))
-		i--
-		dAtA[i] = 0x8
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListUploadPartsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.ListUploadPartsRequest{")
+	s = append(s, "UploadID: "+fmt.Sprintf("%#v", this.UploadID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Object) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListUploadPartsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.ListUploadPartsResponse{")
+	if this.Parts != nil {
+		s = append(s, "Parts: "+fmt.Sprintf("%#v", this.Parts)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Object) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *CompleteUploadRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.CompleteUploadRequest{")
+	s = append(s, "UploadID: "+fmt.Sprintf("%#v", this.UploadID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Object) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ShardID) > 0 {
-		i -= len(m.ShardID)
-		copy(dAtA[i:], m.ShardID)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.ShardID)))
-		i--
-		dAtA[i] = 0x12
+func (this *CompleteUploadResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.CompleteUploadResponse{")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AbortUploadRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.AbortUploadRequest{")
+	s = append(s, "UploadID: "+fmt.Sprintf("%#v", this.UploadID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *AbortUploadResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.AbortUploadResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+func (this *SetMetadataRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.SetMetadataRequest{")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SetMetadataResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WriteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.SetMetadataResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *GetMetadataRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.GetMetadataRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetMetadataResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.GetMetadataResponse{")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DeleteMetadataRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DeleteMetadataRequest{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FilePath) > 0 {
-		i -= len(m.FilePath)
-		copy(dAtA[i:], m.FilePath)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.FilePath)))
-		i--
-		dAtA[i] = 0x12
+func (this *DeleteMetadataResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.DeleteMetadataResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListMetadataKeysRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.ListMetadataKeysRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ListMetadataKeysResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.ListMetadataKeysResponse{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DataWriteRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataWriteRequest{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *DataWriteResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataWriteResponse{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DataWriteFileRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataWriteFileRequest{")
+	s = append(s, "FilePath: "+fmt.Sprintf("%#v", this.FilePath)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DataWriteFileResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataWriteFileResponse{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (this *DataWriteStreamRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataWriteStreamRequest{")
+	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest_Metadata_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DataWriteStreamResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataWriteStreamResponse{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest_Metadata_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *DataReadRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataReadRequest{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *WriteStreamRequest_Data_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DataReadResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataReadResponse{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest_Data_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (this *DataReadFileRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 8)
+	s = append(s, "&schema.DataReadFileRequest{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "FilePath: "+fmt.Sprintf("%#v", this.FilePath)+",\n")
+	s = append(s, "FileMode: "+fmt.Sprintf("%#v", this.FileMode)+",\n")
+	s = append(s, "SynchronousIO: "+fmt.Sprintf("%#v", this.SynchronousIO)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *WriteStreamRequest_Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DataReadFileResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.DataReadFileResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DataReadStreamRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.DataReadStreamRequest{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "ChunkSize: "+fmt.Sprintf("%#v", this.ChunkSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (this *DataReadStreamResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataReadStreamResponse{")
+	s = append(s, "DataChunk: "+fmt.Sprintf("%#v", this.DataChunk)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest_Data) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DataDeleteRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataDeleteRequest{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest_Data) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DataDeleteResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.DataDeleteResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamRequest_Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DataChunk) > 0 {
-		i -= len(m.DataChunk)
-		copy(dAtA[i:], m.DataChunk)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
-		i--
-		dAtA[i] = 0x12
+func (this *DataCheckRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&schema.DataCheckRequest{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "Fast: "+fmt.Sprintf("%#v", this.Fast)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DataCheckResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataCheckResponse{")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DataRepairRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataRepairRequest{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *WriteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *DataRepairResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataRepairResponse{")
+	if this.Chunks != nil {
+		s = append(s, "Chunks: "+fmt.Sprintf("%#v", this.Chunks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DataShardHealthRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&schema.DataShardHealthRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DataShardHealthResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&schema.DataShardHealthResponse{")
+	if this.Shards != nil {
+		s = append(s, "Shards: "+fmt.Sprintf("%#v", this.Shards)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardHealth) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&schema.ShardHealth{")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	s = append(s, "Failures: "+fmt.Sprintf("%#v", this.Failures)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "LastErrorTime: "+fmt.Sprintf("%#v", this.LastErrorTime)+",\n")
+	s = append(s, "Latency: "+fmt.Sprintf("%#v", this.Latency)+",\n")
+	if this.Namespace != nil {
+		s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NamespaceHealth) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&schema.NamespaceHealth{")
+	s = append(s, "DataIOErrors: "+fmt.Sprintf("%#v", this.DataIOErrors)+",\n")
+	s = append(s, "IndexIOErrors: "+fmt.Sprintf("%#v", this.IndexIOErrors)+",\n")
+	s = append(s, "DataFaults: "+fmt.Sprintf("%#v", this.DataFaults)+",\n")
+	s = append(s, "IndexFaults: "+fmt.Sprintf("%#v", this.IndexFaults)+",\n")
+	s = append(s, "DataIOErrorLast: "+fmt.Sprintf("%#v", this.DataIOErrorLast)+",\n")
+	s = append(s, "IndexIOErrorLast: "+fmt.Sprintf("%#v", this.IndexIOErrorLast)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDaemon(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
 
-func (m *ReadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// FileServiceClient is the client API for FileService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FileServiceClient interface {
+	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	WriteFile(ctx context.Context, in *WriteFileRequest, opts ...grpc.CallOption) (*WriteFileResponse, error)
+	WriteStream(ctx context.Context, opts ...grpc.CallOption) (FileService_WriteStreamClient, error)
+	Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error)
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (*ReadFileResponse, error)
+	ReadStream(ctx context.Context, in *ReadStreamRequest, opts ...grpc.CallOption) (FileService_ReadStreamClient, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*RepairResponse, error)
+	CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadResponse, error)
+	UploadPart(ctx context.Context, opts ...grpc.CallOption) (FileService_UploadPartClient, error)
+	ListUploadParts(ctx context.Context, in *ListUploadPartsRequest, opts ...grpc.CallOption) (*ListUploadPartsResponse, error)
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadResponse, error)
+	AbortUpload(ctx context.Context, in *AbortUploadRequest, opts ...grpc.CallOption) (*AbortUploadResponse, error)
+}
+
+type fileServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFileServiceClient(cc *grpc.ClientConn) FileServiceClient {
+	return &fileServiceClient{cc}
+}
+
+func (c *fileServiceClient) Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/Write", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ReadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *fileServiceClient) WriteFile(ctx context.Context, in *WriteFileRequest, opts ...grpc.CallOption) (*WriteFileResponse, error) {
+	out := new(WriteFileResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/WriteFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (c *fileServiceClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (FileService_WriteStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FileService_serviceDesc.Streams[0], "/schema.FileService/WriteStream", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	x := &fileServiceWriteStreamClient{stream}
+	return x, nil
 }
 
-func (m *ReadRequest_Key) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type FileService_WriteStreamClient interface {
+	Send(*WriteStreamRequest) error
+	CloseAndRecv() (*WriteStreamResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ReadRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Key != nil {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type fileServiceWriteStreamClient struct {
+	grpc.ClientStream
 }
-func (m *ReadRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (x *fileServiceWriteStreamClient) Send(m *WriteStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func (m *ReadRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (x *fileServiceWriteStreamClient) CloseAndRecv() (*WriteStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	m := new(WriteStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (m *ReadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *fileServiceClient) Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error) {
+	out := new(AppendResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/Append", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ReadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *fileServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
+	out := new(ReadResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+func (c *fileServiceClient) ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (*ReadFileResponse, error) {
+	out := new(ReadFileResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/ReadFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ReadFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *fileServiceClient) ReadStream(ctx context.Context, in *ReadStreamRequest, opts ...grpc.CallOption) (FileService_ReadStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FileService_serviceDesc.Streams[1], "/schema.FileService/ReadStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &fileServiceReadStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *ReadFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type FileService_ReadStreamClient interface {
+	Recv() (*ReadStreamResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ReadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SynchronousIO {
-		i--
-		if m.SynchronousIO {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+type fileServiceReadStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileServiceReadStreamClient) Recv() (*ReadStreamResponse, error) {
+	m := new(ReadStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.FileMode != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.FileMode))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.FilePath) > 0 {
-		i -= len(m.FilePath)
-		copy(dAtA[i:], m.FilePath)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.FilePath)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Input != nil {
-		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReadFileRequest_Key) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *ReadFileRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Key != nil {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (c *fileServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ReadFileRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ReadFileRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-func (m *ReadFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *fileServiceClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/Check", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ReadFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *fileServiceClient) Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*RepairResponse, error) {
+	out := new(RepairResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/Repair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ReadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *fileServiceClient) CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadResponse, error) {
+	out := new(CreateUploadResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/CreateUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ReadStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *fileServiceClient) UploadPart(ctx context.Context, opts ...grpc.CallOption) (FileService_UploadPartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FileService_serviceDesc.Streams[2], "/schema.FileService/UploadPart", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &fileServiceUploadPartClient{stream}
+	return x, nil
 }
 
-func (m *ReadStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type FileService_UploadPartClient interface {
+	Send(*UploadPartRequest) error
+	CloseAndRecv() (*UploadPartResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ReadStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChunkSize != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.ChunkSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Input != nil {
-		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+type fileServiceUploadPartClient struct {
+	grpc.ClientStream
 }
 
-func (m *ReadStreamRequest_Key) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *fileServiceUploadPartClient) Send(m *UploadPartRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func (m *ReadStreamRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Key != nil {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (x *fileServiceUploadPartClient) CloseAndRecv() (*UploadPartResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ReadStreamRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReadStreamRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	m := new(UploadPartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
-func (m *ReadStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *fileServiceClient) ListUploadParts(ctx context.Context, in *ListUploadPartsRequest, opts ...grpc.CallOption) (*ListUploadPartsResponse, error) {
+	out := new(ListUploadPartsResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/ListUploadParts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReadStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ReadStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DataChunk) > 0 {
-		i -= len(m.DataChunk)
-		copy(dAtA[i:], m.DataChunk)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
-		i--
-		dAtA[i] = 0xa
+func (c *fileServiceClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadResponse, error) {
+	out := new(CompleteUploadResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/CompleteUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *fileServiceClient) AbortUpload(ctx context.Context, in *AbortUploadRequest, opts ...grpc.CallOption) (*AbortUploadResponse, error) {
+	out := new(AbortUploadResponse)
+	err := c.cc.Invoke(ctx, "/schema.FileService/AbortUpload", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FileServiceServer is the server API for FileService service.
+type FileServiceServer interface {
+	Write(context.Context, *WriteRequest) (*WriteResponse, error)
+	WriteFile(context.Context, *WriteFileRequest) (*WriteFileResponse, error)
+	WriteStream(FileService_WriteStreamServer) error
+	Append(context.Context, *AppendRequest) (*AppendResponse, error)
+	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	ReadFile(context.Context, *ReadFileRequest) (*ReadFileResponse, error)
+	ReadStream(*ReadStreamRequest, FileService_ReadStreamServer) error
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+	Repair(context.Context, *RepairRequest) (*RepairResponse, error)
+	CreateUpload(context.Context, *CreateUploadRequest) (*CreateUploadResponse, error)
+	UploadPart(FileService_UploadPartServer) error
+	ListUploadParts(context.Context, *ListUploadPartsRequest) (*ListUploadPartsResponse, error)
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error)
+	AbortUpload(context.Context, *AbortUploadRequest) (*AbortUploadResponse, error)
 }
 
-func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Input != nil {
-		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedFileServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedFileServiceServer struct {
 }
 
-func (m *DeleteRequest_Key) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedFileServiceServer) Write(ctx context.Context, req *WriteRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
 }
-
-func (m *DeleteRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Key != nil {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedFileServiceServer) WriteFile(ctx context.Context, req *WriteFileRequest) (*WriteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteFile not implemented")
 }
-func (m *DeleteRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedFileServiceServer) WriteStream(srv FileService_WriteStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
 }
-
-func (m *DeleteRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedFileServiceServer) Append(ctx context.Context, req *AppendRequest) (*AppendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
 }
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedFileServiceServer) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
-
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedFileServiceServer) ReadFile(ctx context.Context, req *ReadFileRequest) (*ReadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+}
+func (*UnimplementedFileServiceServer) ReadStream(req *ReadStreamRequest, srv FileService_ReadStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadStream not implemented")
+}
+func (*UnimplementedFileServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedFileServiceServer) Check(ctx context.Context, req *CheckRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (*UnimplementedFileServiceServer) Repair(ctx context.Context, req *RepairRequest) (*RepairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repair not implemented")
+}
+func (*UnimplementedFileServiceServer) CreateUpload(ctx context.Context, req *CreateUploadRequest) (*CreateUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUpload not implemented")
+}
+func (*UnimplementedFileServiceServer) UploadPart(srv FileService_UploadPartServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadPart not implemented")
+}
+func (*UnimplementedFileServiceServer) ListUploadParts(ctx context.Context, req *ListUploadPartsRequest) (*ListUploadPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUploadParts not implemented")
+}
+func (*UnimplementedFileServiceServer) CompleteUpload(ctx context.Context, req *CompleteUploadRequest) (*CompleteUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteUpload not implemented")
+}
+func (*UnimplementedFileServiceServer) AbortUpload(ctx context.Context, req *AbortUploadRequest) (*AbortUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortUpload not implemented")
 }
 
-func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterFileServiceServer(s *grpc.Server, srv FileServiceServer) {
+	s.RegisterService(&_FileService_serviceDesc, srv)
 }
 
-func (m *CheckRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _FileService_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CheckRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(FileServiceServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).Write(ctx, req.(*WriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fast {
-		i--
-		if m.Fast {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func _FileService_WriteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Input != nil {
-		{
-			size := m.Input.Size()
-			i -= size
-			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if interceptor == nil {
+		return srv.(FileServiceServer).WriteFile(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/WriteFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).WriteFile(ctx, req.(*WriteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckRequest_Key) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _FileService_WriteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FileServiceServer).WriteStream(&fileServiceWriteStreamServer{stream})
 }
 
-func (m *CheckRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Key != nil {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-func (m *CheckRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type FileService_WriteStreamServer interface {
+	SendAndClose(*WriteStreamResponse) error
+	Recv() (*WriteStreamRequest, error)
+	grpc.ServerStream
 }
 
-func (m *CheckRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-func (m *CheckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type fileServiceWriteStreamServer struct {
+	grpc.ServerStream
 }
 
-func (m *CheckResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *fileServiceWriteStreamServer) SendAndClose(m *WriteStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *CheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (x *fileServiceWriteStreamServer) Recv() (*WriteStreamRequest, error) {
+	m := new(WriteStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *RepairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _FileService_Append_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RepairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(FileServiceServer).Append(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/Append",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).Append(ctx, req.(*AppendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func _FileService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(FileServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).Read(ctx, req.(*ReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _FileService_ReadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(FileServiceServer).ReadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/ReadFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ReadFile(ctx, req.(*ReadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _FileService_ReadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileServiceServer).ReadStream(m, &fileServiceReadStreamServer{stream})
 }
 
-func (m *RepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type FileService_ReadStreamServer interface {
+	Send(*ReadStreamResponse) error
+	grpc.ServerStream
 }
 
-func (m *SetMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type fileServiceReadStreamServer struct {
+	grpc.ServerStream
 }
 
-func (m *SetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *fileServiceReadStreamServer) Send(m *ReadStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *SetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _FileService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(FileServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _FileService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(FileServiceServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/Check",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).Check(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _FileService_Repair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(FileServiceServer).Repair(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/Repair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).Repair(ctx, req.(*RepairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _FileService_CreateUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(FileServiceServer).CreateUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/CreateUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CreateUpload(ctx, req.(*CreateUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _FileService_UploadPart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FileServiceServer).UploadPart(&fileServiceUploadPartServer{stream})
 }
 
-func (m *GetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDaemon(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type FileService_UploadPartServer interface {
+	SendAndClose(*UploadPartResponse) error
+	Recv() (*UploadPartRequest, error)
+	grpc.ServerStream
 }
 
-func (m *DeleteMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type fileServiceUploadPartServer struct {
+	grpc.ServerStream
 }
 
-func (m *DeleteMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *fileServiceUploadPartServer) SendAndClose(m *UploadPartResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *DeleteMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (x *fileServiceUploadPartServer) Recv() (*UploadPartRequest, error) {
+	m := new(UploadPartRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *DeleteMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _FileService_ListUploadParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUploadPartsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListUploadParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/ListUploadParts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListUploadParts(ctx, req.(*ListUploadPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _FileService_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/CompleteUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListMetadataKeysRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _FileService_AbortUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortUploadRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(FileServiceServer).AbortUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.FileService/AbortUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).AbortUpload(ctx, req.(*AbortUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListMetadataKeysRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var _FileService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "schema.FileService",
+	HandlerType: (*FileServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Write",
+			Handler:    _FileService_Write_Handler,
+		},
+		{
+			MethodName: "WriteFile",
+			Handler:    _FileService_WriteFile_Handler,
+		},
+		{
+			MethodName: "Append",
+			Handler:    _FileService_Append_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _FileService_Read_Handler,
+		},
+		{
+			MethodName: "ReadFile",
+			Handler:    _FileService_ReadFile_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _FileService_Delete_Handler,
+		},
+		{
+			MethodName: "Check",
+			Handler:    _FileService_Check_Handler,
+		},
+		{
+			MethodName: "Repair",
+			Handler:    _FileService_Repair_Handler,
+		},
+		{
+			MethodName: "CreateUpload",
+			Handler:    _FileService_CreateUpload_Handler,
+		},
+		{
+			MethodName: "ListUploadParts",
+			Handler:    _FileService_ListUploadParts_Handler,
+		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _FileService_CompleteUpload_Handler,
+		},
+		{
+			MethodName: "AbortUpload",
+			Handler:    _FileService_AbortUpload_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WriteStream",
+			Handler:       _FileService_WriteStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadStream",
+			Handler:       _FileService_ReadStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadPart",
+			Handler:       _FileService_UploadPart_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "schema/daemon.proto",
 }
 
-func (m *ListMetadataKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MetadataServiceClient is the client API for MetadataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MetadataServiceClient interface {
+	SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error)
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	DeleteMetadata(ctx context.Context, in *DeleteMetadataRequest, opts ...grpc.CallOption) (*DeleteMetadataResponse, error)
+	ListKeys(ctx context.Context, in *ListMetadataKeysRequest, opts ...grpc.CallOption) (MetadataService_ListKeysClient, error)
 }
 
-func (m *ListMetadataKeysResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type metadataServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ListMetadataKeysResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewMetadataServiceClient(cc *grpc.ClientConn) MetadataServiceClient {
+	return &metadataServiceClient{cc}
 }
 
-func (m *ListMetadataKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (c *metadataServiceClient) SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*SetMetadataResponse, error) {
+	out := new(SetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/schema.MetadataService/SetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DataWriteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/schema.MetadataService/GetMetadata", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DataWriteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *DataWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+func (c *metadataServiceClient) DeleteMetadata(ctx context.Context, in *DeleteMetadataRequest, opts ...grpc.CallOption) (*DeleteMetadataResponse, error) {
+	out := new(DeleteMetadataResponse)
+	err := c.cc.Invoke(ctx, "/schema.MetadataService/DeleteMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DataWriteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataServiceClient) ListKeys(ctx context.Context, in *ListMetadataKeysRequest, opts ...grpc.CallOption) (MetadataService_ListKeysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MetadataService_serviceDesc.Streams[0], "/schema.MetadataService/ListKeys", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &metadataServiceListKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *DataWriteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MetadataService_ListKeysClient interface {
+	Recv() (*ListMetadataKeysResponse, error)
+	grpc.ClientStream
 }
 
-func (m *DataWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type metadataServiceListKeysClient struct {
+	grpc.ClientStream
 }
 
-func (m *DataWriteFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *metadataServiceListKeysClient) Recv() (*ListMetadataKeysResponse, error) {
+	m := new(ListMetadataKeysResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *DataWriteFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MetadataServiceServer is the server API for MetadataService service.
+type MetadataServiceServer interface {
+	SetMetadata(context.Context, *SetMetadataRequest) (*SetMetadataResponse, error)
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	DeleteMetadata(context.Context, *DeleteMetadataRequest) (*DeleteMetadataResponse, error)
+	ListKeys(*ListMetadataKeysRequest, MetadataService_ListKeysServer) error
 }
 
-func (m *DataWriteFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FilePath) > 0 {
-		i -= len(m.FilePath)
-		copy(dAtA[i:], m.FilePath)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.FilePath)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMetadataServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMetadataServiceServer struct {
 }
 
-func (m *DataWriteFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMetadataServiceServer) SetMetadata(ctx context.Context, req *SetMetadataRequest) (*SetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetadata not implemented")
+}
+func (*UnimplementedMetadataServiceServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (*UnimplementedMetadataServiceServer) DeleteMetadata(ctx context.Context, req *DeleteMetadataRequest) (*DeleteMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadata not implemented")
+}
+func (*UnimplementedMetadataServiceServer) ListKeys(req *ListMetadataKeysRequest, srv MetadataService_ListKeysServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
 }
 
-func (m *DataWriteFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMetadataServiceServer(s *grpc.Server, srv MetadataServiceServer) {
+	s.RegisterService(&_MetadataService_serviceDesc, srv)
 }
 
-func (m *DataWriteFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _MetadataService_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.MetadataService/SetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SetMetadata(ctx, req.(*SetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DataWriteStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _MetadataService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.MetadataService/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DataWriteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _MetadataService_DeleteMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DeleteMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.MetadataService/DeleteMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DeleteMetadata(ctx, req.(*DeleteMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DataWriteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DataChunk) > 0 {
-		i -= len(m.DataChunk)
-		copy(dAtA[i:], m.DataChunk)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
-		i--
-		dAtA[i] = 0xa
+func _MetadataService_ListKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListMetadataKeysRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(MetadataServiceServer).ListKeys(m, &metadataServiceListKeysServer{stream})
 }
 
-func (m *DataWriteStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MetadataService_ListKeysServer interface {
+	Send(*ListMetadataKeysResponse) error
+	grpc.ServerStream
 }
 
-func (m *DataWriteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type metadataServiceListKeysServer struct {
+	grpc.ServerStream
 }
 
-func (m *DataWriteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (x *metadataServiceListKeysServer) Send(m *ListMetadataKeysResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *DataReadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var _MetadataService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "schema.MetadataService",
+	HandlerType: (*MetadataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetMetadata",
+			Handler:    _MetadataService_SetMetadata_Handler,
+		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _MetadataService_GetMetadata_Handler,
+		},
+		{
+			MethodName: "DeleteMetadata",
+			Handler:    _MetadataService_DeleteMetadata_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListKeys",
+			Handler:       _MetadataService_ListKeys_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "schema/daemon.proto",
+}
+
+// DataServiceClient is the client API for DataService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataServiceClient interface {
+	Write(ctx context.Context, in *DataWriteRequest, opts ...grpc.CallOption) (*DataWriteResponse, error)
+	WriteFile(ctx context.Context, in *DataWriteFileRequest, opts ...grpc.CallOption) (*DataWriteFileResponse, error)
+	WriteStream(ctx context.Context, opts ...grpc.CallOption) (DataService_WriteStreamClient, error)
+	Read(ctx context.Context, in *DataReadRequest, opts ...grpc.CallOption) (*DataReadResponse, error)
+	ReadFile(ctx context.Context, in *DataReadFileRequest, opts ...grpc.CallOption) (*DataReadFileResponse, error)
+	ReadStream(ctx context.Context, in *DataReadStreamRequest, opts ...grpc.CallOption) (DataService_ReadStreamClient, error)
+	Delete(ctx context.Context, in *DataDeleteRequest, opts ...grpc.CallOption) (*DataDeleteResponse, error)
+	Check(ctx context.Context, in *DataCheckRequest, opts ...grpc.CallOption) (*DataCheckResponse, error)
+	Repair(ctx context.Context, in *DataRepairRequest, opts ...grpc.CallOption) (*DataRepairResponse, error)
+	ShardHealth(ctx context.Context, in *DataShardHealthRequest, opts ...grpc.CallOption) (*DataShardHealthResponse, error)
+}
+
+type dataServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataServiceClient(cc *grpc.ClientConn) DataServiceClient {
+	return &dataServiceClient{cc}
+}
+
+func (c *dataServiceClient) Write(ctx context.Context, in *DataWriteRequest, opts ...grpc.CallOption) (*DataWriteResponse, error) {
+	out := new(DataWriteResponse)
+	err := c.cc.Invoke(ctx, "/schema.DataService/Write", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DataReadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *DataReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dataServiceClient) WriteFile(ctx context.Context, in *DataWriteFileRequest, opts ...grpc.CallOption) (*DataWriteFileResponse, error) {
+	out := new(DataWriteFileResponse)
+	err := c.cc.Invoke(ctx, "/schema.DataService/WriteFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DataReadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataServiceClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (DataService_WriteStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DataService_serviceDesc.Streams[0], "/schema.DataService/WriteStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &dataServiceWriteStreamClient{stream}
+	return x, nil
 }
 
-func (m *DataReadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DataService_WriteStreamClient interface {
+	Send(*DataWriteStreamRequest) error
+	CloseAndRecv() (*DataWriteStreamResponse, error)
+	grpc.ClientStream
 }
 
-func (m *DataReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+type dataServiceWriteStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataServiceWriteStreamClient) Send(m *DataWriteStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dataServiceWriteStreamClient) CloseAndRecv() (*DataWriteStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	m := new(DataWriteStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (m *DataReadFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataServiceClient) Read(ctx context.Context, in *DataReadRequest, opts ...grpc.CallOption) (*DataReadResponse, error) {
+	out := new(DataReadResponse)
+	err := c.cc.Invoke(ctx, "/schema.DataService/Read", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DataReadFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DataReadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SynchronousIO {
-		i--
-		if m.SynchronousIO {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.FileMode != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.FileMode))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.FilePath) > 0 {
-		i -= len(m.FilePath)
-		copy(dAtA[i:], m.FilePath)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.FilePath)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dataServiceClient) ReadFile(ctx context.Context, in *DataReadFileRequest, opts ...grpc.CallOption) (*DataReadFileResponse, error) {
+	out := new(DataReadFileResponse)
+	err := c.cc.Invoke(ctx, "/schema.DataService/ReadFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DataReadFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataServiceClient) ReadStream(ctx context.Context, in *DataReadStreamRequest, opts ...grpc.CallOption) (DataService_ReadStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DataService_serviceDesc.Streams[1], "/schema.DataService/ReadStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &dataServiceReadStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *DataReadFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DataService_ReadStreamClient interface {
+	Recv() (*DataReadStreamResponse, error)
+	grpc.ClientStream
 }
 
-func (m *DataReadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type dataServiceReadStreamClient struct {
+	grpc.ClientStream
 }
 
-func (m *DataReadStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *dataServiceReadStreamClient) Recv() (*DataReadStreamResponse, error) {
+	m := new(DataReadStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DataReadStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *DataReadStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChunkSize != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.ChunkSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *dataServiceClient) Delete(ctx context.Context, in *DataDeleteRequest, opts ...grpc.CallOption) (*DataDeleteResponse, error) {
+	out := new(DataDeleteResponse)
+	err := c.cc.Invoke(ctx, "/schema.DataService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DataReadStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataServiceClient) Check(ctx context.Context, in *DataCheckRequest, opts ...grpc.CallOption) (*DataCheckResponse, error) {
+	out := new(DataCheckResponse)
+	err := c.cc.Invoke(ctx, "/schema.DataService/Check", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DataReadStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *DataReadStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DataChunk) > 0 {
-		i -= len(m.DataChunk)
-		copy(dAtA[i:], m.DataChunk)
-		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
-		i--
-		dAtA[i] = 0xa
+func (c *dataServiceClient) Repair(ctx context.Context, in *DataRepairRequest, opts ...grpc.CallOption) (*DataRepairResponse, error) {
+	out := new(DataRepairResponse)
+	err := c.cc.Invoke(ctx, "/schema.DataService/Repair", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DataDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *dataServiceClient) ShardHealth(ctx context.Context, in *DataShardHealthRequest, opts ...grpc.CallOption) (*DataShardHealthResponse, error) {
+	out := new(DataShardHealthResponse)
+	err := c.cc.Invoke(ctx, "/schema.DataService/ShardHealth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DataDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DataServiceServer is the server API for DataService service.
+type DataServiceServer interface {
+	Write(context.Context, *DataWriteRequest) (*DataWriteResponse, error)
+	WriteFile(context.Context, *DataWriteFileRequest) (*DataWriteFileResponse, error)
+	WriteStream(DataService_WriteStreamServer) error
+	Read(context.Context, *DataReadRequest) (*DataReadResponse, error)
+	ReadFile(context.Context, *DataReadFileRequest) (*DataReadFileResponse, error)
+	ReadStream(*DataReadStreamRequest, DataService_ReadStreamServer) error
+	Delete(context.Context, *DataDeleteRequest) (*DataDeleteResponse, error)
+	Check(context.Context, *DataCheckRequest) (*DataCheckResponse, error)
+	Repair(context.Context, *DataRepairRequest) (*DataRepairResponse, error)
+	ShardHealth(context.Context, *DataShardHealthRequest) (*DataShardHealthResponse, error)
 }
 
-func (m *DataDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedDataServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDataServiceServer struct {
 }
 
-func (m *DataDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedDataServiceServer) Write(ctx context.Context, req *DataWriteRequest) (*DataWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
 }
-
-func (m *DataDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedDataServiceServer) WriteFile(ctx context.Context, req *DataWriteFileRequest) (*DataWriteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteFile not implemented")
+}
+func (*UnimplementedDataServiceServer) WriteStream(srv DataService_WriteStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
+}
+func (*UnimplementedDataServiceServer) Read(ctx context.Context, req *DataReadRequest) (*DataReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (*UnimplementedDataServiceServer) ReadFile(ctx context.Context, req *DataReadFileRequest) (*DataReadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+}
+func (*UnimplementedDataServiceServer) ReadStream(req *DataReadStreamRequest, srv DataService_ReadStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadStream not implemented")
+}
+func (*UnimplementedDataServiceServer) Delete(ctx context.Context, req *DataDeleteRequest) (*DataDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedDataServiceServer) Check(ctx context.Context, req *DataCheckRequest) (*DataCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (*UnimplementedDataServiceServer) Repair(ctx context.Context, req *DataRepairRequest) (*DataRepairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repair not implemented")
+}
+func (*UnimplementedDataServiceServer) ShardHealth(ctx context.Context, req *DataShardHealthRequest) (*DataShardHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShardHealth not implemented")
 }
 
-func (m *DataDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterDataServiceServer(s *grpc.Server, srv DataServiceServer) {
+	s.RegisterService(&_DataService_serviceDesc, srv)
 }
 
-func (m *DataCheckRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _DataService_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataWriteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DataCheckRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DataCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fast {
-		i--
-		if m.Fast {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(DataServiceServer).Write(ctx, in)
 	}
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.DataService/Write",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).Write(ctx, req.(*DataWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DataCheckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _DataService_WriteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataWriteFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(DataServiceServer).WriteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.DataService/WriteFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).WriteFile(ctx, req.(*DataWriteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DataCheckResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _DataService_WriteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataServiceServer).WriteStream(&dataServiceWriteStreamServer{stream})
 }
 
-func (m *DataCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintDaemon(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type DataService_WriteStreamServer interface {
+	SendAndClose(*DataWriteStreamResponse) error
+	Recv() (*DataWriteStreamRequest, error)
+	grpc.ServerStream
 }
 
-func (m *DataRepairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type dataServiceWriteStreamServer struct {
+	grpc.ServerStream
 }
 
-func (m *DataRepairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *dataServiceWriteStreamServer) SendAndClose(m *DataWriteStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *DataRepairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (x *dataServiceWriteStreamServer) Recv() (*DataWriteStreamRequest, error) {
+	m := new(DataWriteStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *DataRepairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _DataService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataReadRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(DataServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.DataService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).Read(ctx, req.(*DataReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DataRepairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _DataService_ReadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataReadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ReadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.DataService/ReadFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ReadFile(ctx, req.(*DataReadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DataRepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDaemon(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _DataService_ReadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DataReadStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return len(dAtA) - i, nil
+	return srv.(DataServiceServer).ReadStream(m, &dataServiceReadStreamServer{stream})
 }
 
-func encodeVarintDaemon(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDaemon(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+type DataService_ReadStreamServer interface {
+	Send(*DataReadStreamResponse) error
+	grpc.ServerStream
 }
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if m.TotalSize != 0 {
-		n += 1 + sovDaemon(uint64(m.TotalSize))
+
+type dataServiceReadStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataServiceReadStreamServer) Send(m *DataReadStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DataService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CreationEpoch != 0 {
-		n += 1 + sovDaemon(uint64(m.CreationEpoch))
+	if interceptor == nil {
+		return srv.(DataServiceServer).Delete(ctx, in)
 	}
-	if m.LastWriteEpoch != 0 {
-		n += 1 + sovDaemon(uint64(m.LastWriteEpoch))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.DataService/Delete",
 	}
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).Delete(ctx, req.(*DataDeleteRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Chunk) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ChunkSize != 0 {
-		n += 1 + sovDaemon(uint64(m.ChunkSize))
+	if interceptor == nil {
+		return srv.(DataServiceServer).Check(ctx, in)
 	}
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.DataService/Check",
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).Check(ctx, req.(*DataCheckRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Object) Size() (n int) {
-	if m == nil {
-		return 0
+func _DataService_Repair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataRepairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	if interceptor == nil {
+		return srv.(DataServiceServer).Repair(ctx, in)
 	}
-	l = len(m.ShardID)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.DataService/Repair",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).Repair(ctx, req.(*DataRepairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WriteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+func _DataService_ShardHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataShardHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	if interceptor == nil {
+		return srv.(DataServiceServer).ShardHealth(ctx, in)
 	}
-	return n
-}
-
-func (m *WriteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schema.DataService/ShardHealth",
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ShardHealth(ctx, req.(*DataShardHealthRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WriteFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	l = len(m.FilePath)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+var _DataService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "schema.DataService",
+	HandlerType: (*DataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Write",
+			Handler:    _DataService_Write_Handler,
+		},
+		{
+			MethodName: "WriteFile",
+			Handler:    _DataService_WriteFile_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _DataService_Read_Handler,
+		},
+		{
+			MethodName: "ReadFile",
+			Handler:    _DataService_ReadFile_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DataService_Delete_Handler,
+		},
+		{
+			MethodName: "Check",
+			Handler:    _DataService_Check_Handler,
+		},
+		{
+			MethodName: "Repair",
+			Handler:    _DataService_Repair_Handler,
+		},
+		{
+			MethodName: "ShardHealth",
+			Handler:    _DataService_ShardHealth_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WriteStream",
+			Handler:       _DataService_WriteStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadStream",
+			Handler:       _DataService_ReadStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "schema/daemon.proto",
 }
 
-func (m *WriteFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WriteStreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
-	}
-	return n
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WriteStreamRequest_Metadata_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return n
-}
-func (m *WriteStreamRequest_Data_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LastWriteEpoch != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.LastWriteEpoch))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+	if m.CreationEpoch != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.CreationEpoch))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *WriteStreamRequest_Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TotalSize != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WriteStreamRequest_Data) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DataChunk)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+func (m *Chunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WriteStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+func (m *Chunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Chunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *ReadRequest_Key) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Objects) > 0 {
+		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Objects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if m.Key != nil {
-		l = len(m.Key)
-		n += 1 + l + sovDaemon(uint64(l))
+	if m.ChunkSize != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ReadRequest_Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+
+func (m *Object) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ReadResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+
+func (m *Object) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Object) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
-	}
-	l = len(m.FilePath)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	if m.FileMode != 0 {
-		n += 1 + sovDaemon(uint64(m.FileMode))
+	if len(m.ShardID) > 0 {
+		i -= len(m.ShardID)
+		copy(dAtA[i:], m.ShardID)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.ShardID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.SynchronousIO {
-		n += 2
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReadFileRequest_Key) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WriteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Key != nil {
-		l = len(m.Key)
-		n += 1 + l + sovDaemon(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *ReadFileRequest_Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *WriteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ReadFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *WriteFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReadStreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WriteFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ChunkSize != 0 {
-		n += 1 + sovDaemon(uint64(m.ChunkSize))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReadStreamRequest_Key) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != nil {
-		l = len(m.Key)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
-}
-func (m *ReadStreamRequest_Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WriteFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ReadStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DataChunk)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+
+func (m *WriteStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WriteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Input != nil {
-		n += m.Input.Size()
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteRequest_Key) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != nil {
-		l = len(m.Key)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+func (m *WriteStreamRequest_Metadata_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *DeleteRequest_Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *WriteStreamRequest_Metadata_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *DeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *WriteStreamRequest_Data_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CheckRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		n += m.Input.Size()
+func (m *WriteStreamRequest_Data_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Fast {
-		n += 2
+	return len(dAtA) - i, nil
+}
+func (m *WriteStreamRequest_Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CheckRequest_Key) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != nil {
-		l = len(m.Key)
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+func (m *WriteStreamRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *CheckRequest_Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WriteStreamRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *CheckResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovDaemon(uint64(m.Status))
+
+func (m *WriteStreamRequest_Data) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RepairRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+func (m *WriteStreamRequest_Data) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepairResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WriteStreamRequest_Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+	if len(m.DataChunk) > 0 {
+		i -= len(m.DataChunk)
+		copy(dAtA[i:], m.DataChunk)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SetMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+func (m *WriteStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *WriteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WriteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDaemon(uint64(l))
+func (m *AppendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AppendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AppendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListMetadataKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *AppendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListMetadataKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AppendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataWriteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+func (m *ReadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataWriteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataWriteFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FilePath)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+func (m *ReadRequest_Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataWriteFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
-		}
+func (m *ReadRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Key != nil {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *ReadRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataWriteStreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.DataChunk)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *ReadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataWriteStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
-		}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataReadRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
-		}
+func (m *ReadFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataReadResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+func (m *ReadFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataReadFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	if m.SynchronousIO {
+		i--
+		if m.SynchronousIO {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	l = len(m.FilePath)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.FileMode != 0 {
-		n += 1 + sovDaemon(uint64(m.FileMode))
+		i = encodeVarintDaemon(dAtA, i, uint64(m.FileMode))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.SynchronousIO {
-		n += 2
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DataReadFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *ReadFileRequest_Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataReadStreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadFileRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Key != nil {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *ReadFileRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadFileRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ChunkSize != 0 {
-		n += 1 + sovDaemon(uint64(m.ChunkSize))
+	return len(dAtA) - i, nil
+}
+func (m *ReadFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataReadStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReadFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DataChunk)
-	if l > 0 {
-		n += 1 + l + sovDaemon(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	if m.ChunkSize != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataDeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *ReadStreamRequest_Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataCheckRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadStreamRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Key != nil {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *ReadStreamRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadStreamRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Fast {
-		n += 2
+	return len(dAtA) - i, nil
+}
+func (m *ReadStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DataCheckResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReadStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovDaemon(uint64(m.Status))
+	if len(m.DataChunk) > 0 {
+		i -= len(m.DataChunk)
+		copy(dAtA[i:], m.DataChunk)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataRepairRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DataRepairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteRequest_Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Key != nil {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	if len(m.Chunks) > 0 {
-		for _, e := range m.Chunks {
-			l = e.Size()
-			n += 1 + l + sovDaemon(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *DeleteRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func sovDaemon(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozDaemon(x uint64) (n int) {
-	return sovDaemon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
 }
-func (this *Metadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+
+func (m *CheckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&Metadata{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`TotalSize:` + fmt.Sprintf("%v", this.TotalSize) + `,`,
-		`CreationEpoch:` + fmt.Sprintf("%v", this.CreationEpoch) + `,`,
-		`LastWriteEpoch:` + fmt.Sprintf("%v", this.LastWriteEpoch) + `,`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *Chunk) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CheckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fast {
+		i--
+		if m.Fast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	repeatedStringForObjects := "[]*Object{"
-	for _, f := range this.Objects {
-		repeatedStringForObjects += strings.Replace(f.String(), "Object", "Object", 1) + ","
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	repeatedStringForObjects += "}"
-	s := strings.Join([]string{`&Chunk{`,
-		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
-		`Objects:` + repeatedStringForObjects + `,`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *Object) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Object{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CheckRequest_Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *WriteRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CheckRequest_Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Key != nil {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&WriteRequest{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *WriteResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&WriteResponse{`,
-		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *CheckRequest_Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *WriteFileRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CheckRequest_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&WriteFileRequest{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *WriteFileResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *CheckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&WriteFileResponse{`,
-		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *WriteStreamRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&WriteStreamRequest{`,
-		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CheckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *WriteStreamRequest_Metadata_) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	s := strings.Join([]string{`&WriteStreamRequest_Metadata_{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "WriteStreamRequest_Metadata", "WriteStreamRequest_Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *WriteStreamRequest_Data_) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&WriteStreamRequest_Data_{`,
-		`Data:` + strings.Replace(fmt.Sprintf("%v", this.Data), "WriteStreamRequest_Data", "WriteStreamRequest_Data", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *WriteStreamRequest_Metadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&WriteStreamRequest_Metadata{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *RepairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *WriteStreamRequest_Data) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&WriteStreamRequest_Data{`,
-		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *WriteStreamResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&WriteStreamResponse{`,
-		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ReadRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ReadRequest{`,
-		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *RepairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ReadRequest_Key) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&ReadRequest_Key{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ReadRequest_Metadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadedPart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ReadRequest_Metadata{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ReadResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ReadResponse{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *UploadedPart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ReadFileRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadedPart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastWriteEpoch != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.LastWriteEpoch))
+		i--
+		dAtA[i] = 0x18
 	}
-	s := strings.Join([]string{`&ReadFileRequest{`,
-		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
-		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
-		`FileMode:` + fmt.Sprintf("%v", this.FileMode) + `,`,
-		`SynchronousIO:` + fmt.Sprintf("%v", this.SynchronousIO) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReadFileRequest_Key) String() string {
-	if this == nil {
-		return "nil"
+	if m.Size_ != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
 	}
-	s := strings.Join([]string{`&ReadFileRequest_Key{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReadFileRequest_Metadata) String() string {
-	if this == nil {
-		return "nil"
+	if m.Number != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
 	}
-	s := strings.Join([]string{`&ReadFileRequest_Metadata{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ReadFileResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CreateUploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ReadFileResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ReadStreamRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ReadStreamRequest{`,
-		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
-		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CreateUploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ReadStreamRequest_Key) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CreateUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&ReadStreamRequest_Key{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ReadStreamRequest_Metadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CreateUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ReadStreamRequest_Metadata{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ReadStreamResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ReadStreamResponse{`,
-		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CreateUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CreateUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&DeleteRequest{`,
-		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DeleteRequest_Key) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DeleteRequest_Key{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DeleteRequest_Metadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DeleteRequest_Metadata{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *UploadPartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DeleteResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		{
+			size := m.Input.Size()
+			i -= size
+			if _, err := m.Input.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	s := strings.Join([]string{`&DeleteResponse{`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *CheckRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CheckRequest{`,
-		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
-		`Fast:` + fmt.Sprintf("%v", this.Fast) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *UploadPartRequest_Part_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *CheckRequest_Key) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartRequest_Part_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Part != nil {
+		{
+			size, err := m.Part.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&CheckRequest_Key{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *CheckRequest_Metadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CheckRequest_Metadata{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *UploadPartRequest_Data_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *CheckResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartRequest_Data_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&CheckResponse{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *RepairRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *UploadPartRequest_Part) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RepairRequest{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *RepairResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&RepairResponse{`,
-		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *UploadPartRequest_Part) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *SetMetadataRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartRequest_Part) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
 	}
-	s := strings.Join([]string{`&SetMetadataRequest{`,
-		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetMetadataResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&SetMetadataResponse{`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *GetMetadataRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartRequest_Data) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetMetadataRequest{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetMetadataResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetMetadataResponse{`,
-		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *UploadPartRequest_Data) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DeleteMetadataRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartRequest_Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataChunk) > 0 {
+		i -= len(m.DataChunk)
+		copy(dAtA[i:], m.DataChunk)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&DeleteMetadataRequest{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DeleteMetadataResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DeleteMetadataResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ListMetadataKeysRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ListMetadataKeysRequest{`,
-		`}`,
-	}, "")
-	return s
+
+func (m *UploadPartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ListMetadataKeysResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UploadPartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Part != nil {
+		{
+			size, err := m.Part.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&ListMetadataKeysResponse{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DataWriteRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListUploadPartsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DataWriteRequest{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DataWriteResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
-	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataWriteResponse{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ListUploadPartsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DataWriteFileRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListUploadPartsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&DataWriteFileRequest{`,
-		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DataWriteFileResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+
+func (m *ListUploadPartsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataWriteFileResponse{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DataWriteStreamRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DataWriteStreamRequest{`,
-		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ListUploadPartsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DataWriteStreamResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+
+func (m *ListUploadPartsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parts) > 0 {
+		for iNdEx := len(m.Parts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataWriteStreamResponse{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DataReadRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+
+func (m *CompleteUploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataReadRequest{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DataReadResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DataReadResponse{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CompleteUploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DataReadFileRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+
+func (m *CompleteUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataReadFileRequest{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
-		`FileMode:` + fmt.Sprintf("%v", this.FileMode) + `,`,
-		`SynchronousIO:` + fmt.Sprintf("%v", this.SynchronousIO) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DataReadFileResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CompleteUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DataReadFileResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DataReadStreamRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
-	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataReadStreamRequest{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *CompleteUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DataReadStreamResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CompleteUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&DataReadStreamResponse{`,
-		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DataDeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AbortUploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *AbortUploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbortUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadID) > 0 {
+		i -= len(m.UploadID)
+		copy(dAtA[i:], m.UploadID)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.UploadID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataDeleteRequest{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DataDeleteResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AbortUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DataDeleteResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DataCheckRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AbortUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbortUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *SetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataCheckRequest{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`Fast:` + fmt.Sprintf("%v", this.Fast) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DataCheckResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SetMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DataCheckResponse{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *DataRepairRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataRepairRequest{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DataRepairResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForChunks := "[]*Chunk{"
-	for _, f := range this.Chunks {
-		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMetadataKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMetadataKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMetadataKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMetadataKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMetadataKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMetadataKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataWriteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataWriteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataWriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataWriteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataWriteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataWriteFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataWriteFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataWriteFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataWriteFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataWriteFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataWriteFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataWriteStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataWriteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataWriteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataChunk) > 0 {
+		i -= len(m.DataChunk)
+		copy(dAtA[i:], m.DataChunk)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataWriteStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataWriteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataWriteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataReadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataReadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataReadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataReadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataReadFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataReadFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataReadFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SynchronousIO {
+		i--
+		if m.SynchronousIO {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FileMode != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.FileMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataReadFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataReadFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataReadFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DataReadStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataReadStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataReadStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChunkSize != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataReadStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataReadStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataReadStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataChunk) > 0 {
+		i -= len(m.DataChunk)
+		copy(dAtA[i:], m.DataChunk)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.DataChunk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DataCheckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataCheckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataCheckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fast {
+		i--
+		if m.Fast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataCheckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataCheckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataCheckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataRepairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataRepairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRepairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataRepairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataRepairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRepairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataShardHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataShardHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataShardHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DataShardHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataShardHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataShardHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDaemon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		{
+			size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDaemon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Latency != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.Latency))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastErrorTime != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.LastErrorTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Failures != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Since != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Shard) > 0 {
+		i -= len(m.Shard)
+		copy(dAtA[i:], m.Shard)
+		i = encodeVarintDaemon(dAtA, i, uint64(len(m.Shard)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexIOErrorLast != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.IndexIOErrorLast))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DataIOErrorLast != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.DataIOErrorLast))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IndexFaults != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.IndexFaults))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DataFaults != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.DataFaults))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexIOErrors != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.IndexIOErrors))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataIOErrors != 0 {
+		i = encodeVarintDaemon(dAtA, i, uint64(m.DataIOErrors))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDaemon(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDaemon(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovDaemon(uint64(m.TotalSize))
+	}
+	if m.CreationEpoch != 0 {
+		n += 1 + sovDaemon(uint64(m.CreationEpoch))
+	}
+	if m.LastWriteEpoch != 0 {
+		n += 1 + sovDaemon(uint64(m.LastWriteEpoch))
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Chunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChunkSize != 0 {
+		n += 1 + sovDaemon(uint64(m.ChunkSize))
+	}
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *Object) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	l = len(m.ShardID)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	return n
+}
+
+func (m *WriteStreamRequest_Metadata_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *WriteStreamRequest_Data_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *WriteStreamRequest_Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteStreamRequest_Data) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataChunk)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *AppendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *AppendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *ReadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	return n
+}
+
+func (m *ReadRequest_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = len(m.Key)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *ReadRequest_Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *ReadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *ReadFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.FileMode != 0 {
+		n += 1 + sovDaemon(uint64(m.FileMode))
+	}
+	if m.SynchronousIO {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReadFileRequest_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = len(m.Key)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *ReadFileRequest_Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *ReadFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReadStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovDaemon(uint64(m.ChunkSize))
+	}
+	return n
+}
+
+func (m *ReadStreamRequest_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = len(m.Key)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *ReadStreamRequest_Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *ReadStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataChunk)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	return n
+}
+
+func (m *DeleteRequest_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = len(m.Key)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *DeleteRequest_Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *DeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CheckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	if m.Fast {
+		n += 2
+	}
+	return n
+}
+
+func (m *CheckRequest_Key) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = len(m.Key)
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *CheckRequest_Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *CheckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovDaemon(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *RepairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *RepairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadedPart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovDaemon(uint64(m.Number))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovDaemon(uint64(m.Size_))
+	}
+	if m.LastWriteEpoch != 0 {
+		n += 1 + sovDaemon(uint64(m.LastWriteEpoch))
+	}
+	return n
+}
+
+func (m *CreateUploadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadPartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		n += m.Input.Size()
+	}
+	return n
+}
+
+func (m *UploadPartRequest_Part_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Part != nil {
+		l = m.Part.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *UploadPartRequest_Data_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+func (m *UploadPartRequest_Part) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovDaemon(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *UploadPartRequest_Data) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataChunk)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadPartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Part != nil {
+		l = m.Part.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *ListUploadPartsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *ListUploadPartsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Parts) > 0 {
+		for _, e := range m.Parts {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompleteUploadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *CompleteUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *AbortUploadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadID)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *AbortUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *SetMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *GetMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListMetadataKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListMetadataKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DataWriteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DataWriteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataWriteFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DataWriteFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataWriteStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataChunk)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DataWriteStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataReadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataReadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DataReadFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.FileMode != 0 {
+		n += 1 + sovDaemon(uint64(m.FileMode))
+	}
+	if m.SynchronousIO {
+		n += 2
+	}
+	return n
+}
+
+func (m *DataReadFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DataReadStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovDaemon(uint64(m.ChunkSize))
+	}
+	return n
+}
+
+func (m *DataReadStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataChunk)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *DataDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DataCheckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	if m.Fast {
+		n += 2
+	}
+	return n
+}
+
+func (m *DataCheckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovDaemon(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *DataRepairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataRepairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataShardHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DataShardHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovDaemon(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShardHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Shard)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovDaemon(uint64(m.State))
+	}
+	if m.Since != 0 {
+		n += 1 + sovDaemon(uint64(m.Since))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovDaemon(uint64(m.Failures))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	if m.LastErrorTime != 0 {
+		n += 1 + sovDaemon(uint64(m.LastErrorTime))
+	}
+	if m.Latency != 0 {
+		n += 1 + sovDaemon(uint64(m.Latency))
+	}
+	if m.Namespace != nil {
+		l = m.Namespace.Size()
+		n += 1 + l + sovDaemon(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataIOErrors != 0 {
+		n += 1 + sovDaemon(uint64(m.DataIOErrors))
+	}
+	if m.IndexIOErrors != 0 {
+		n += 1 + sovDaemon(uint64(m.IndexIOErrors))
+	}
+	if m.DataFaults != 0 {
+		n += 1 + sovDaemon(uint64(m.DataFaults))
+	}
+	if m.IndexFaults != 0 {
+		n += 1 + sovDaemon(uint64(m.IndexFaults))
+	}
+	if m.DataIOErrorLast != 0 {
+		n += 1 + sovDaemon(uint64(m.DataIOErrorLast))
+	}
+	if m.IndexIOErrorLast != 0 {
+		n += 1 + sovDaemon(uint64(m.IndexIOErrorLast))
+	}
+	return n
+}
+
+func sovDaemon(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDaemon(x uint64) (n int) {
+	return sovDaemon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&Metadata{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`TotalSize:` + fmt.Sprintf("%v", this.TotalSize) + `,`,
+		`CreationEpoch:` + fmt.Sprintf("%v", this.CreationEpoch) + `,`,
+		`LastWriteEpoch:` + fmt.Sprintf("%v", this.LastWriteEpoch) + `,`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Chunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForObjects := "[]*Object{"
+	for _, f := range this.Objects {
+		repeatedStringForObjects += strings.Replace(f.String(), "Object", "Object", 1) + ","
+	}
+	repeatedStringForObjects += "}"
+	s := strings.Join([]string{`&Chunk{`,
+		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
+		`Objects:` + repeatedStringForObjects + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Object) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Object{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteResponse{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteFileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteFileRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteFileResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteFileResponse{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteStreamRequest{`,
+		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteStreamRequest_Metadata_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteStreamRequest_Metadata_{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "WriteStreamRequest_Metadata", "WriteStreamRequest_Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteStreamRequest_Data_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteStreamRequest_Data_{`,
+		`Data:` + strings.Replace(fmt.Sprintf("%v", this.Data), "WriteStreamRequest_Data", "WriteStreamRequest_Data", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteStreamRequest_Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteStreamRequest_Metadata{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteStreamRequest_Data) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteStreamRequest_Data{`,
+		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteStreamResponse{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppendRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppendRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppendResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppendResponse{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadRequest{`,
+		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadRequest_Key) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadRequest_Key{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadRequest_Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadRequest_Metadata{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadResponse{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadFileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadFileRequest{`,
+		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
+		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
+		`FileMode:` + fmt.Sprintf("%v", this.FileMode) + `,`,
+		`SynchronousIO:` + fmt.Sprintf("%v", this.SynchronousIO) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadFileRequest_Key) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadFileRequest_Key{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadFileRequest_Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadFileRequest_Metadata{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadFileResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadFileResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadStreamRequest{`,
+		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
+		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadStreamRequest_Key) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadStreamRequest_Key{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadStreamRequest_Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadStreamRequest_Metadata{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadStreamResponse{`,
+		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteRequest{`,
+		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteRequest_Key) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteRequest_Key{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteRequest_Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteRequest_Metadata{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckRequest{`,
+		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
+		`Fast:` + fmt.Sprintf("%v", this.Fast) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckRequest_Key) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckRequest_Key{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckRequest_Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckRequest_Metadata{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckResponse{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepairRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepairRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepairResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepairResponse{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadedPart) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadedPart{`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`LastWriteEpoch:` + fmt.Sprintf("%v", this.LastWriteEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateUploadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateUploadRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateUploadResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateUploadResponse{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadPartRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadPartRequest{`,
+		`Input:` + fmt.Sprintf("%v", this.Input) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadPartRequest_Part_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadPartRequest_Part_{`,
+		`Part:` + strings.Replace(fmt.Sprintf("%v", this.Part), "UploadPartRequest_Part", "UploadPartRequest_Part", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadPartRequest_Data_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadPartRequest_Data_{`,
+		`Data:` + strings.Replace(fmt.Sprintf("%v", this.Data), "UploadPartRequest_Data", "UploadPartRequest_Data", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadPartRequest_Part) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadPartRequest_Part{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadPartRequest_Data) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadPartRequest_Data{`,
+		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UploadPartResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UploadPartResponse{`,
+		`Part:` + strings.Replace(this.Part.String(), "UploadedPart", "UploadedPart", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListUploadPartsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListUploadPartsRequest{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListUploadPartsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParts := "[]*UploadedPart{"
+	for _, f := range this.Parts {
+		repeatedStringForParts += strings.Replace(f.String(), "UploadedPart", "UploadedPart", 1) + ","
+	}
+	repeatedStringForParts += "}"
+	s := strings.Join([]string{`&ListUploadPartsResponse{`,
+		`Parts:` + repeatedStringForParts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompleteUploadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompleteUploadRequest{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompleteUploadResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompleteUploadResponse{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AbortUploadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AbortUploadRequest{`,
+		`UploadID:` + fmt.Sprintf("%v", this.UploadID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AbortUploadResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AbortUploadResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetMetadataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetMetadataRequest{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetMetadataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetMetadataResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetMetadataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMetadataRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetMetadataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMetadataResponse{`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteMetadataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteMetadataRequest{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteMetadataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteMetadataResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListMetadataKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListMetadataKeysRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListMetadataKeysResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListMetadataKeysResponse{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataWriteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataWriteRequest{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataWriteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataWriteResponse{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataWriteFileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataWriteFileRequest{`,
+		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataWriteFileResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataWriteFileResponse{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataWriteStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataWriteStreamRequest{`,
+		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataWriteStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataWriteStreamResponse{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataReadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataReadRequest{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataReadResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataReadResponse{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataReadFileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataReadFileRequest{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
+		`FileMode:` + fmt.Sprintf("%v", this.FileMode) + `,`,
+		`SynchronousIO:` + fmt.Sprintf("%v", this.SynchronousIO) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataReadFileResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataReadFileResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataReadStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataReadStreamRequest{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataReadStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataReadStreamResponse{`,
+		`DataChunk:` + fmt.Sprintf("%v", this.DataChunk) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataDeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataDeleteRequest{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataDeleteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataDeleteResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataCheckRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataCheckRequest{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`Fast:` + fmt.Sprintf("%v", this.Fast) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataCheckResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataCheckResponse{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRepairRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataRepairRequest{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataRepairResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChunks := "[]*Chunk{"
+	for _, f := range this.Chunks {
+		repeatedStringForChunks += strings.Replace(f.String(), "Chunk", "Chunk", 1) + ","
+	}
+	repeatedStringForChunks += "}"
+	s := strings.Join([]string{`&DataRepairResponse{`,
+		`Chunks:` + repeatedStringForChunks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataShardHealthRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataShardHealthRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataShardHealthResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForShards := "[]*ShardHealth{"
+	for _, f := range this.Shards {
+		repeatedStringForShards += strings.Replace(f.String(), "ShardHealth", "ShardHealth", 1) + ","
+	}
+	repeatedStringForShards += "}"
+	s := strings.Join([]string{`&DataShardHealthResponse{`,
+		`Shards:` + repeatedStringForShards + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardHealth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardHealth{`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
+		`Failures:` + fmt.Sprintf("%v", this.Failures) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`LastErrorTime:` + fmt.Sprintf("%v", this.LastErrorTime) + `,`,
+		`Latency:` + fmt.Sprintf("%v", this.Latency) + `,`,
+		`Namespace:` + strings.Replace(this.Namespace.String(), "NamespaceHealth", "NamespaceHealth", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceHealth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceHealth{`,
+		`DataIOErrors:` + fmt.Sprintf("%v", this.DataIOErrors) + `,`,
+		`IndexIOErrors:` + fmt.Sprintf("%v", this.IndexIOErrors) + `,`,
+		`DataFaults:` + fmt.Sprintf("%v", this.DataFaults) + `,`,
+		`IndexFaults:` + fmt.Sprintf("%v", this.IndexFaults) + `,`,
+		`DataIOErrorLast:` + fmt.Sprintf("%v", this.DataIOErrorLast) + `,`,
+		`IndexIOErrorLast:` + fmt.Sprintf("%v", this.IndexIOErrorLast) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDaemon(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationEpoch", wireType)
+			}
+			m.CreationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWriteEpoch", wireType)
+			}
+			m.LastWriteEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWriteEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, &Chunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WriteStreamRequest_Metadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &WriteStreamRequest_Metadata_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WriteStreamRequest_Data{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &WriteStreamRequest_Data_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteStreamRequest_Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteStreamRequest_Data) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataChunk == nil {
+				m.DataChunk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Input = &ReadRequest_Key{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Metadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &ReadRequest_Metadata{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForChunks += "}"
-	s := strings.Join([]string{`&DataRepairResponse{`,
-		`Chunks:` + repeatedStringForChunks + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringDaemon(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *ReadFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9491,10 +14570,10 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9526,16 +14605,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Input = &ReadFileRequest_Key{v}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.TotalSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -9545,16 +14623,32 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Metadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &ReadFileRequest_Metadata{v}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
 			}
-			m.CreationEpoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -9564,16 +14658,29 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationEpoch |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWriteEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
 			}
-			m.LastWriteEpoch = 0
+			m.FileMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -9583,16 +14690,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastWriteEpoch |= int64(b&0x7F) << shift
+				m.FileMode |= FileMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronousIO", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -9602,26 +14709,65 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.SynchronousIO = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chunks = append(m.Chunks, &Chunk{})
-			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -9646,7 +14792,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Chunk) Unmarshal(dAtA []byte) error {
+func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9669,17 +14815,17 @@ func (m *Chunk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.ChunkSize = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -9689,14 +14835,28 @@ func (m *Chunk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChunkSize |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Input = &ReadStreamRequest_Key{v}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9723,14 +14883,87 @@ func (m *Chunk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &Metadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &ReadStreamRequest_Metadata{v}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9757,9 +14990,9 @@ func (m *Chunk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataChunk == nil {
+				m.DataChunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9786,7 +15019,7 @@ func (m *Chunk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Object) Unmarshal(dAtA []byte) error {
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9809,10 +15042,10 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9844,16 +15077,15 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Input = &DeleteRequest_Key{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -9863,23 +15095,26 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardID = string(dAtA[iNdEx:postIndex])
+			v := &Metadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Input = &DeleteRequest_Metadata{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9905,7 +15140,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9928,10 +15163,63 @@ func (m *WriteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9963,16 +15251,15 @@ func (m *WriteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Input = &CheckRequest_Key{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -9982,26 +15269,47 @@ func (m *WriteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			v := &Metadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Input = &CheckRequest_Metadata{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fast = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -10026,7 +15334,7 @@ func (m *WriteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10049,17 +15357,17 @@ func (m *WriteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -10069,28 +15377,11 @@ func (m *WriteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= CheckStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -10115,7 +15406,7 @@ func (m *WriteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteFileRequest) Unmarshal(dAtA []byte) error {
+func (m *RepairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10138,10 +15429,10 @@ func (m *WriteFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10178,38 +15469,6 @@ func (m *WriteFileRequest) Unmarshal(dAtA []byte) error {
 				m.Key = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -10234,7 +15493,7 @@ func (m *WriteFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteFileResponse) Unmarshal(dAtA []byte) error {
+func (m *RepairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10257,10 +15516,10 @@ func (m *WriteFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10323,7 +15582,7 @@ func (m *WriteFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *UploadedPart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10346,17 +15605,17 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadedPart: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadedPart: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -10366,32 +15625,35 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			v := &WriteStreamRequest_Metadata{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Input = &WriteStreamRequest_Metadata_{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWriteEpoch", wireType)
 			}
-			var msglen int
+			m.LastWriteEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -10401,27 +15663,11 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastWriteEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &WriteStreamRequest_Data{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &WriteStreamRequest_Data_{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -10446,7 +15692,7 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteStreamRequest_Metadata) Unmarshal(dAtA []byte) error {
+func (m *CreateUploadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10469,10 +15715,10 @@ func (m *WriteStreamRequest_Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateUploadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10533,7 +15779,7 @@ func (m *WriteStreamRequest_Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteStreamRequest_Data) Unmarshal(dAtA []byte) error {
+func (m *CreateUploadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10556,17 +15802,17 @@ func (m *WriteStreamRequest_Data) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateUploadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -10576,25 +15822,23 @@ func (m *WriteStreamRequest_Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataChunk == nil {
-				m.DataChunk = []byte{}
-			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10620,7 +15864,7 @@ func (m *WriteStreamRequest_Data) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *UploadPartRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10643,15 +15887,15 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadPartRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadPartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Part", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10678,12 +15922,46 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
+			v := &UploadPartRequest_Part{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Input = &UploadPartRequest_Part_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UploadPartRequest_Data{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Input = &UploadPartRequest_Data_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10709,7 +15987,7 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+func (m *UploadPartRequest_Part) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10732,17 +16010,17 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Part: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Part: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -10752,30 +16030,29 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Input = &ReadRequest_Key{v}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var msglen int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -10785,27 +16062,11 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &Metadata{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &ReadRequest_Metadata{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -10830,7 +16091,7 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+func (m *UploadPartRequest_Data) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10853,15 +16114,15 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10888,9 +16149,9 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataChunk == nil {
+				m.DataChunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10917,7 +16178,7 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadFileRequest) Unmarshal(dAtA []byte) error {
+func (m *UploadPartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10940,48 +16201,15 @@ func (m *ReadFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadPartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadPartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Input = &ReadFileRequest_Key{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Part", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10999,92 +16227,22 @@ func (m *ReadFileRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			if msglen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &Metadata{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &ReadFileRequest_Metadata{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
-			}
-			m.FileMode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FileMode |= FileMode(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynchronousIO", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return ErrInvalidLengthDaemon
 			}
-			m.SynchronousIO = bool(v != 0)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Part == nil {
+				m.Part = &UploadedPart{}
+			}
+			if err := m.Part.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -11109,7 +16267,7 @@ func (m *ReadFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadFileResponse) Unmarshal(dAtA []byte) error {
+func (m *ListUploadPartsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11132,12 +16290,44 @@ func (m *ReadFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListUploadPartsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListUploadPartsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -11162,7 +16352,7 @@ func (m *ReadFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *ListUploadPartsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11185,48 +16375,15 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListUploadPartsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListUploadPartsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Input = &ReadStreamRequest_Key{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11253,31 +16410,11 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Metadata{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parts = append(m.Parts, &UploadedPart{})
+			if err := m.Parts[len(m.Parts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &ReadStreamRequest_Metadata{v}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
-			}
-			m.ChunkSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChunkSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -11302,7 +16439,7 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *CompleteUploadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11325,17 +16462,17 @@ func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompleteUploadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompleteUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -11345,25 +16482,23 @@ func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataChunk == nil {
-				m.DataChunk = []byte{}
-			}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11389,7 +16524,7 @@ func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *CompleteUploadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11412,17 +16547,17 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompleteUploadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompleteUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -11432,30 +16567,86 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Input = &DeleteRequest_Key{v}
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbortUploadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbortUploadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbortUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -11465,26 +16656,23 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Metadata{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Input = &DeleteRequest_Metadata{v}
+			m.UploadID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11510,7 +16698,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *AbortUploadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11533,10 +16721,10 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbortUploadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbortUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11563,7 +16751,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckRequest) Unmarshal(dAtA []byte) error {
+func (m *SetMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11586,46 +16774,13 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Input = &CheckRequest_Key{v}
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: SetMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -11654,32 +16809,13 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Metadata{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Input = &CheckRequest_Metadata{v}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Fast = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -11704,7 +16840,7 @@ func (m *CheckRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckResponse) Unmarshal(dAtA []byte) error {
+func (m *SetMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11727,31 +16863,12 @@ func (m *CheckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= CheckStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -11776,7 +16893,7 @@ func (m *CheckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepairRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11799,10 +16916,10 @@ func (m *RepairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11863,7 +16980,7 @@ func (m *RepairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepairResponse) Unmarshal(dAtA []byte) error {
+func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11886,10 +17003,10 @@ func (m *RepairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11952,7 +17069,7 @@ func (m *RepairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11975,17 +17092,17 @@ func (m *SetMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -11995,26 +17112,24 @@ func (m *SetMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -12041,7 +17156,7 @@ func (m *SetMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12064,10 +17179,10 @@ func (m *SetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12094,7 +17209,7 @@ func (m *SetMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *ListMetadataKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12117,46 +17232,12 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListMetadataKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListMetadataKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -12181,7 +17262,7 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *ListMetadataKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12204,17 +17285,17 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListMetadataKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListMetadataKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -12224,26 +17305,24 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -12270,7 +17349,7 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *DataWriteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12293,15 +17372,15 @@ func (m *DeleteMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataWriteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataWriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12328,9 +17407,9 @@ func (m *DeleteMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -12357,7 +17436,7 @@ func (m *DeleteMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *DataWriteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12380,65 +17459,46 @@ func (m *DeleteMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataWriteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDaemon(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDaemon
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListMetadataKeysRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDaemon
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Chunks = append(m.Chunks, &Chunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListMetadataKeysRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMetadataKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -12463,7 +17523,7 @@ func (m *ListMetadataKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListMetadataKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *DataWriteFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12486,17 +17546,17 @@ func (m *ListMetadataKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListMetadataKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataWriteFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMetadataKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataWriteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -12506,25 +17566,23 @@ func (m *ListMetadataKeysResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12550,7 +17608,7 @@ func (m *ListMetadataKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataWriteRequest) Unmarshal(dAtA []byte) error {
+func (m *DataWriteFileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12573,17 +17631,17 @@ func (m *DataWriteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataWriteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataWriteFileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataWriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataWriteFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -12593,24 +17651,24 @@ func (m *DataWriteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Chunks = append(m.Chunks, &Chunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -12637,7 +17695,7 @@ func (m *DataWriteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataWriteResponse) Unmarshal(dAtA []byte) error {
+func (m *DataWriteStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12660,17 +17718,17 @@ func (m *DataWriteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataWriteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataWriteStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataWriteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -12680,24 +17738,24 @@ func (m *DataWriteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chunks = append(m.Chunks, &Chunk{})
-			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataChunk == nil {
+				m.DataChunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -12724,7 +17782,7 @@ func (m *DataWriteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataWriteFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DataWriteStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12747,17 +17805,17 @@ func (m *DataWriteFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataWriteFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataWriteStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataWriteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataWriteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -12767,23 +17825,25 @@ func (m *DataWriteFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDaemon
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDaemon
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
+			m.Chunks = append(m.Chunks, &Chunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12809,7 +17869,7 @@ func (m *DataWriteFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataWriteFileResponse) Unmarshal(dAtA []byte) error {
+func (m *DataReadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12832,10 +17892,10 @@ func (m *DataWriteFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataWriteFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataReadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataWriteFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12896,7 +17956,7 @@ func (m *DataWriteFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataWriteStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *DataReadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12919,15 +17979,15 @@ func (m *DataWriteStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataWriteStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataReadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataWriteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12954,9 +18014,9 @@ func (m *DataWriteStreamRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataChunk == nil {
-				m.DataChunk = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -12983,7 +18043,7 @@ func (m *DataWriteStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataWriteStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *DataReadFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13006,10 +18066,10 @@ func (m *DataWriteStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataWriteStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataReadFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataWriteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataReadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13046,6 +18106,77 @@ func (m *DataWriteStreamResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
+			}
+			m.FileMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileMode |= FileMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronousIO", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SynchronousIO = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -13070,7 +18201,7 @@ func (m *DataWriteStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataReadRequest) Unmarshal(dAtA []byte) error {
+func (m *DataReadFileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13093,10 +18224,63 @@ func (m *DataReadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataReadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataReadFileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataReadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDaemon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataReadStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDaemon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataReadStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataReadStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13132,7 +18316,26 @@ func (m *DataReadRequest) Unmarshal(dAtA []byte) error {
 			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -13157,7 +18360,7 @@ func (m *DataReadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataReadResponse) Unmarshal(dAtA []byte) error {
+func (m *DataReadStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13180,15 +18383,15 @@ func (m *DataReadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataReadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataReadStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataReadStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13215,9 +18418,9 @@ func (m *DataReadResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataChunk == nil {
+				m.DataChunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -13244,7 +18447,7 @@ func (m *DataReadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataReadFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DataDeleteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13267,10 +18470,10 @@ func (m *DataReadFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataReadFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataReadFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13307,77 +18510,6 @@ func (m *DataReadFileRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
-			}
-			m.FileMode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FileMode |= FileMode(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynchronousIO", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SynchronousIO = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -13402,7 +18534,7 @@ func (m *DataReadFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataReadFileResponse) Unmarshal(dAtA []byte) error {
+func (m *DataDeleteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13425,10 +18557,10 @@ func (m *DataReadFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataReadFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataDeleteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataReadFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13455,7 +18587,7 @@ func (m *DataReadFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataReadStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *DataCheckRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13478,10 +18610,10 @@ func (m *DataReadStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataReadStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataCheckRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataReadStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13520,9 +18652,9 @@ func (m *DataReadStreamRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
 			}
-			m.ChunkSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -13532,11 +18664,12 @@ func (m *DataReadStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChunkSize |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Fast = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -13561,7 +18694,7 @@ func (m *DataReadStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataReadStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *DataCheckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13584,17 +18717,17 @@ func (m *DataReadStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataReadStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataCheckResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataReadStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataCheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataChunk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -13604,26 +18737,11 @@ func (m *DataReadStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= CheckStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataChunk = append(m.DataChunk[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataChunk == nil {
-				m.DataChunk = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -13648,7 +18766,7 @@ func (m *DataReadStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *DataRepairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13671,10 +18789,10 @@ func (m *DataDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataRepairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataRepairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13735,7 +18853,7 @@ func (m *DataDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *DataRepairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13758,12 +18876,46 @@ func (m *DataDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataRepairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataRepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, &Chunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -13788,7 +18940,7 @@ func (m *DataDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataCheckRequest) Unmarshal(dAtA []byte) error {
+func (m *DataShardHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13811,66 +18963,12 @@ func (m *DataCheckRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataCheckRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataShardHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataCheckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataShardHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chunks = append(m.Chunks, &Chunk{})
-			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDaemon
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Fast = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -13895,7 +18993,7 @@ func (m *DataCheckRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataCheckResponse) Unmarshal(dAtA []byte) error {
+func (m *DataShardHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13918,17 +19016,17 @@ func (m *DataCheckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataCheckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataShardHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataCheckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataShardHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -13938,11 +19036,26 @@ func (m *DataCheckResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= CheckStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &ShardHealth{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
@@ -13967,7 +19080,7 @@ func (m *DataCheckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataRepairRequest) Unmarshal(dAtA []byte) error {
+func (m *ShardHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13990,15 +19103,174 @@ func (m *DataRepairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataRepairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataRepairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shard = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ShardState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDaemon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			m.LastErrorTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastErrorTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			m.Latency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14025,8 +19297,10 @@ func (m *DataRepairRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chunks = append(m.Chunks, &Chunk{})
-			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Namespace == nil {
+				m.Namespace = &NamespaceHealth{}
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14054,7 +19328,7 @@ func (m *DataRepairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataRepairResponse) Unmarshal(dAtA []byte) error {
+func (m *NamespaceHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14077,17 +19351,17 @@ func (m *DataRepairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataRepairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataRepairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataIOErrors", wireType)
 			}
-			var msglen int
+			m.DataIOErrors = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDaemon
@@ -14097,26 +19371,106 @@ func (m *DataRepairResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataIOErrors |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDaemon
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexIOErrors", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDaemon
+			m.IndexIOErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexIOErrors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFaults", wireType)
 			}
-			m.Chunks = append(m.Chunks, &Chunk{})
-			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataFaults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataFaults |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexFaults", wireType)
+			}
+			m.IndexFaults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexFaults |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataIOErrorLast", wireType)
+			}
+			m.DataIOErrorLast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataIOErrorLast |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexIOErrorLast", wireType)
+			}
+			m.IndexIOErrorLast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDaemon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexIOErrorLast |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDaemon(dAtA[iNdEx:])
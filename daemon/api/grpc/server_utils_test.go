@@ -140,7 +140,7 @@ func newServerClient(passwd, namespace string) (cli *zerodb.Client, addr string,
 	if err != nil {
 		return
 	}
-	cli, err = zerodb.NewClient(addr, passwd, namespace)
+	cli, err = zerodb.NewClient(addr, passwd, namespace, nil)
 	if err != nil {
 		return
 	}
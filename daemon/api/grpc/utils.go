@@ -17,10 +17,12 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
 
+	"github.com/threefoldtech/0-stor/client"
 	"github.com/threefoldtech/0-stor/client/datastor"
 	"github.com/threefoldtech/0-stor/client/metastor/metatypes"
 
@@ -30,6 +32,14 @@ import (
 	pb "github.com/threefoldtech/0-stor/daemon/api/grpc/schema"
 )
 
+func convertUploadedPartToProto(part client.UploadedPart) *pb.UploadedPart {
+	return &pb.UploadedPart{
+		Number:         part.Number,
+		Size_:          part.Size,
+		LastWriteEpoch: part.LastWriteEpoch,
+	}
+}
+
 func convertProtoToInMemoryChunkSlice(chunks []*pb.Chunk) []metatypes.Chunk {
 	n := len(chunks)
 	if n == 0 {
@@ -155,6 +165,41 @@ var _StorageToProtoCheckStatusMapping = map[storage.CheckStatus]pb.CheckStatus{
 	storage.CheckStatusValid:   pb.CheckStatusValid,
 }
 
+func convertShardHealthToProto(health datastor.ShardHealth) *pb.ShardHealth {
+	state, ok := _DatastorToProtoShardStateMapping[health.State]
+	if !ok {
+		panic(fmt.Sprintf("unsupported shard state: %v", health.State))
+	}
+	protoHealth := &pb.ShardHealth{
+		Shard:    health.Shard,
+		State:    state,
+		Since:    health.Since.UnixNano(),
+		Failures: int64(health.Failures),
+		Latency:  int64(health.Latency),
+	}
+	if health.LastError != nil {
+		protoHealth.LastError = health.LastError.Error()
+		protoHealth.LastErrorTime = health.LastErrorTime.UnixNano()
+	}
+	if ns := health.Namespace; ns != nil {
+		protoHealth.Namespace = &pb.NamespaceHealth{
+			DataIOErrors:     ns.DataIOErrors,
+			IndexIOErrors:    ns.IndexIOErrors,
+			DataFaults:       ns.DataFaults,
+			IndexFaults:      ns.IndexFaults,
+			DataIOErrorLast:  ns.DataIOErrorLast,
+			IndexIOErrorLast: ns.IndexIOErrorLast,
+		}
+	}
+	return protoHealth
+}
+
+var _DatastorToProtoShardStateMapping = map[datastor.ShardState]pb.ShardState{
+	datastor.ShardStateHealthy:   pb.ShardStateHealthy,
+	datastor.ShardStateDegraded:  pb.ShardStateDegraded,
+	datastor.ShardStateUnhealthy: pb.ShardStateUnhealthy,
+}
+
 func mapZstorError(err error) error {
 	if cerr, ok := _ErrMetaStorErrorMapping[err]; ok {
 		return cerr
@@ -175,6 +220,9 @@ func mapDataStorError(err error) error {
 var _ErrDataStorErrorMapping = map[error]error{
 	datastor.ErrKeyNotFound:     rpctypes.ErrGRPCKeyNotFound,
 	datastor.ErrObjectCorrupted: rpctypes.ErrGRPCDataCorrupted,
+	context.Canceled:            rpctypes.ErrGRPCCanceled,
+	context.DeadlineExceeded:    rpctypes.ErrGRPCDeadlineExceeded,
+	client.ErrAppendConflict:    rpctypes.ErrGRPCAppendConflict,
 }
 
 func mapMetaStorError(err error) error {
@@ -184,6 +232,22 @@ func mapMetaStorError(err error) error {
 	return err
 }
 
+// mapUploadError maps the errors of upload sessions,
+// where a metadata which isn't found refers to the upload session itself
+func mapUploadError(err error) error {
+	if cerr, ok := _ErrUploadErrorMapping[err]; ok {
+		return cerr
+	}
+	return mapZstorError(err)
+}
+
+var _ErrUploadErrorMapping = map[error]error{
+	metastor.ErrNotFound:        rpctypes.ErrGRPCUploadNotFound,
+	metastor.ErrInvalidUploadID: rpctypes.ErrGRPCInvalidUploadID,
+	client.ErrInvalidUploadPart: rpctypes.ErrGRPCInvalidPart,
+	client.ErrEmptyUpload:       rpctypes.ErrGRPCEmptyUpload,
+}
+
 var _ErrMetaStorErrorMapping = map[error]error{
 	metastor.ErrNotFound: rpctypes.ErrGRPCKeyNotFound,
 }
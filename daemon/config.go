@@ -22,6 +22,7 @@ import (
 	"github.com/threefoldtech/0-stor/client"
 	"github.com/threefoldtech/0-stor/client/metastor/encoding"
 	"github.com/threefoldtech/0-stor/client/processing"
+	"github.com/threefoldtech/0-stor/client/scrub"
 
 	yaml "gopkg.in/yaml.v2"
 )
@@ -32,6 +33,13 @@ type Config struct {
 
 	// MetaStor defines the configuration for the metadata server.
 	MetaStor *MetaStorConfig `yaml:"metastor"`
+
+	// Scrub defines the configuration of the scrubber,
+	// which checks and repairs the data of all keys in the background.
+	//
+	// This configuration is optional,
+	// and when not given, the daemon doesn't scrub.
+	Scrub *scrub.Config `yaml:"scrub"`
 }
 
 // MetaStorConfig is used to configure the metastor client.
@@ -24,7 +24,7 @@ import (
 
 func main() {
 	// create a client to connect to a `--no-auth` zdb server
-	client, err := datastor.NewClient("127.0.0.1:12345", "", "test")
+	client, err := datastor.NewClient("127.0.0.1:12345", "", "test", nil)
 	if err != nil {
 		log.Fatal(err)
 	}
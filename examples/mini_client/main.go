@@ -55,7 +55,7 @@ func NewMiniClient(address, namespace string, privKey []byte) (*MiniClient, erro
 	}
 
 	// create our datastor client
-	zstor, err := datastor.NewClient(address, "", namespace)
+	zstor, err := datastor.NewClient(address, "", namespace, nil)
 	if err != nil {
 		return nil, err
 	}